@@ -13,6 +13,10 @@
 // https://developers.docusign.com/docs/admin-api/reference
 package admin // import "github.com/jfcote87/esign/admin
 
+import (
+	"github.com/jfcote87/esign"
+)
+
 // For more infomation on how to use scopes, see https://developers.docusign.com/docs/admin-api/admin101/auth/
 const (
 	// OAuthScopeOrganizationRead required to get lists of organizations and organization data.
@@ -41,6 +45,19 @@ type AddDSGroupAndUsersResponse struct {
 	GroupUsers *AddDSGroupUsersResponse `json:"group_users,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r AddDSGroupAndUsersResponse) Validate() error {
+	var v esign.Validator
+	if r.Group != nil {
+		v.Nested("group", r.Group.Validate())
+	}
+	if r.GroupUsers != nil {
+		v.Nested("group_users", r.GroupUsers.Validate())
+	}
+	return v.Err("AddDSGroupAndUsersResponse")
+}
+
 // AddDSGroupUsersResponse not described in definition file
 type AddDSGroupUsersResponse struct {
 	//
@@ -51,6 +68,16 @@ type AddDSGroupUsersResponse struct {
 	Users []DSGroupUserResponse `json:"users,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r AddDSGroupUsersResponse) Validate() error {
+	var v esign.Validator
+	for i := range r.Users {
+		v.NestedIndex("users", i, r.Users[i].Validate())
+	}
+	return v.Err("AddDSGroupUsersResponse")
+}
+
 // AddUserResponse methods to manage multi-product users in an account.
 type AddUserResponse struct {
 	//
@@ -93,6 +120,17 @@ type AddUserResponse struct {
 	UserName string `json:"user_name,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r AddUserResponse) Validate() error {
+	var v esign.Validator
+	for i := range r.Accounts {
+		v.NestedIndex("accounts", i, r.Accounts[i].Validate())
+	}
+	v.Format("id", r.ID, "uuid")
+	return v.Err("AddUserResponse")
+}
+
 // AddUserResponseAccountProperties not described in definition file
 type AddUserResponseAccountProperties struct {
 	// The user's company name.
@@ -109,6 +147,20 @@ type AddUserResponseAccountProperties struct {
 	SiteID int32 `json:"site_id,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r AddUserResponseAccountProperties) Validate() error {
+	var v esign.Validator
+	for i := range r.DsGroups {
+		v.NestedIndex("ds_groups", i, r.DsGroups[i].Validate())
+	}
+	v.Format("id", r.ID, "uuid")
+	for i := range r.ProductPermissionProfiles {
+		v.NestedIndex("product_permission_profiles", i, r.ProductPermissionProfiles[i].Validate())
+	}
+	return v.Err("AddUserResponseAccountProperties")
+}
+
 // CertificateResponse information about a single certificate.
 type CertificateResponse struct {
 	// The date when the certificate expires.
@@ -125,6 +177,15 @@ type CertificateResponse struct {
 	Thumbprint string `json:"thumbprint,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r CertificateResponse) Validate() error {
+	var v esign.Validator
+	v.Format("expiration_date", r.ExpirationDate, "date-time")
+	v.Format("id", r.ID, "uuid")
+	return v.Err("CertificateResponse")
+}
+
 // DSGroupAddRequest not described in definition file
 type DSGroupAddRequest struct {
 	//
@@ -133,6 +194,14 @@ type DSGroupAddRequest struct {
 	GroupName string `json:"group_name,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r DSGroupAddRequest) Validate() error {
+	var v esign.Validator
+	v.Required("group_name", r.GroupName)
+	return v.Err("DSGroupAddRequest")
+}
+
 // DSGroupAndUsersResponse not described in definition file
 type DSGroupAndUsersResponse struct {
 	//
@@ -141,6 +210,19 @@ type DSGroupAndUsersResponse struct {
 	GroupUsers *DSGroupUsersResponse `json:"group_users,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r DSGroupAndUsersResponse) Validate() error {
+	var v esign.Validator
+	if r.Group != nil {
+		v.Nested("group", r.Group.Validate())
+	}
+	if r.GroupUsers != nil {
+		v.Nested("group_users", r.GroupUsers.Validate())
+	}
+	return v.Err("DSGroupAndUsersResponse")
+}
+
 // DSGroupListResponse not described in definition file
 type DSGroupListResponse struct {
 	// Select users that are members of the specified account. At least one of `email`, `account_id` or `organization_reserved_domain_id` must be specified.
@@ -155,12 +237,32 @@ type DSGroupListResponse struct {
 	TotalCount int32 `json:"total_count,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r DSGroupListResponse) Validate() error {
+	var v esign.Validator
+	v.Format("account_id", r.AccountID, "uuid")
+	for i := range r.DsGroups {
+		v.NestedIndex("ds_groups", i, r.DsGroups[i].Validate())
+	}
+	return v.Err("DSGroupListResponse")
+}
+
 // DSGroupRequest not described in definition file
 type DSGroupRequest struct {
 	//
 	DsGroupID string `json:"ds_group_id,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r DSGroupRequest) Validate() error {
+	var v esign.Validator
+	v.Required("ds_group_id", r.DsGroupID)
+	v.Format("ds_group_id", r.DsGroupID, "uuid")
+	return v.Err("DSGroupRequest")
+}
+
 // DSGroupResponse not described in definition file
 type DSGroupResponse struct {
 	// Select users that are members of the specified account. At least one of `email`, `account_id` or `organization_reserved_domain_id` must be specified.
@@ -187,6 +289,16 @@ type DSGroupResponse struct {
 	UserCount int32 `json:"user_count,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r DSGroupResponse) Validate() error {
+	var v esign.Validator
+	v.Format("account_id", r.AccountID, "uuid")
+	v.Format("ds_group_id", r.DsGroupID, "uuid")
+	v.Format("last_modified_on", r.LastModifiedOn, "date-time")
+	return v.Err("DSGroupResponse")
+}
+
 // DSGroupUserResponse not described in definition file
 type DSGroupUserResponse struct {
 	// Select users that are members of the specified account. At least one of `email`, `account_id` or `organization_reserved_domain_id` must be specified.
@@ -207,18 +319,43 @@ type DSGroupUserResponse struct {
 	UserName string `json:"user_name,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r DSGroupUserResponse) Validate() error {
+	var v esign.Validator
+	v.Format("account_id", r.AccountID, "uuid")
+	v.Format("user_id", r.UserID, "uuid")
+	return v.Err("DSGroupUserResponse")
+}
+
 // DSGroupUsersAddRequest not described in definition file
 type DSGroupUsersAddRequest struct {
 	//
 	UserIds []string `json:"user_ids,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r DSGroupUsersAddRequest) Validate() error {
+	var v esign.Validator
+	v.Required("user_ids", r.UserIds)
+	return v.Err("DSGroupUsersAddRequest")
+}
+
 // DSGroupUsersRemoveRequest not described in definition file
 type DSGroupUsersRemoveRequest struct {
 	//
 	UserIds []string `json:"user_ids,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r DSGroupUsersRemoveRequest) Validate() error {
+	var v esign.Validator
+	v.Required("user_ids", r.UserIds)
+	return v.Err("DSGroupUsersRemoveRequest")
+}
+
 // DSGroupUsersResponse not described in definition file
 type DSGroupUsersResponse struct {
 	// The page number.
@@ -231,12 +368,31 @@ type DSGroupUsersResponse struct {
 	Users []DSGroupUserResponse `json:"users,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r DSGroupUsersResponse) Validate() error {
+	var v esign.Validator
+	for i := range r.Users {
+		v.NestedIndex("users", i, r.Users[i].Validate())
+	}
+	return v.Err("DSGroupUsersResponse")
+}
+
 // DeleteMembershipRequest not described in definition file
 type DeleteMembershipRequest struct {
 	// The ID of a user's account you want to close.
 	ID string `json:"id,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r DeleteMembershipRequest) Validate() error {
+	var v esign.Validator
+	v.Required("id", r.ID)
+	v.Format("id", r.ID, "uuid")
+	return v.Err("DeleteMembershipRequest")
+}
+
 // DeleteMembershipResponse results of closing accounts.
 type DeleteMembershipResponse struct {
 	// Error results.
@@ -245,12 +401,31 @@ type DeleteMembershipResponse struct {
 	ID string `json:"id,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r DeleteMembershipResponse) Validate() error {
+	var v esign.Validator
+	v.Format("id", r.ID, "uuid")
+	return v.Err("DeleteMembershipResponse")
+}
+
 // DeleteMembershipsRequest is a list of accounts to close for a user.
 type DeleteMembershipsRequest struct {
 	// A list of accounts to close for a user.
 	Accounts []DeleteMembershipRequest `json:"accounts,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r DeleteMembershipsRequest) Validate() error {
+	var v esign.Validator
+	v.Required("accounts", r.Accounts)
+	for i := range r.Accounts {
+		v.NestedIndex("accounts", i, r.Accounts[i].Validate())
+	}
+	return v.Err("DeleteMembershipsRequest")
+}
+
 // DeleteMembershipsResponse is the results of closing a user's account.
 type DeleteMembershipsResponse struct {
 	// A list of accounts that were closed.
@@ -259,6 +434,16 @@ type DeleteMembershipsResponse struct {
 	Success bool `json:"success,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r DeleteMembershipsResponse) Validate() error {
+	var v esign.Validator
+	for i := range r.Accounts {
+		v.NestedIndex("accounts", i, r.Accounts[i].Validate())
+	}
+	return v.Err("DeleteMembershipsResponse")
+}
+
 // DeleteResponse results of deleting identities.
 type DeleteResponse struct {
 	// A list of identities to delete.
@@ -267,12 +452,33 @@ type DeleteResponse struct {
 	Success bool `json:"success,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r DeleteResponse) Validate() error {
+	var v esign.Validator
+	for i := range r.Identities {
+		v.NestedIndex("identities", i, r.Identities[i].Validate())
+	}
+	return v.Err("DeleteResponse")
+}
+
 // DeleteUserIdentityRequest request to delete a user's identities,
 type DeleteUserIdentityRequest struct {
 	// A list of identities.
 	Identities []UserIdentityRequest `json:"identities,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r DeleteUserIdentityRequest) Validate() error {
+	var v esign.Validator
+	v.Required("identities", r.Identities)
+	for i := range r.Identities {
+		v.NestedIndex("identities", i, r.Identities[i].Validate())
+	}
+	return v.Err("DeleteUserIdentityRequest")
+}
+
 // DomainResponse information about a reserved domain.
 type DomainResponse struct {
 	// The host name of the reserved domain.
@@ -298,12 +504,31 @@ type DomainResponse struct {
 	TxtToken string `json:"txt_token,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r DomainResponse) Validate() error {
+	var v esign.Validator
+	v.Format("id", r.ID, "uuid")
+	v.Format("identity_provider_id", r.IdentityProviderID, "uuid")
+	return v.Err("DomainResponse")
+}
+
 // DomainsResponse is a response about reserved domains.
 type DomainsResponse struct {
 	// Information about reserved domains.
 	ReservedDomains []DomainResponse `json:"reserved_domains,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r DomainsResponse) Validate() error {
+	var v esign.Validator
+	for i := range r.ReservedDomains {
+		v.NestedIndex("reserved_domains", i, r.ReservedDomains[i].Validate())
+	}
+	return v.Err("DomainsResponse")
+}
+
 // ErrorDetails errors.
 type ErrorDetails struct {
 	// The code for the error.
@@ -356,12 +581,33 @@ type IdentityProviderResponse struct {
 	Type string `json:"type,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r IdentityProviderResponse) Validate() error {
+	var v esign.Validator
+	v.Format("id", r.ID, "uuid")
+	if r.Saml20 != nil {
+		v.Nested("saml_20", r.Saml20.Validate())
+	}
+	return v.Err("IdentityProviderResponse")
+}
+
 // IdentityProvidersResponse not described in definition file
 type IdentityProvidersResponse struct {
 	//
 	IdentityProviders []IdentityProviderResponse `json:"identity_providers,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r IdentityProvidersResponse) Validate() error {
+	var v esign.Validator
+	for i := range r.IdentityProviders {
+		v.NestedIndex("identity_providers", i, r.IdentityProviders[i].Validate())
+	}
+	return v.Err("IdentityProvidersResponse")
+}
+
 // LinkResponse is a link to a useful URL.
 type LinkResponse struct {
 	// The URL of the linked item.
@@ -420,6 +666,15 @@ type MembershipResponse struct {
 	Status string `json:"status,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r MembershipResponse) Validate() error {
+	var v esign.Validator
+	v.Format("account_id", r.AccountID, "uuid")
+	v.Format("created_on", r.CreatedOn, "date-time")
+	return v.Err("MembershipResponse")
+}
+
 // NewAccountUserRequest is a new user request.
 type NewAccountUserRequest struct {
 	// The access code that the user needs to activate an account.
@@ -469,6 +724,15 @@ type NewAccountUserRequest struct {
 	UserName string `json:"user_name,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r NewAccountUserRequest) Validate() error {
+	var v esign.Validator
+	v.Format("default_account_id", r.DefaultAccountID, "uuid")
+	v.Required("email", r.Email)
+	return v.Err("NewAccountUserRequest")
+}
+
 // NewMultiProductUserAddRequest not described in definition file
 type NewMultiProductUserAddRequest struct {
 	// The access code that the user needs to activate an account.
@@ -515,6 +779,22 @@ type NewMultiProductUserAddRequest struct {
 	UserName string `json:"user_name,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r NewMultiProductUserAddRequest) Validate() error {
+	var v esign.Validator
+	v.Format("default_account_id", r.DefaultAccountID, "uuid")
+	for i := range r.DsGroups {
+		v.NestedIndex("ds_groups", i, r.DsGroups[i].Validate())
+	}
+	v.Required("email", r.Email)
+	v.Required("product_permission_profiles", r.ProductPermissionProfiles)
+	for i := range r.ProductPermissionProfiles {
+		v.NestedIndex("product_permission_profiles", i, r.ProductPermissionProfiles[i].Validate())
+	}
+	return v.Err("NewMultiProductUserAddRequest")
+}
+
 // NewUserRequest information about a new user.
 type NewUserRequest struct {
 	// The access code that the user needs to activate an account.
@@ -561,6 +841,19 @@ type NewUserRequest struct {
 	UserName string `json:"user_name,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r NewUserRequest) Validate() error {
+	var v esign.Validator
+	v.Required("accounts", r.Accounts)
+	for i := range r.Accounts {
+		v.NestedIndex("accounts", i, r.Accounts[i].Validate())
+	}
+	v.Format("default_account_id", r.DefaultAccountID, "uuid")
+	v.Required("email", r.Email)
+	return v.Err("NewUserRequest")
+}
+
 // NewUserRequestAccountProperties is an individual new account user.
 type NewUserRequestAccountProperties struct {
 	// The user's company name.
@@ -575,6 +868,15 @@ type NewUserRequestAccountProperties struct {
 	PermissionProfile *PermissionProfileRequest `json:"permission_profile,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r NewUserRequestAccountProperties) Validate() error {
+	var v esign.Validator
+	v.Required("id", r.ID)
+	v.Format("id", r.ID, "uuid")
+	return v.Err("NewUserRequestAccountProperties")
+}
+
 // NewUserResponse information about a newly created user.
 type NewUserResponse struct {
 	// A list of accounts the user belongs to.
@@ -618,6 +920,17 @@ type NewUserResponse struct {
 	UserName string `json:"user_name,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r NewUserResponse) Validate() error {
+	var v esign.Validator
+	for i := range r.Accounts {
+		v.NestedIndex("accounts", i, r.Accounts[i].Validate())
+	}
+	v.Format("id", r.ID, "uuid")
+	return v.Err("NewUserResponse")
+}
+
 // NewUserResponseAccountProperties information about a newly created user.
 type NewUserResponseAccountProperties struct {
 	// The user's company name.
@@ -634,6 +947,14 @@ type NewUserResponseAccountProperties struct {
 	SiteID int32 `json:"site_id,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r NewUserResponseAccountProperties) Validate() error {
+	var v esign.Validator
+	v.Format("id", r.ID, "uuid")
+	return v.Err("NewUserResponseAccountProperties")
+}
+
 // OASIRRErrorDetails not described in definition file
 type OASIRRErrorDetails struct {
 	// The error number.
@@ -680,6 +1001,14 @@ type OrgExportSelectedAccount struct {
 	AccountID string `json:"account_id,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OrgExportSelectedAccount) Validate() error {
+	var v esign.Validator
+	v.Format("account_id", r.AccountID, "uuid")
+	return v.Err("OrgExportSelectedAccount")
+}
+
 // OrgExportSelectedDomain not described in definition file
 type OrgExportSelectedDomain struct {
 	//
@@ -702,12 +1031,30 @@ type OrgReportCreateResponse struct {
 	ReportCorrelationID string `json:"report_correlation_id,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OrgReportCreateResponse) Validate() error {
+	var v esign.Validator
+	v.Format("report_correlation_id", r.ReportCorrelationID, "uuid")
+	return v.Err("OrgReportCreateResponse")
+}
+
 // OrgReportListResponse not described in definition file
 type OrgReportListResponse struct {
 	//
 	Reports []OrgReportListResponseOrgReport `json:"reports,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OrgReportListResponse) Validate() error {
+	var v esign.Validator
+	for i := range r.Reports {
+		v.NestedIndex("reports", i, r.Reports[i].Validate())
+	}
+	return v.Err("OrgReportListResponse")
+}
+
 // OrgReportListResponseOrgReport not described in definition file
 type OrgReportListResponseOrgReport struct {
 	//
@@ -736,6 +1083,21 @@ type OrgReportListResponseOrgReport struct {
 	URL string `json:"url,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OrgReportListResponseOrgReport) Validate() error {
+	var v esign.Validator
+	v.Format("created_on", r.CreatedOn, "date-time")
+	v.Format("custom_end_date", r.CustomEndDate, "date-time")
+	v.Format("custom_start_date", r.CustomStartDate, "date-time")
+	v.Format("report_correlation_id", r.ReportCorrelationID, "uuid")
+	v.Format("report_id", r.ReportID, "uuid")
+	if r.Requestor != nil {
+		v.Nested("requestor", r.Requestor.Validate())
+	}
+	return v.Err("OrgReportListResponseOrgReport")
+}
+
 // OrgReportListResponseRequestor not described in definition file
 type OrgReportListResponseRequestor struct {
 	//
@@ -744,6 +1106,14 @@ type OrgReportListResponseRequestor struct {
 	Name string `json:"name,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OrgReportListResponseRequestor) Validate() error {
+	var v esign.Validator
+	v.Format("id", r.ID, "uuid")
+	return v.Err("OrgReportListResponseRequestor")
+}
+
 // OrgReportRequest not described in definition file
 type OrgReportRequest struct {
 	//
@@ -758,12 +1128,30 @@ type OrgReportRequest struct {
 	ReportType string `json:"report_type,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OrgReportRequest) Validate() error {
+	var v esign.Validator
+	v.Format("custom_end_date", r.CustomEndDate, "date-time")
+	v.Format("custom_start_date", r.CustomStartDate, "date-time")
+	return v.Err("OrgReportRequest")
+}
+
 // OrganizationAccountRequest not described in definition file
 type OrganizationAccountRequest struct {
 	// Select users that are members of the specified account. At least one of `email`, `account_id` or `organization_reserved_domain_id` must be specified.
 	AccountID string `json:"account_id,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OrganizationAccountRequest) Validate() error {
+	var v esign.Validator
+	v.Required("account_id", r.AccountID)
+	v.Format("account_id", r.AccountID, "uuid")
+	return v.Err("OrganizationAccountRequest")
+}
+
 // OrganizationAccountResponse information about an account.
 type OrganizationAccountResponse struct {
 	// The external account ID.
@@ -776,6 +1164,14 @@ type OrganizationAccountResponse struct {
 	SiteID int32 `json:"site_id,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OrganizationAccountResponse) Validate() error {
+	var v esign.Validator
+	v.Format("id", r.ID, "uuid")
+	return v.Err("OrganizationAccountResponse")
+}
+
 // OrganizationAccountSettingsImportRequestorResponse not described in definition file
 type OrganizationAccountSettingsImportRequestorResponse struct {
 	// The email address.
@@ -822,6 +1218,20 @@ type OrganizationAccountSettingsImportResponse struct {
 	Type string `json:"type,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OrganizationAccountSettingsImportResponse) Validate() error {
+	var v esign.Validator
+	v.Format("completed", r.Completed, "date-time")
+	v.Format("created", r.Created, "date-time")
+	v.Format("expires", r.Expires, "date-time")
+	v.Format("last_modified", r.LastModified, "date-time")
+	for i := range r.Results {
+		v.NestedIndex("results", i, r.Results[i].Validate())
+	}
+	return v.Err("OrganizationAccountSettingsImportResponse")
+}
+
 // OrganizationAccountSettingsImportResultResponse not described in definition file
 type OrganizationAccountSettingsImportResultResponse struct {
 	// Error results.
@@ -840,18 +1250,44 @@ type OrganizationAccountSettingsImportResultResponse struct {
 	URL string `json:"url,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OrganizationAccountSettingsImportResultResponse) Validate() error {
+	var v esign.Validator
+	v.Format("id", r.ID, "uuid")
+	return v.Err("OrganizationAccountSettingsImportResultResponse")
+}
+
 // OrganizationAccountsRequest not described in definition file
 type OrganizationAccountsRequest struct {
 	//
 	Accounts []OrganizationAccountRequest `json:"accounts,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OrganizationAccountsRequest) Validate() error {
+	var v esign.Validator
+	for i := range r.Accounts {
+		v.NestedIndex("accounts", i, r.Accounts[i].Validate())
+	}
+	return v.Err("OrganizationAccountsRequest")
+}
+
 // OrganizationExportAccount not described in definition file
 type OrganizationExportAccount struct {
 	// Select users that are members of the specified account. At least one of `email`, `account_id` or `organization_reserved_domain_id` must be specified.
 	AccountID string `json:"account_id,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OrganizationExportAccount) Validate() error {
+	var v esign.Validator
+	v.Format("account_id", r.AccountID, "uuid")
+	return v.Err("OrganizationExportAccount")
+}
+
 // OrganizationExportDomain not described in definition file
 type OrganizationExportDomain struct {
 	//
@@ -873,6 +1309,16 @@ type OrganizationExportRequest struct {
 	Type string `json:"type,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OrganizationExportRequest) Validate() error {
+	var v esign.Validator
+	for i := range r.Accounts {
+		v.NestedIndex("accounts", i, r.Accounts[i].Validate())
+	}
+	return v.Err("OrganizationExportRequest")
+}
+
 // OrganizationExportRequestorResponse not described in definition file
 type OrganizationExportRequestorResponse struct {
 	// The email address.
@@ -921,6 +1367,24 @@ type OrganizationExportResponse struct {
 	Type string `json:"type,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OrganizationExportResponse) Validate() error {
+	var v esign.Validator
+	v.Format("completed", r.Completed, "date-time")
+	v.Format("created", r.Created, "date-time")
+	v.Format("expires", r.Expires, "date-time")
+	v.Format("id", r.ID, "uuid")
+	v.Format("last_modified", r.LastModified, "date-time")
+	for i := range r.Results {
+		v.NestedIndex("results", i, r.Results[i].Validate())
+	}
+	for i := range r.SelectedAccounts {
+		v.NestedIndex("selected_accounts", i, r.SelectedAccounts[i].Validate())
+	}
+	return v.Err("OrganizationExportResponse")
+}
+
 // OrganizationExportTaskResponse not described in definition file
 type OrganizationExportTaskResponse struct {
 	// Error results.
@@ -937,12 +1401,30 @@ type OrganizationExportTaskResponse struct {
 	URL string `json:"url,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OrganizationExportTaskResponse) Validate() error {
+	var v esign.Validator
+	v.Format("id", r.ID, "uuid")
+	return v.Err("OrganizationExportTaskResponse")
+}
+
 // OrganizationExportsResponse not described in definition file
 type OrganizationExportsResponse struct {
 	//
 	Exports []OrganizationExportResponse `json:"exports,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OrganizationExportsResponse) Validate() error {
+	var v esign.Validator
+	for i := range r.Exports {
+		v.NestedIndex("exports", i, r.Exports[i].Validate())
+	}
+	return v.Err("OrganizationExportsResponse")
+}
+
 // OrganizationImportResponse methods to import users.
 type OrganizationImportResponse struct {
 	//
@@ -999,6 +1481,16 @@ type OrganizationImportResponse struct {
 	WarningCount int32 `json:"warning_count,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OrganizationImportResponse) Validate() error {
+	var v esign.Validator
+	v.Format("created", r.Created, "date-time")
+	v.Format("id", r.ID, "uuid")
+	v.Format("last_modified", r.LastModified, "date-time")
+	return v.Err("OrganizationImportResponse")
+}
+
 // OrganizationImportResponseErrorRollup not described in definition file
 type OrganizationImportResponseErrorRollup struct {
 	//
@@ -1033,6 +1525,16 @@ type OrganizationImportsResponse struct {
 	Imports []OrganizationImportResponse `json:"imports,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OrganizationImportsResponse) Validate() error {
+	var v esign.Validator
+	for i := range r.Imports {
+		v.NestedIndex("imports", i, r.Imports[i].Validate())
+	}
+	return v.Err("OrganizationImportsResponse")
+}
+
 // OrganizationResponse information about an individual organization.
 type OrganizationResponse struct {
 	// A list of organization accounts.
@@ -1065,6 +1567,31 @@ type OrganizationResponse struct {
 	Users []OrganizationSimpleIDObject `json:"users,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OrganizationResponse) Validate() error {
+	var v esign.Validator
+	for i := range r.Accounts {
+		v.NestedIndex("accounts", i, r.Accounts[i].Validate())
+	}
+	v.Format("created_by", r.CreatedBy, "uuid")
+	v.Format("created_on", r.CreatedOn, "date-time")
+	v.Format("default_account_id", r.DefaultAccountID, "uuid")
+	v.Format("id", r.ID, "uuid")
+	for i := range r.IdentityProviders {
+		v.NestedIndex("identity_providers", i, r.IdentityProviders[i].Validate())
+	}
+	v.Format("last_modified_by", r.LastModifiedBy, "uuid")
+	v.Format("last_modified_on", r.LastModifiedOn, "date-time")
+	for i := range r.ReservedDomains {
+		v.NestedIndex("reserved_domains", i, r.ReservedDomains[i].Validate())
+	}
+	for i := range r.Users {
+		v.NestedIndex("users", i, r.Users[i].Validate())
+	}
+	return v.Err("OrganizationResponse")
+}
+
 // OrganizationSalesforceAccountManagersResponse not described in definition file
 type OrganizationSalesforceAccountManagersResponse struct {
 	// Select users that are members of the specified account. At least one of `email`, `account_id` or `organization_reserved_domain_id` must be specified.
@@ -1087,6 +1614,14 @@ type OrganizationSimpleIDObject struct {
 	ID string `json:"id,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OrganizationSimpleIDObject) Validate() error {
+	var v esign.Validator
+	v.Format("id", r.ID, "uuid")
+	return v.Err("OrganizationSimpleIDObject")
+}
+
 // OrganizationUserResponse information about a user.
 type OrganizationUserResponse struct {
 	// The date the user's account was created.
@@ -1125,6 +1660,20 @@ type OrganizationUserResponse struct {
 	UserStatus string `json:"user_status,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OrganizationUserResponse) Validate() error {
+	var v esign.Validator
+	v.Format("created_on", r.CreatedOn, "date-time")
+	for i := range r.DsGroups {
+		v.NestedIndex("ds_groups", i, r.DsGroups[i].Validate())
+	}
+	v.Format("id", r.ID, "uuid")
+	v.Format("membership_created_on", r.MembershipCreatedOn, "date-time")
+	v.Format("membership_id", r.MembershipID, "uuid")
+	return v.Err("OrganizationUserResponse")
+}
+
 // OrganizationUsersResponse is a response containing information about users.
 type OrganizationUsersResponse struct {
 	// Contains information about paging through the results.
@@ -1133,12 +1682,32 @@ type OrganizationUsersResponse struct {
 	Users []OrganizationUserResponse `json:"users,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OrganizationUsersResponse) Validate() error {
+	var v esign.Validator
+	for i := range r.Users {
+		v.NestedIndex("users", i, r.Users[i].Validate())
+	}
+	return v.Err("OrganizationUsersResponse")
+}
+
 // OrganizationsResponse organization list.
 type OrganizationsResponse struct {
 	// A list of organizations of which the  authenticated user is a member.
 	Organizations []OrganizationResponse `json:"organizations,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OrganizationsResponse) Validate() error {
+	var v esign.Validator
+	for i := range r.Organizations {
+		v.NestedIndex("organizations", i, r.Organizations[i].Validate())
+	}
+	return v.Err("OrganizationsResponse")
+}
+
 // PagingResponseProperties not described in definition file
 type PagingResponseProperties struct {
 	//
@@ -1195,6 +1764,16 @@ type ProductPermissionProfileRequest struct {
 	ProductID string `json:"product_id,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r ProductPermissionProfileRequest) Validate() error {
+	var v esign.Validator
+	v.Required("permission_profile_id", r.PermissionProfileID)
+	v.Required("product_id", r.ProductID)
+	v.Format("product_id", r.ProductID, "uuid")
+	return v.Err("ProductPermissionProfileRequest")
+}
+
 // ProductPermissionProfileResponse not described in definition file
 type ProductPermissionProfileResponse struct {
 	//
@@ -1207,18 +1786,47 @@ type ProductPermissionProfileResponse struct {
 	ProductName string `json:"product_name,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r ProductPermissionProfileResponse) Validate() error {
+	var v esign.Validator
+	v.Format("product_id", r.ProductID, "uuid")
+	return v.Err("ProductPermissionProfileResponse")
+}
+
 // ProductPermissionProfilesRequest not described in definition file
 type ProductPermissionProfilesRequest struct {
 	//
 	ProductPermissionProfiles []ProductPermissionProfileRequest `json:"product_permission_profiles,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r ProductPermissionProfilesRequest) Validate() error {
+	var v esign.Validator
+	v.Required("product_permission_profiles", r.ProductPermissionProfiles)
+	for i := range r.ProductPermissionProfiles {
+		v.NestedIndex("product_permission_profiles", i, r.ProductPermissionProfiles[i].Validate())
+	}
+	return v.Err("ProductPermissionProfilesRequest")
+}
+
 // ProductPermissionProfilesResponse not described in definition file
 type ProductPermissionProfilesResponse struct {
 	//
 	ProductPermissionProfiles []ProductPermissionProfileResponse `json:"product_permission_profiles,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r ProductPermissionProfilesResponse) Validate() error {
+	var v esign.Validator
+	for i := range r.ProductPermissionProfiles {
+		v.NestedIndex("product_permission_profiles", i, r.ProductPermissionProfiles[i].Validate())
+	}
+	return v.Err("ProductPermissionProfilesResponse")
+}
+
 // RemoveDSGroupUsersResponse not described in definition file
 type RemoveDSGroupUsersResponse struct {
 	//
@@ -1227,6 +1835,16 @@ type RemoveDSGroupUsersResponse struct {
 	IsSuccess bool `json:"is_success,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r RemoveDSGroupUsersResponse) Validate() error {
+	var v esign.Validator
+	for i := range r.FailedUsers {
+		v.NestedIndex("failed_users", i, r.FailedUsers[i].Validate())
+	}
+	return v.Err("RemoveDSGroupUsersResponse")
+}
+
 // RequiredAttributeMappingResponse is a single attribute mapping response.
 type RequiredAttributeMappingResponse struct {
 	// The human-readable name of the attribute.
@@ -1251,6 +1869,16 @@ type Saml2IdentityProviderResponse struct {
 	Settings []SettingResponse `json:"settings,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r Saml2IdentityProviderResponse) Validate() error {
+	var v esign.Validator
+	for i := range r.Certificates {
+		v.NestedIndex("certificates", i, r.Certificates[i].Validate())
+	}
+	return v.Err("Saml2IdentityProviderResponse")
+}
+
 // SettingResponse is a key/value list of settings.
 type SettingResponse struct {
 	// The key of the setting.
@@ -1287,6 +1915,15 @@ type UpdateMembershipRequest struct {
 	SendActivation bool `json:"send_activation,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r UpdateMembershipRequest) Validate() error {
+	var v esign.Validator
+	v.Required("account_id", r.AccountID)
+	v.Format("account_id", r.AccountID, "uuid")
+	return v.Err("UpdateMembershipRequest")
+}
+
 // UpdateResponse is a response.
 type UpdateResponse struct {
 	// The status of the request.
@@ -1304,6 +1941,16 @@ type UpdateUserEmailRequest struct {
 	SiteID int32 `json:"site_id,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r UpdateUserEmailRequest) Validate() error {
+	var v esign.Validator
+	v.Required("email", r.Email)
+	v.Required("id", r.ID)
+	v.Format("id", r.ID, "uuid")
+	return v.Err("UpdateUserEmailRequest")
+}
+
 // UpdateUserRequest request to change a user's information.
 type UpdateUserRequest struct {
 	// The account ID of the user's default account.
@@ -1354,18 +2001,51 @@ type UpdateUserRequest struct {
 	UserName string `json:"user_name,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r UpdateUserRequest) Validate() error {
+	var v esign.Validator
+	v.Format("default_account_id", r.DefaultAccountID, "uuid")
+	v.Required("id", r.ID)
+	v.Format("id", r.ID, "uuid")
+	for i := range r.Memberships {
+		v.NestedIndex("memberships", i, r.Memberships[i].Validate())
+	}
+	return v.Err("UpdateUserRequest")
+}
+
 // UpdateUsersEmailRequest is a change email request.
 type UpdateUsersEmailRequest struct {
 	// A list of users whose email address to change.
 	Users []UpdateUserEmailRequest `json:"users,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r UpdateUsersEmailRequest) Validate() error {
+	var v esign.Validator
+	for i := range r.Users {
+		v.NestedIndex("users", i, r.Users[i].Validate())
+	}
+	return v.Err("UpdateUsersEmailRequest")
+}
+
 // UpdateUsersRequest is a list of users whose information you want to change.
 type UpdateUsersRequest struct {
 	// A list of users whose information you want to change.
 	Users []UpdateUserRequest `json:"users,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r UpdateUsersRequest) Validate() error {
+	var v esign.Validator
+	for i := range r.Users {
+		v.NestedIndex("users", i, r.Users[i].Validate())
+	}
+	return v.Err("UpdateUsersRequest")
+}
+
 // UserDrilldownResponse information about a user.
 type UserDrilldownResponse struct {
 	// The date the user's account was created.
@@ -1432,12 +2112,37 @@ type UserDrilldownResponse struct {
 	UserStatus string `json:"user_status,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r UserDrilldownResponse) Validate() error {
+	var v esign.Validator
+	v.Format("created_on", r.CreatedOn, "date-time")
+	v.Format("default_account_id", r.DefaultAccountID, "uuid")
+	v.Format("id", r.ID, "uuid")
+	for i := range r.Identities {
+		v.NestedIndex("identities", i, r.Identities[i].Validate())
+	}
+	v.Format("last_login", r.LastLogin, "date-time")
+	for i := range r.Memberships {
+		v.NestedIndex("memberships", i, r.Memberships[i].Validate())
+	}
+	return v.Err("UserDrilldownResponse")
+}
+
 // UserIdentityRequest user identity,
 type UserIdentityRequest struct {
 	// The ID of the identity.
 	ID string `json:"id,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r UserIdentityRequest) Validate() error {
+	var v esign.Validator
+	v.Format("id", r.ID, "uuid")
+	return v.Err("UserIdentityRequest")
+}
+
 // UserIdentityResponse results of deleting a user identity.
 type UserIdentityResponse struct {
 	// Error results.
@@ -1452,6 +2157,16 @@ type UserIdentityResponse struct {
 	UserID string `json:"user_id,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r UserIdentityResponse) Validate() error {
+	var v esign.Validator
+	v.Format("id", r.ID, "uuid")
+	v.Format("provider_id", r.ProviderID, "uuid")
+	v.Format("user_id", r.UserID, "uuid")
+	return v.Err("UserIdentityResponse")
+}
+
 // UserProductPermissionProfilesResponse not described in definition file
 type UserProductPermissionProfilesResponse struct {
 	// Select users that are members of the specified account. At least one of `email`, `account_id` or `organization_reserved_domain_id` must be specified.
@@ -1462,6 +2177,18 @@ type UserProductPermissionProfilesResponse struct {
 	UserID string `json:"user_id,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r UserProductPermissionProfilesResponse) Validate() error {
+	var v esign.Validator
+	v.Format("account_id", r.AccountID, "uuid")
+	for i := range r.ProductPermissionProfiles {
+		v.NestedIndex("product_permission_profiles", i, r.ProductPermissionProfiles[i].Validate())
+	}
+	v.Format("user_id", r.UserID, "uuid")
+	return v.Err("UserProductPermissionProfilesResponse")
+}
+
 // UserUpdateResponse error result of attempting to change a user's email address.
 type UserUpdateResponse struct {
 	// The email address.
@@ -1474,12 +2201,30 @@ type UserUpdateResponse struct {
 	SiteID int32 `json:"site_id,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r UserUpdateResponse) Validate() error {
+	var v esign.Validator
+	v.Format("id", r.ID, "uuid")
+	return v.Err("UserUpdateResponse")
+}
+
 // UsersDrilldownResponse information about a list of users.
 type UsersDrilldownResponse struct {
 	// A list of users.
 	Users []UserDrilldownResponse `json:"users,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r UsersDrilldownResponse) Validate() error {
+	var v esign.Validator
+	for i := range r.Users {
+		v.NestedIndex("users", i, r.Users[i].Validate())
+	}
+	return v.Err("UsersDrilldownResponse")
+}
+
 // UsersUpdateResponse is the results of changing a user's information.
 type UsersUpdateResponse struct {
 	// If **true**, the request to change user information succeeded.
@@ -1487,3 +2232,13 @@ type UsersUpdateResponse struct {
 	// A list of users whose email addresses have been updated.
 	Users []UserUpdateResponse `json:"users,omitempty"`
 }
+
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r UsersUpdateResponse) Validate() error {
+	var v esign.Validator
+	for i := range r.Users {
+		v.NestedIndex("users", i, r.Users[i].Validate())
+	}
+	return v.Err("UsersUpdateResponse")
+}
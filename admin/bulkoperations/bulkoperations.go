@@ -69,6 +69,15 @@ func (op *CreateAccountSettingsExportOp) Do(ctx context.Context) (*admin.Organiz
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *CreateAccountSettingsExportOp) ValidateFirst() *CreateAccountSettingsExportOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // DeleteAccountSettingsExport deletes a single account settings export request.
 //
 // https://developers.docusign.com/docs/admin-api/reference/bulkoperations/accountsettingsexport/deleteaccountsettingsexport
@@ -169,6 +178,15 @@ func (op *CreateUserListExportOp) Do(ctx context.Context) (*admin.OrganizationEx
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *CreateUserListExportOp) ValidateFirst() *CreateUserListExportOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // DeleteUserListExport deletes a single user list export request.
 //
 // https://developers.docusign.com/docs/admin-api/reference/bulkoperations/userexport/deleteuserlistexport
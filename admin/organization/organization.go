@@ -72,9 +72,18 @@ func (op *GetOrganizationsOp) Do(ctx context.Context) (*admin.OrganizationsRespo
 // - `account_membership`: Returns organizations that contain an account of which the authenticated user is a member
 //
 // Default value: `org_admin`
-func (op *GetOrganizationsOp) Mode(val string) *GetOrganizationsOp {
+func (op *GetOrganizationsOp) Mode(val Mode) *GetOrganizationsOp {
 	if op != nil {
-		op.QueryOpts.Set("mode", val)
+		op.QueryOpts.Set("mode", string(val))
 	}
 	return op
 }
+
+// Mode is a value of the mode query option.
+type Mode string
+
+// Mode values
+const (
+	ModeOrgAdmin          Mode = "org_admin"
+	ModeAccountMembership Mode = "account_membership"
+)
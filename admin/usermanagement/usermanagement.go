@@ -68,6 +68,45 @@ func (op *GetGroupsOp) Do(ctx context.Context) (*admin.MemberGroupsResponse, err
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *GetGroupsOp) Pages(ctx context.Context, f func(*admin.MemberGroupsResponse) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil || res.Paging == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   fmt.Sprint(res.Paging.ResultSetEndPosition),
+			NextURI:       &res.Paging.Next,
+			ResultSetSize: fmt.Sprint(res.Paging.ResultSetSize),
+			StartPosition: fmt.Sprint(res.Paging.ResultSetStartPosition),
+			TotalSetSize:  fmt.Sprint(res.Paging.TotalSetSize),
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // Start index of first item to include in the response. The default value is 0.
 func (op *GetGroupsOp) Start(val int) *GetGroupsOp {
 	if op != nil {
@@ -143,6 +182,15 @@ func (op *AddDSGroupOp) Do(ctx context.Context) (*admin.DSGroupResponse, error)
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *AddDSGroupOp) ValidateFirst() *AddDSGroupOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // AddDSGroupUsers adds a list of users to a DSGroup.
 //
 // https://developers.docusign.com/docs/admin-api/reference/usermanagement/multiproductusermanagement/adddsgroupusers
@@ -169,6 +217,15 @@ func (op *AddDSGroupUsersOp) Do(ctx context.Context) (*admin.AddDSGroupAndUsersR
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *AddDSGroupUsersOp) ValidateFirst() *AddDSGroupUsersOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // DeleteDSGroup deletes a DSGroup.
 //
 // https://developers.docusign.com/docs/admin-api/reference/usermanagement/multiproductusermanagement/deletedsgroup
@@ -284,6 +341,43 @@ func (op *GetDSGroupsOp) Do(ctx context.Context) (*admin.DSGroupListResponse, er
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *GetDSGroupsOp) Pages(ctx context.Context, f func(*admin.DSGroupListResponse) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			Page:       fmt.Sprint(res.Page),
+			PageSize:   fmt.Sprint(res.PageSize),
+			TotalCount: fmt.Sprint(res.TotalCount),
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "", "", "page"); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // Page start page of DSGroups.
 func (op *GetDSGroupsOp) Page(val int) *GetDSGroupsOp {
 	if op != nil {
@@ -326,6 +420,15 @@ func (op *RemoveDSGroupUsersOp) Do(ctx context.Context) (*admin.RemoveDSGroupUse
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *RemoveDSGroupUsersOp) ValidateFirst() *RemoveDSGroupUsersOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // AddUserProductPermissionProfiles assigns user to permission profiles for one or more products.
 //
 // https://developers.docusign.com/docs/admin-api/reference/usermanagement/multiproductusermanagement/adduserproductpermissionprofiles
@@ -352,6 +455,15 @@ func (op *AddUserProductPermissionProfilesOp) Do(ctx context.Context) (*admin.Us
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *AddUserProductPermissionProfilesOp) ValidateFirst() *AddUserProductPermissionProfilesOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // GetProductPermissionProfiles gets products associated with the account and the available permission profiles.
 //
 // https://developers.docusign.com/docs/admin-api/reference/usermanagement/multiproductusermanagement/getproductpermissionprofiles
@@ -428,6 +540,15 @@ func (op *AddOrUpdateUserOp) Do(ctx context.Context) (*admin.AddUserResponse, er
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *AddOrUpdateUserOp) ValidateFirst() *AddOrUpdateUserOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // GetUsers returns information about the users in an organization.
 //
 // https://developers.docusign.com/docs/admin-api/reference/usermanagement/users/getusers
@@ -453,6 +574,45 @@ func (op *GetUsersOp) Do(ctx context.Context) (*admin.OrganizationUsersResponse,
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *GetUsersOp) Pages(ctx context.Context, f func(*admin.OrganizationUsersResponse) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil || res.Paging == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   fmt.Sprint(res.Paging.ResultSetEndPosition),
+			NextURI:       &res.Paging.Next,
+			ResultSetSize: fmt.Sprint(res.Paging.ResultSetSize),
+			StartPosition: fmt.Sprint(res.Paging.ResultSetStartPosition),
+			TotalSetSize:  fmt.Sprint(res.Paging.TotalSetSize),
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // Start index of first item to include in the response. The default value is 0.
 func (op *GetUsersOp) Start(val int) *GetUsersOp {
 	if op != nil {
@@ -565,6 +725,15 @@ func (op *UpdateEmailAddressOp) Do(ctx context.Context) (*admin.UsersUpdateRespo
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *UpdateEmailAddressOp) ValidateFirst() *UpdateEmailAddressOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // ActivateMembership activates user memberships.
 //
 // https://developers.docusign.com/docs/admin-api/reference/usermanagement/esignusermanagement/activatemembership
@@ -617,6 +786,15 @@ func (op *AddUsersOp) Do(ctx context.Context) (*admin.NewUserResponse, error) {
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *AddUsersOp) ValidateFirst() *AddUsersOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // CloseMemberships closes a user's memberships.
 //
 // https://developers.docusign.com/docs/admin-api/reference/usermanagement/esignusermanagement/closememberships
@@ -643,6 +821,15 @@ func (op *CloseMembershipsOp) Do(ctx context.Context) (*admin.DeleteMembershipsR
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *CloseMembershipsOp) ValidateFirst() *CloseMembershipsOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // CreateUser creates a new user.
 //
 // https://developers.docusign.com/docs/admin-api/reference/usermanagement/esignusermanagement/createuser
@@ -669,6 +856,15 @@ func (op *CreateUserOp) Do(ctx context.Context) (*admin.NewUserResponse, error)
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *CreateUserOp) ValidateFirst() *CreateUserOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // DeleteIdentities deletes user identities.
 //
 // https://developers.docusign.com/docs/admin-api/reference/usermanagement/esignusermanagement/deleteidentities
@@ -695,6 +891,15 @@ func (op *DeleteIdentitiesOp) Do(ctx context.Context) (*admin.DeleteResponse, er
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *DeleteIdentitiesOp) ValidateFirst() *DeleteIdentitiesOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // GetUserProfiles returns information about recently modified users.
 //
 //
@@ -754,3 +959,12 @@ func (op *UpdateUserOp) Do(ctx context.Context) (*admin.UsersUpdateResponse, err
 	var res *admin.UsersUpdateResponse
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
+
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *UpdateUserOp) ValidateFirst() *UpdateUserOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
@@ -11,6 +11,10 @@
 //
 //
 //
+// The specification lists no field constraints for this API's models,
+// so they have no Validate methods and ops have no ValidateFirst option.
+// Constraints may be added with a gen-esign overrides file.
+//
 // Service Api documentation may be found at:
 // https://developers.docusign.com/docs/click-api/reference/accounts
 // Usage example:
@@ -24,8 +28,10 @@ package click // import "github.com/jfcote87/esign/click"
 
 import (
 	"context"
+	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/jfcote87/esign"
 )
@@ -141,9 +147,9 @@ func (op *DeleteClickwrapOp) Do(ctx context.Context) (ClickwrapVersionsDeleteRes
 }
 
 // Versions is a comma-separated list of versions to delete.
-func (op *DeleteClickwrapOp) Versions(val string) *DeleteClickwrapOp {
+func (op *DeleteClickwrapOp) Versions(val ...string) *DeleteClickwrapOp {
 	if op != nil {
-		op.QueryOpts.Set("versions", val)
+		op.QueryOpts.Set("versions", strings.Join(val, ","))
 	}
 	return op
 }
@@ -224,9 +230,9 @@ func (op *DeleteClickwrapVersionsOp) Do(ctx context.Context) (ClickwrapVersionsD
 }
 
 // ClickwrapVersionIds is a comma-separated list of clickwrap version IDs to delete.
-func (op *DeleteClickwrapVersionsOp) ClickwrapVersionIds(val string) *DeleteClickwrapVersionsOp {
+func (op *DeleteClickwrapVersionsOp) ClickwrapVersionIds(val ...string) *DeleteClickwrapVersionsOp {
 	if op != nil {
-		op.QueryOpts.Set("clickwrapVersionIds", val)
+		op.QueryOpts.Set("clickwrapVersionIds", strings.Join(val, ","))
 	}
 	return op
 }
@@ -257,9 +263,9 @@ func (op *DeleteClickwrapsOp) Do(ctx context.Context) (ClickwrapsDeleteResponse,
 }
 
 // ClickwrapIds is a comma-separated list of clickwrap IDs to delete.
-func (op *DeleteClickwrapsOp) ClickwrapIds(val string) *DeleteClickwrapsOp {
+func (op *DeleteClickwrapsOp) ClickwrapIds(val ...string) *DeleteClickwrapsOp {
 	if op != nil {
-		op.QueryOpts.Set("clickwrapIds", val)
+		op.QueryOpts.Set("clickwrapIds", strings.Join(val, ","))
 	}
 	return op
 }
@@ -362,6 +368,40 @@ func (op *GetClickwrapAgreementsOp) Do(ctx context.Context) (ClickwrapAgreements
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *GetClickwrapAgreementsOp) Pages(ctx context.Context, f func(ClickwrapAgreementsResponse) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		pg := esign.PageInfo{
+			Page:           fmt.Sprint(res.Page),
+			PageSize:       fmt.Sprint(res.PageSize),
+			PagesRemaining: fmt.Sprint(res.MinimumPagesRemaining),
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "", "", "page_number"); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // ClientUserID is the client ID.
 func (op *GetClickwrapAgreementsOp) ClientUserID(val string) *GetClickwrapAgreementsOp {
 	if op != nil {
@@ -371,9 +411,9 @@ func (op *GetClickwrapAgreementsOp) ClientUserID(val string) *GetClickwrapAgreem
 }
 
 // FromDate optional. The earliest date to return agreements from.
-func (op *GetClickwrapAgreementsOp) FromDate(val string) *GetClickwrapAgreementsOp {
+func (op *GetClickwrapAgreementsOp) FromDate(val time.Time) *GetClickwrapAgreementsOp {
 	if op != nil {
-		op.QueryOpts.Set("from_date", val)
+		op.QueryOpts.Set("from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -395,9 +435,9 @@ func (op *GetClickwrapAgreementsOp) Status(val string) *GetClickwrapAgreementsOp
 }
 
 // ToDate optional. The latest date to return agreements from.
-func (op *GetClickwrapAgreementsOp) ToDate(val string) *GetClickwrapAgreementsOp {
+func (op *GetClickwrapAgreementsOp) ToDate(val time.Time) *GetClickwrapAgreementsOp {
 	if op != nil {
-		op.QueryOpts.Set("to_date", val)
+		op.QueryOpts.Set("to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -452,6 +492,40 @@ func (op *GetClickwrapVersionAgreementsOp) Do(ctx context.Context) (ClickwrapAgr
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *GetClickwrapVersionAgreementsOp) Pages(ctx context.Context, f func(ClickwrapAgreementsResponse) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		pg := esign.PageInfo{
+			Page:           fmt.Sprint(res.Page),
+			PageSize:       fmt.Sprint(res.PageSize),
+			PagesRemaining: fmt.Sprint(res.MinimumPagesRemaining),
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "", "", "page_number"); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // ClientUserID set the call query parameter client_user_id
 func (op *GetClickwrapVersionAgreementsOp) ClientUserID(val string) *GetClickwrapVersionAgreementsOp {
 	if op != nil {
@@ -461,9 +535,9 @@ func (op *GetClickwrapVersionAgreementsOp) ClientUserID(val string) *GetClickwra
 }
 
 // FromDate optional. The earliest date to return agreements from.
-func (op *GetClickwrapVersionAgreementsOp) FromDate(val string) *GetClickwrapVersionAgreementsOp {
+func (op *GetClickwrapVersionAgreementsOp) FromDate(val time.Time) *GetClickwrapVersionAgreementsOp {
 	if op != nil {
-		op.QueryOpts.Set("from_date", val)
+		op.QueryOpts.Set("from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -481,17 +555,17 @@ func (op *GetClickwrapVersionAgreementsOp) PageNumber(val string) *GetClickwrapV
 // - `active`
 // - `inactive`
 // - `deleted`
-func (op *GetClickwrapVersionAgreementsOp) Status(val string) *GetClickwrapVersionAgreementsOp {
+func (op *GetClickwrapVersionAgreementsOp) Status(val Status) *GetClickwrapVersionAgreementsOp {
 	if op != nil {
-		op.QueryOpts.Set("status", val)
+		op.QueryOpts.Set("status", string(val))
 	}
 	return op
 }
 
 // ToDate optional. The latest date to return agreements from.
-func (op *GetClickwrapVersionAgreementsOp) ToDate(val string) *GetClickwrapVersionAgreementsOp {
+func (op *GetClickwrapVersionAgreementsOp) ToDate(val time.Time) *GetClickwrapVersionAgreementsOp {
 	if op != nil {
-		op.QueryOpts.Set("to_date", val)
+		op.QueryOpts.Set("to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -521,6 +595,40 @@ func (op *GetClickwrapVersionAgreementsByNumberOp) Do(ctx context.Context) (Clic
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *GetClickwrapVersionAgreementsByNumberOp) Pages(ctx context.Context, f func(ClickwrapAgreementsResponse) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		pg := esign.PageInfo{
+			Page:           fmt.Sprint(res.Page),
+			PageSize:       fmt.Sprint(res.PageSize),
+			PagesRemaining: fmt.Sprint(res.MinimumPagesRemaining),
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "", "", "page_number"); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // ClientUserID is the client user ID.
 func (op *GetClickwrapVersionAgreementsByNumberOp) ClientUserID(val string) *GetClickwrapVersionAgreementsByNumberOp {
 	if op != nil {
@@ -530,9 +638,9 @@ func (op *GetClickwrapVersionAgreementsByNumberOp) ClientUserID(val string) *Get
 }
 
 // FromDate optional. The earliest date to return agreements from.
-func (op *GetClickwrapVersionAgreementsByNumberOp) FromDate(val string) *GetClickwrapVersionAgreementsByNumberOp {
+func (op *GetClickwrapVersionAgreementsByNumberOp) FromDate(val time.Time) *GetClickwrapVersionAgreementsByNumberOp {
 	if op != nil {
-		op.QueryOpts.Set("from_date", val)
+		op.QueryOpts.Set("from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -550,17 +658,17 @@ func (op *GetClickwrapVersionAgreementsByNumberOp) PageNumber(val string) *GetCl
 // - `active`
 // - `inactive`
 // - `deleted`
-func (op *GetClickwrapVersionAgreementsByNumberOp) Status(val string) *GetClickwrapVersionAgreementsByNumberOp {
+func (op *GetClickwrapVersionAgreementsByNumberOp) Status(val Status) *GetClickwrapVersionAgreementsByNumberOp {
 	if op != nil {
-		op.QueryOpts.Set("status", val)
+		op.QueryOpts.Set("status", string(val))
 	}
 	return op
 }
 
 // ToDate optional. The latest date to return agreements from.
-func (op *GetClickwrapVersionAgreementsByNumberOp) ToDate(val string) *GetClickwrapVersionAgreementsByNumberOp {
+func (op *GetClickwrapVersionAgreementsByNumberOp) ToDate(val time.Time) *GetClickwrapVersionAgreementsByNumberOp {
 	if op != nil {
-		op.QueryOpts.Set("to_date", val)
+		op.QueryOpts.Set("to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -615,10 +723,44 @@ func (op *GetClickwrapsOp) Do(ctx context.Context) (ClickwrapVersionsResponse, e
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *GetClickwrapsOp) Pages(ctx context.Context, f func(ClickwrapVersionsResponse) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		pg := esign.PageInfo{
+			Page:           fmt.Sprint(res.Page),
+			PageSize:       fmt.Sprint(res.PageSize),
+			PagesRemaining: fmt.Sprint(res.MinimumPagesRemaining),
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "", "", "page_number"); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // FromDate optional. The earliest date to return agreements from.
-func (op *GetClickwrapsOp) FromDate(val string) *GetClickwrapsOp {
+func (op *GetClickwrapsOp) FromDate(val time.Time) *GetClickwrapsOp {
 	if op != nil {
-		op.QueryOpts.Set("from_date", val)
+		op.QueryOpts.Set("from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -659,9 +801,9 @@ func (op *GetClickwrapsOp) Status(val string) *GetClickwrapsOp {
 }
 
 // ToDate optional. The latest date to return agreements from.
-func (op *GetClickwrapsOp) ToDate(val string) *GetClickwrapsOp {
+func (op *GetClickwrapsOp) ToDate(val time.Time) *GetClickwrapsOp {
 	if op != nil {
-		op.QueryOpts.Set("to_date", val)
+		op.QueryOpts.Set("to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -768,3 +910,13 @@ func (op *UpdateClickwrapVersionByNumberOp) Do(ctx context.Context) (ClickwrapVe
 	var res ClickwrapVersionSummaryResponse
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
+
+// Status is a value of the status query option.
+type Status string
+
+// Status values
+const (
+	StatusActive   Status = "active"
+	StatusInactive Status = "inactive"
+	StatusDeleted  Status = "deleted"
+)
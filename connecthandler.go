@@ -0,0 +1,268 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+// connecthandler.go contains an http.Handler for receiving
+// Connect messages. see:
+// https://developers.docusign.com/platform/webhooks/connect/hmac/
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"hash"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// DefaultConnectMaxBodySize is the largest Connect message accepted by a
+// ConnectHandler with a zero MaxBodySize.  Messages containing documents
+// may be quite large.
+const DefaultConnectMaxBodySize int64 = 64 << 20
+
+// ErrConnectSignature indicates that a Connect message does not
+// contain a valid HMAC signature.
+var ErrConnectSignature = errors.New("invalid or missing connect signature")
+
+// ConnectMessage is a verified Connect message passed to a
+// ConnectHandler's Handle func.
+type ConnectMessage struct {
+	// Header contains the headers of the Connect request
+	Header http.Header
+	// Body is the unaltered message.  Body is nil for XML messages
+	// when the handler's StreamXML is true.
+	Body []byte
+	// Stream reads the unaltered XML message when the handler's
+	// StreamXML is true, otherwise nil.  Stream is only valid until
+	// Handle returns.
+	Stream io.Reader
+	// XML contains the decoded message for legacy XML
+	// configurations, otherwise nil.  XML is also nil when
+	// the handler's StreamXML is true.
+	XML *ConnectData
+	// JSON contains the message for JSON (restv2.1) configurations,
+	// otherwise nil.
+	JSON json.RawMessage
+}
+
+// ConnectHandler is an http.Handler that receives Connect messages,
+// verifies their HMAC signatures and passes the decoded message to
+// the Handle func.  The handler responds with a 200 when Handle returns
+// nil and a 500 when Handle returns an error, causing DocuSign to retry
+// the message.  Invalid requests receive a 4xx response.
+type ConnectHandler struct {
+	// Secrets contains the HMAC keys of the Connect configuration.  A message
+	// is accepted when any X-DocuSign-Signature-N header matches the signature
+	// computed using any of the secrets.  Multiple secrets allow for key
+	// rotation.  If empty, every message is rejected unless
+	// SkipVerification is true.
+	Secrets []string
+	// SkipVerification accepts messages without checking signatures.
+	// Only set when the Connect configuration does not use HMAC and
+	// requests are authenticated by other means.
+	SkipVerification bool
+	// MaxBodySize is the largest message accepted.  If zero,
+	// DefaultConnectMaxBodySize is used.
+	MaxBodySize int64
+	// StreamXML spools messages to a temporary file rather than
+	// reading them into memory.  XML messages are not decoded, and
+	// Handle should pass msg.Stream to DecodeConnectXML so that large
+	// documents are never held in memory.
+	StreamXML bool
+	// Handle processes each message.
+	Handle func(ctx context.Context, msg *ConnectMessage) error
+	// ErrorFunc, if not nil, is called with each error before
+	// the error response is written.
+	ErrorFunc func(r *http.Request, status int, err error)
+}
+
+// ServeHTTP reads, verifies and decodes the Connect message
+// then calls h.Handle.
+func (h *ConnectHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		h.fail(w, r, http.StatusMethodNotAllowed, errors.New("connect message must be a POST"))
+		return
+	}
+	if h.Handle == nil {
+		h.fail(w, r, http.StatusInternalServerError, errors.New("connect handler has no Handle func"))
+		return
+	}
+	if len(h.Secrets) == 0 && !h.SkipVerification {
+		h.fail(w, r, http.StatusInternalServerError, errors.New("connect handler has no Secrets"))
+		return
+	}
+	maxSize := h.MaxBodySize
+	if maxSize <= 0 {
+		maxSize = DefaultConnectMaxBodySize
+	}
+	var buf bytes.Buffer
+	var dst io.Writer = &buf
+	if h.StreamXML {
+		f, err := ioutil.TempFile("", "connect-*.msg")
+		if err != nil {
+			h.fail(w, r, http.StatusInternalServerError, err)
+			return
+		}
+		defer os.Remove(f.Name())
+		defer f.Close()
+		dst = f
+	}
+	// compute signatures while reading to avoid a second pass over the body
+	macs := make([]hash.Hash, len(h.Secrets))
+	writers := []io.Writer{dst}
+	for i, secret := range h.Secrets {
+		macs[i] = hmac.New(sha256.New, []byte(secret))
+		writers = append(writers, macs[i])
+	}
+	n, err := io.Copy(io.MultiWriter(writers...), io.LimitReader(r.Body, maxSize+1))
+	if err != nil {
+		h.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+	if n > maxSize {
+		h.fail(w, r, http.StatusRequestEntityTooLarge, errors.New("connect message exceeds "+strconv.FormatInt(maxSize, 10)+" bytes"))
+		return
+	}
+	if !h.SkipVerification && !matchConnectSignature(macs, r.Header) {
+		h.fail(w, r, http.StatusUnauthorized, ErrConnectSignature)
+		return
+	}
+	var msg *ConnectMessage
+	if f, ok := dst.(*os.File); ok {
+		msg, err = streamConnectMessage(f, r.Header)
+	} else {
+		msg, err = decodeConnectMessage(buf.Bytes(), r.Header)
+	}
+	if err != nil {
+		h.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+	if err = h.Handle(r.Context(), msg); err != nil {
+		h.fail(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *ConnectHandler) fail(w http.ResponseWriter, r *http.Request, status int, err error) {
+	if h.ErrorFunc != nil {
+		h.ErrorFunc(r, status, err)
+	}
+	http.Error(w, http.StatusText(status), status)
+}
+
+// decodeConnectMessage determines the format of the message using the
+// Content-Type header, falling back to the first character of body.
+func decodeConnectMessage(body []byte, hdr http.Header) (*ConnectMessage, error) {
+	msg := &ConnectMessage{Header: hdr, Body: body}
+	ct := strings.ToLower(hdr.Get("Content-Type"))
+	trimmed := bytes.TrimSpace(body)
+	switch {
+	case strings.Contains(ct, "json"), !strings.Contains(ct, "xml") && bytes.HasPrefix(trimmed, []byte("{")):
+		if !json.Valid(trimmed) {
+			return nil, errors.New("connect message contains invalid json")
+		}
+		msg.JSON = json.RawMessage(trimmed)
+	case strings.Contains(ct, "xml"), bytes.HasPrefix(trimmed, []byte("<")):
+		msg.XML = &ConnectData{}
+		if err := xml.Unmarshal(body, msg.XML); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("connect message is neither xml nor json")
+	}
+	return msg, nil
+}
+
+// streamConnectMessage returns a message whose Stream reads the spooled
+// file f when the message is XML.  Other messages are decoded from
+// memory.
+func streamConnectMessage(f *os.File, hdr http.Header) (*ConnectMessage, error) {
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	br := bufio.NewReader(f)
+	ct := strings.ToLower(hdr.Get("Content-Type"))
+	if !strings.Contains(ct, "json") && (strings.Contains(ct, "xml") || firstByte(br) == '<') {
+		return &ConnectMessage{Header: hdr, Stream: br}, nil
+	}
+	body, err := ioutil.ReadAll(br)
+	if err != nil {
+		return nil, err
+	}
+	return decodeConnectMessage(body, hdr)
+}
+
+// firstByte returns the first non-space byte of br without consuming it.
+func firstByte(br *bufio.Reader) byte {
+	for i := 1; ; i++ {
+		b, err := br.Peek(i)
+		if len(b) < i || err != nil {
+			return 0
+		}
+		if c := b[i-1]; c != ' ' && c != '\t' && c != '\r' && c != '\n' {
+			return c
+		}
+	}
+}
+
+// matchConnectSignature compares the sums of macs to the
+// X-DocuSign-Signature-N headers in hdr.
+func matchConnectSignature(macs []hash.Hash, hdr http.Header) bool {
+	var sigs [][]byte
+	for i := 1; ; i++ {
+		val := hdr.Get("X-DocuSign-Signature-" + strconv.Itoa(i))
+		if val == "" {
+			break
+		}
+		if sig, err := base64.StdEncoding.DecodeString(val); err == nil {
+			sigs = append(sigs, sig)
+		}
+	}
+	var matched bool
+	for _, mac := range macs {
+		sum := mac.Sum(nil)
+		for _, sig := range sigs {
+			// check every combination to avoid leaking timing information
+			if hmac.Equal(sum, sig) {
+				matched = true
+			}
+		}
+	}
+	return matched
+}
+
+// VerifyConnectSignature returns true if any of the X-DocuSign-Signature-N
+// headers in hdr matches the HMAC-SHA256 signature of body computed with
+// any of the secrets.  Comparisons are made in constant time.
+func VerifyConnectSignature(body []byte, hdr http.Header, secrets ...string) bool {
+	macs := make([]hash.Hash, len(secrets))
+	for i, secret := range secrets {
+		macs[i] = hmac.New(sha256.New, []byte(secret))
+		macs[i].Write(body)
+	}
+	return matchConnectSignature(macs, hdr)
+}
+
+// ConnectSignature returns the HMAC-SHA256 signature of body using
+// secret.  The base64 encoding of the result is the value DocuSign
+// sends in the X-DocuSign-Signature-N headers.
+func ConnectSignature(body []byte, secret string) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,187 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfcote87/esign"
+)
+
+func signConnect(body []byte, secret string) string {
+	return base64.StdEncoding.EncodeToString(esign.ConnectSignature(body, secret))
+}
+
+func TestConnectHandler(t *testing.T) {
+	xmlBody, err := ioutil.ReadFile("testdata/connect.xml")
+	if err != nil {
+		t.Fatalf("read connect.xml: %v", err)
+	}
+	jsonBody := []byte(`{"event":"envelope-completed","apiVersion":"v2.1"}`)
+
+	var lastMsg *esign.ConnectMessage
+	var handleErr error
+	h := &esign.ConnectHandler{
+		Secrets:     []string{"old secret", "new secret"},
+		MaxBodySize: int64(len(xmlBody)),
+		Handle: func(ctx context.Context, msg *esign.ConnectMessage) error {
+			lastMsg = msg
+			return handleErr
+		},
+	}
+
+	tests := []struct {
+		name        string
+		method      string
+		body        []byte
+		contentType string
+		sigs        []string
+		handleErr   error
+		wantStatus  int
+		wantXML     bool
+		wantJSON    bool
+	}{
+		{name: "xml", body: xmlBody, contentType: "text/xml", sigs: []string{signConnect(xmlBody, "new secret")},
+			wantStatus: 200, wantXML: true},
+		{name: "json second signature", body: jsonBody, contentType: "application/json",
+			sigs: []string{signConnect(jsonBody, "other"), signConnect(jsonBody, "old secret")}, wantStatus: 200, wantJSON: true},
+		{name: "json no content type", body: jsonBody, sigs: []string{signConnect(jsonBody, "old secret")},
+			wantStatus: 200, wantJSON: true},
+		{name: "missing signature", body: jsonBody, contentType: "application/json", wantStatus: 401},
+		{name: "invalid signature", body: jsonBody, contentType: "application/json",
+			sigs: []string{signConnect(jsonBody, "other")}, wantStatus: 401},
+		{name: "altered body", body: jsonBody[1:], contentType: "application/json",
+			sigs: []string{signConnect(jsonBody, "new secret")}, wantStatus: 401},
+		{name: "method", method: "GET", wantStatus: 405},
+		{name: "too large", body: append(xmlBody, ' '), contentType: "text/xml", wantStatus: 413},
+		{name: "bad json", body: []byte("{bad"), contentType: "application/json",
+			sigs: []string{signConnect([]byte("{bad"), "new secret")}, wantStatus: 400},
+		{name: "handle error", body: jsonBody, contentType: "application/json", sigs: []string{signConnect(jsonBody, "new secret")},
+			handleErr: errors.New("retry"), wantStatus: 500, wantJSON: true},
+	}
+	for _, tt := range tests {
+		lastMsg, handleErr = nil, tt.handleErr
+		method := tt.method
+		if method == "" {
+			method = "POST"
+		}
+		r := httptest.NewRequest(method, "/connect", bytes.NewReader(tt.body))
+		if tt.contentType > "" {
+			r.Header.Set("Content-Type", tt.contentType)
+		}
+		for i, sig := range tt.sigs {
+			r.Header.Set("X-DocuSign-Signature-"+string(rune('1'+i)), sig)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		if w.Code != tt.wantStatus {
+			t.Errorf("%s: expected status %d; got %d", tt.name, tt.wantStatus, w.Code)
+			continue
+		}
+		if tt.wantXML && (lastMsg == nil || lastMsg.XML == nil || lastMsg.XML.EnvelopeStatus.EnvelopeID == "") {
+			t.Errorf("%s: expected decoded xml; got %#v", tt.name, lastMsg)
+		}
+		if tt.wantJSON && (lastMsg == nil || lastMsg.JSON == nil || lastMsg.XML != nil) {
+			t.Errorf("%s: expected json message; got %#v", tt.name, lastMsg)
+		}
+	}
+}
+
+func TestConnectHandler_NoSecrets(t *testing.T) {
+	body := []byte(`{"event":"envelope-completed"}`)
+	var handled int
+	h := &esign.ConnectHandler{
+		Handle: func(ctx context.Context, msg *esign.ConnectMessage) error {
+			handled++
+			return nil
+		},
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("POST", "/connect", bytes.NewReader(body)))
+	if w.Code != http.StatusInternalServerError || handled != 0 {
+		t.Errorf("expected rejected message without secrets; got %d with %d handled", w.Code, handled)
+	}
+	h.SkipVerification = true
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("POST", "/connect", bytes.NewReader(body)))
+	if w.Code != http.StatusOK || handled != 1 {
+		t.Errorf("expected unverified message with SkipVerification; got %d with %d handled", w.Code, handled)
+	}
+}
+
+func TestConnectHandler_StreamXML(t *testing.T) {
+	pdf := bytes.Repeat([]byte("%PDF-1.4 streamed document "), 1000)
+	xmlBody := connectWithPDFs(t, map[string][]byte{"doc.pdf": pdf}, "doc.pdf")
+	jsonBody := []byte(`{"event":"envelope-completed"}`)
+
+	var got []byte
+	var lastMsg *esign.ConnectMessage
+	h := &esign.ConnectHandler{
+		Secrets:   []string{"secret"},
+		StreamXML: true,
+		Handle: func(ctx context.Context, msg *esign.ConnectMessage) error {
+			lastMsg = msg
+			if msg.Stream == nil {
+				return nil
+			}
+			return esign.DecodeConnectXML(msg.Stream, nil, func(name string, r io.Reader) error {
+				var err error
+				got, err = ioutil.ReadAll(r)
+				return err
+			})
+		},
+	}
+	r := httptest.NewRequest("POST", "/connect", bytes.NewReader(xmlBody))
+	r.Header.Set("X-DocuSign-Signature-1", signConnect(xmlBody, "secret"))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusOK || lastMsg == nil || lastMsg.Body != nil || lastMsg.XML != nil {
+		t.Fatalf("expected streamed xml message; got %d %#v", w.Code, lastMsg)
+	}
+	if !bytes.Equal(got, pdf) {
+		t.Errorf("expected %d pdf bytes; got %d", len(pdf), len(got))
+	}
+
+	r = httptest.NewRequest("POST", "/connect", bytes.NewReader(jsonBody))
+	r.Header.Set("X-DocuSign-Signature-1", signConnect(jsonBody, "secret"))
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusOK || lastMsg.Stream != nil || string(lastMsg.JSON) != string(jsonBody) {
+		t.Errorf("expected json message in memory; got %d %#v", w.Code, lastMsg)
+	}
+
+	r = httptest.NewRequest("POST", "/connect", bytes.NewReader(xmlBody))
+	r.Header.Set("X-DocuSign-Signature-1", signConnect(xmlBody[1:], "secret"))
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401 for invalid signature; got %d", w.Code)
+	}
+}
+
+func TestVerifyConnectSignature(t *testing.T) {
+	body := []byte("message")
+	hdr := make(http.Header)
+	if esign.VerifyConnectSignature(body, hdr, "secret") {
+		t.Errorf("expected false with no signature headers")
+	}
+	hdr.Set("X-DocuSign-Signature-1", "not base64!")
+	hdr.Set("X-DocuSign-Signature-2", signConnect(body, "secret"))
+	if !esign.VerifyConnectSignature(body, hdr, "secret") {
+		t.Errorf("expected signature 2 to match")
+	}
+	if esign.VerifyConnectSignature(body, hdr) {
+		t.Errorf("expected false with no secrets")
+	}
+}
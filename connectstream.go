@@ -0,0 +1,197 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+// connectstream.go contains a streaming decoder for Connect XML
+// messages that include documents.
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"io"
+	"io/ioutil"
+)
+
+// DecodeConnectXML reads a Connect XML message from r without holding the
+// message's documents in memory.  statusFunc is called with the message's
+// EnvelopeStatus, then pdfFunc is called for each DocumentPDF with the
+// document name and a reader returning the decoded pdf.  The reader is
+// only valid until pdfFunc returns.  PDFBytes are decoded directly from r
+// so that memory use does not depend upon the size of the documents.
+// Either func may be nil, and an error returned by either func ends
+// decoding and is returned by DecodeConnectXML.
+func DecodeConnectXML(r io.Reader, statusFunc func(*EnvelopeStatusXML) error, pdfFunc func(name string, pdf io.Reader) error) error {
+	// xml.Decoder reads byte by byte from an io.ByteReader, leaving
+	// the PDFBytes text unread in cr after the element's StartElement.
+	cr := &connectReader{br: bufio.NewReader(r)}
+	d := xml.NewDecoder(cr)
+	var hasStatus bool
+	for {
+		tk, err := d.Token()
+		if err == io.EOF {
+			if !hasStatus {
+				return errors.New("connect message has no EnvelopeStatus")
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		se, ok := tk.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch se.Name.Local {
+		case "EnvelopeStatus":
+			var status EnvelopeStatusXML
+			if err = d.DecodeElement(&status, &se); err != nil {
+				return err
+			}
+			hasStatus = true
+			if statusFunc != nil {
+				if err = statusFunc(&status); err != nil {
+					return err
+				}
+			}
+		case "DocumentPDF":
+			if !hasStatus {
+				return errors.New("connect message DocumentPDF precedes EnvelopeStatus")
+			}
+			if err = decodeDocumentPDF(d, cr, pdfFunc); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// decodeDocumentPDF reads the elements of a DocumentPDF, calling
+// pdfFunc when the PDFBytes element is reached.
+func decodeDocumentPDF(d *xml.Decoder, cr *connectReader, pdfFunc func(string, io.Reader) error) error {
+	var name string
+	for {
+		tk, err := d.Token()
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		switch t := tk.(type) {
+		case xml.EndElement:
+			return nil
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "Name":
+				if err = d.DecodeElement(&name, &t); err != nil {
+					return err
+				}
+				continue
+			case "PDFBytes":
+				if err = decodePDFBytes(d, cr, name, pdfFunc); err != nil {
+					return err
+				}
+				continue
+			}
+			if err = d.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// decodePDFBytes passes the base64 decoded text following a PDFBytes
+// StartElement to pdfFunc then consumes the element's EndElement.
+func decodePDFBytes(d *xml.Decoder, cr *connectReader, name string, pdfFunc func(string, io.Reader) error) error {
+	tr := &textReader{br: cr.br, done: cr.selfClosed()}
+	if pdfFunc != nil {
+		if err := pdfFunc(name, base64.NewDecoder(base64.StdEncoding, tr)); err != nil {
+			return err
+		}
+	}
+	// skip unread data
+	if _, err := io.Copy(ioutil.Discard, tr); err != nil {
+		return err
+	}
+	tk, err := d.Token()
+	if err != nil {
+		return unexpectedEOF(err)
+	}
+	if _, ok := tk.(xml.EndElement); !ok {
+		return errors.New("connect message PDFBytes must contain only base64 text")
+	}
+	return nil
+}
+
+// connectReader is the io.ByteReader used by DecodeConnectXML's
+// xml.Decoder.  It remembers the last two bytes read to detect
+// self-closing elements.
+type connectReader struct {
+	br         *bufio.Reader
+	prev, last byte
+}
+
+// ReadByte returns the next byte of the message.
+func (cr *connectReader) ReadByte() (byte, error) {
+	b, err := cr.br.ReadByte()
+	if err == nil {
+		cr.prev, cr.last = cr.last, b
+	}
+	return b, err
+}
+
+// Read is required by xml.NewDecoder, which only uses ReadByte.
+func (cr *connectReader) Read(p []byte) (int, error) {
+	return cr.br.Read(p)
+}
+
+// selfClosed reports whether the last StartElement ended with "/>".
+func (cr *connectReader) selfClosed() bool {
+	return cr.prev == '/' && cr.last == '>'
+}
+
+// textReader returns the raw text of an element up to the next '<',
+// leaving the '<' unread for the xml.Decoder.
+type textReader struct {
+	br   *bufio.Reader
+	done bool
+}
+
+// Read returns buffered text without copying the element's
+// complete text into memory.
+func (tr *textReader) Read(p []byte) (int, error) {
+	if tr.done {
+		return 0, io.EOF
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if tr.br.Buffered() == 0 {
+		if _, err := tr.br.Peek(1); err != nil {
+			return 0, unexpectedEOF(err)
+		}
+	}
+	n := tr.br.Buffered()
+	if n > len(p) {
+		n = len(p)
+	}
+	buf, _ := tr.br.Peek(n)
+	if idx := bytes.IndexByte(buf, '<'); idx >= 0 {
+		buf, tr.done = buf[:idx], true
+	}
+	n = copy(p, buf)
+	tr.br.Discard(n)
+	if n == 0 {
+		return 0, io.EOF
+	}
+	return n, nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,107 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/jfcote87/esign"
+)
+
+// connectWithPDFs appends DocumentPDFs to the testdata message
+func connectWithPDFs(t *testing.T, pdfs map[string][]byte, names ...string) []byte {
+	b, err := ioutil.ReadFile("testdata/connect.xml")
+	if err != nil {
+		t.Fatalf("read connect.xml: %v", err)
+	}
+	var sb strings.Builder
+	sb.WriteString("<DocumentPDFs>")
+	for _, nm := range names {
+		enc := base64.StdEncoding.EncodeToString(pdfs[nm])
+		// wrap lines as DocuSign does
+		var wrapped []string
+		for len(enc) > 76 {
+			wrapped, enc = append(wrapped, enc[:76]), enc[76:]
+		}
+		wrapped = append(wrapped, enc)
+		sb.WriteString("<DocumentPDF><Name>" + nm + "</Name><PDFBytes>" + strings.Join(wrapped, "\r\n") +
+			"</PDFBytes><DocumentType>CONTENT</DocumentType></DocumentPDF>")
+	}
+	sb.WriteString("</DocumentPDFs></DocuSignEnvelopeInformation>")
+	return bytes.Replace(b, []byte("</DocuSignEnvelopeInformation>"), []byte(sb.String()), 1)
+}
+
+func TestDecodeConnectXML(t *testing.T) {
+	pdfs := map[string][]byte{
+		"doc1.pdf": bytes.Repeat([]byte("%PDF-1.4 first document "), 500),
+		"doc2.pdf": []byte("%PDF-1.4 second"),
+		"doc3.pdf": bytes.Repeat([]byte("%PDF-1.4 third document "), 100),
+	}
+	msg := connectWithPDFs(t, pdfs, "doc1.pdf", "doc2.pdf", "doc3.pdf")
+
+	var order []string
+	err := esign.DecodeConnectXML(bytes.NewReader(msg), func(st *esign.EnvelopeStatusXML) error {
+		order = append(order, "status:"+st.DocumentStatuses[0].Name)
+		return nil
+	}, func(name string, pdf io.Reader) error {
+		order = append(order, name)
+		if name == "doc2.pdf" {
+			// leave unread to verify remaining data is skipped
+			return nil
+		}
+		b, err := ioutil.ReadAll(pdf)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(b, pdfs[name]) {
+			t.Errorf("%s: expected %d bytes; got %d", name, len(pdfs[name]), len(b))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("expected success; got %v", err)
+	}
+	if strings.Join(order, ",") != "status:Docusign1.pdf,doc1.pdf,doc2.pdf,doc3.pdf" {
+		t.Errorf("expected status then 3 documents; got %v", order)
+	}
+
+	errStop := errors.New("stop")
+	var cnt int
+	if err = esign.DecodeConnectXML(bytes.NewReader(msg), nil, func(name string, pdf io.Reader) error {
+		cnt++
+		return errStop
+	}); err != errStop || cnt != 1 {
+		t.Errorf("expected stop error after 1 document; got %v %d", err, cnt)
+	}
+
+	if err = esign.DecodeConnectXML(bytes.NewReader(msg[:len(msg)-200]), nil, nil); err == nil {
+		t.Errorf("expected error for truncated message")
+	}
+	if err = esign.DecodeConnectXML(strings.NewReader("<DocuSignEnvelopeInformation><DocumentPDFs><DocumentPDF><Name>a</Name></DocumentPDF></DocumentPDFs></DocuSignEnvelopeInformation>"), nil, nil); err == nil {
+		t.Errorf("expected error for DocumentPDF without EnvelopeStatus")
+	}
+
+	pre := "<DocuSignEnvelopeInformation><EnvelopeStatus><EnvelopeID>1</EnvelopeID></EnvelopeStatus><DocumentPDFs><DocumentPDF><Name>a</Name>"
+	post := "<DocumentType>CONTENT</DocumentType></DocumentPDF></DocumentPDFs></DocuSignEnvelopeInformation>"
+	var sizes []int
+	pdfSize := func(name string, pdf io.Reader) error {
+		b, err := ioutil.ReadAll(pdf)
+		sizes = append(sizes, len(b))
+		return err
+	}
+	if err = esign.DecodeConnectXML(strings.NewReader(pre+"<PDFBytes/>"+post), nil, pdfSize); err != nil || len(sizes) != 1 || sizes[0] != 0 {
+		t.Errorf("expected empty document for self-closing PDFBytes; got %v %v", err, sizes)
+	}
+	if err = esign.DecodeConnectXML(strings.NewReader(pre+"<PDFBytes>QUJD<b/>RA==</PDFBytes>"+post), nil, nil); err == nil {
+		t.Errorf("expected error for element within PDFBytes")
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+// errors.go contains the classification of DocuSign error responses.
+
+import (
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/jfcote87/ctxclient"
+)
+
+// Sentinel errors for classifying a *ResponseError with errors.Is.
+//
+//   if errors.Is(err, esign.ErrNotFound) {
+//       // envelope was deleted or never existed
+//   }
+var (
+	// ErrNotFound indicates the requested resource does not exist
+	ErrNotFound = errors.New("resource not found")
+	// ErrRateLimited indicates an hourly or burst api limit was exceeded
+	ErrRateLimited = errors.New("api rate limit exceeded")
+	// ErrAuth indicates an invalid token or insufficient permissions
+	ErrAuth = errors.New("authorization failed")
+	// ErrValidation indicates DocuSign rejected the request's content
+	ErrValidation = errors.New("invalid request")
+	// ErrRetryable indicates the request may succeed if sent again later
+	ErrRetryable = errors.New("retryable error")
+)
+
+// errorCodeCategory maps DocuSign error codes to a sentinel error.  Most
+// DocuSign errors are returned with a 400 status, so the code determines
+// the category rather than the status.
+var errorCodeCategory = map[string]error{
+	"ENVELOPE_DOES_NOT_EXIST":             ErrNotFound,
+	"DOCUMENT_DOES_NOT_EXIST":             ErrNotFound,
+	"TEMPLATE_ID_INVALID":                 ErrNotFound,
+	"USER_DOES_NOT_EXIST_IN_SYSTEM":       ErrNotFound,
+	"RESOURCE_NOT_FOUND":                  ErrNotFound,
+	"HOURLY_APIINVOCATION_LIMIT_EXCEEDED": ErrRateLimited,
+	"BURST_APIINVOCATION_LIMIT_EXCEEDED":  ErrRateLimited,
+	"AUTHORIZATION_INVALID_TOKEN":         ErrAuth,
+	"USER_AUTHENTICATION_FAILED":          ErrAuth,
+	"PARTNER_AUTHENTICATION_FAILED":       ErrAuth,
+	"USER_LACKS_PERMISSIONS":              ErrAuth,
+	"USER_LACKS_MEMBERSHIP":               ErrAuth,
+	"ACCOUNT_LACKS_PERMISSIONS":           ErrAuth,
+}
+
+// category returns the sentinel error describing r.
+func (r ResponseError) category() error {
+	if cat, ok := errorCodeCategory[r.ErrorCode]; ok {
+		return cat
+	}
+	switch r.Status {
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrAuth
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return ErrValidation
+	}
+	return nil
+}
+
+// Is reports whether r belongs to the category of target, one of
+// ErrNotFound, ErrRateLimited, ErrAuth, ErrValidation or ErrRetryable.
+func (r ResponseError) Is(target error) bool {
+	if target == ErrRetryable {
+		return r.retryable()
+	}
+	cat := r.category()
+	return cat != nil && cat == target
+}
+
+func (r ResponseError) retryable() bool {
+	switch r.Status {
+	case http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return r.category() == ErrRateLimited
+}
+
+// Unwrap returns the error from which r was created.
+func (r ResponseError) Unwrap() error {
+	return r.OriginalErr
+}
+
+// IsNotFound reports whether err indicates a missing resource.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsRateLimited reports whether err indicates an exceeded api limit.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsAuth reports whether err indicates an authorization failure.
+func IsAuth(err error) bool {
+	return errors.Is(err, ErrAuth)
+}
+
+// IsValidation reports whether err indicates an invalid request.
+func IsValidation(err error) bool {
+	return errors.Is(err, ErrValidation)
+}
+
+// IsRetryable reports whether err is a rate limit, server unavailable
+// or network timeout error.
+func IsRetryable(err error) bool {
+	if errors.Is(err, ErrRetryable) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// ToResponseError converts a *ctxclient.NotSuccess returned when sending
+// req into a *ResponseError containing the request's method and url, the
+// response headers and the DocuSign trace token.  Other errors are
+// returned unchanged.
+func ToResponseError(req *http.Request, err error) error {
+	var nsErr *ctxclient.NotSuccess
+	if !errors.As(err, &nsErr) {
+		return err
+	}
+	re := NewResponseError(nsErr.Body, nsErr.StatusCode)
+	re.Header = nsErr.Header
+	re.OriginalErr = nsErr
+	re.TraceToken = nsErr.Header.Get("X-DocuSign-TraceToken")
+	if req != nil {
+		re.Method = req.Method
+		if req.URL != nil {
+			re.URL = req.URL.String()
+		}
+	}
+	return re
+}
@@ -0,0 +1,83 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jfcote87/ctxclient"
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/esigntest"
+	"github.com/jfcote87/esign/v2.1/envelopes"
+)
+
+type timeoutErr struct{}
+
+func (timeoutErr) Error() string   { return "timeout" }
+func (timeoutErr) Timeout() bool   { return true }
+func (timeoutErr) Temporary() bool { return true }
+
+func TestResponseError_Is(t *testing.T) {
+	tests := []struct {
+		err                                           error
+		notFound, rateLimited, auth, valid, retryable bool
+	}{
+		{err: &esign.ResponseError{Status: 400, ErrorCode: "ENVELOPE_DOES_NOT_EXIST"}, notFound: true},
+		{err: &esign.ResponseError{Status: 404}, notFound: true},
+		{err: &esign.ResponseError{Status: 400, ErrorCode: "HOURLY_APIINVOCATION_LIMIT_EXCEEDED"}, rateLimited: true, retryable: true},
+		{err: &esign.ResponseError{Status: 429}, rateLimited: true, retryable: true},
+		{err: &esign.ResponseError{Status: 401, ErrorCode: "AUTHORIZATION_INVALID_TOKEN"}, auth: true},
+		{err: &esign.ResponseError{Status: 400, ErrorCode: "USER_LACKS_PERMISSIONS"}, auth: true},
+		{err: &esign.ResponseError{Status: 400, ErrorCode: "INVALID_EMAIL_ADDRESS_FOR_RECIPIENT"}, valid: true},
+		{err: &esign.ResponseError{Status: 503}, retryable: true},
+		{err: fmt.Errorf("wrapped: %w", esign.ResponseError{Status: 404}), notFound: true},
+		{err: fmt.Errorf("send: %w", timeoutErr{}), retryable: true},
+		{err: errors.New("other")},
+	}
+	for i, tt := range tests {
+		got := []bool{esign.IsNotFound(tt.err), esign.IsRateLimited(tt.err), esign.IsAuth(tt.err), esign.IsValidation(tt.err), esign.IsRetryable(tt.err)}
+		want := []bool{tt.notFound, tt.rateLimited, tt.auth, tt.valid, tt.retryable}
+		for j := range got {
+			if got[j] != want[j] {
+				t.Errorf("test %d: expected %v; got %v", i, want, got)
+				break
+			}
+		}
+	}
+}
+
+func TestToResponseError(t *testing.T) {
+	srv := esigntest.NewServer()
+	defer srv.Close()
+	_, err := envelopes.New(srv.Credential()).Get("missing").Do(context.Background())
+	if !esign.IsNotFound(err) {
+		t.Fatalf("expected not found error; got %v", err)
+	}
+	var re *esign.ResponseError
+	if !errors.As(err, &re) {
+		t.Fatalf("expected *ResponseError; got %#v", err)
+	}
+	if re.Method != "GET" || !strings.HasSuffix(re.URL, "/envelopes/missing") {
+		t.Errorf("expected GET .../envelopes/missing; got %s %s", re.Method, re.URL)
+	}
+	if re.TraceToken == "" || re.TraceToken != re.Header.Get("X-DocuSign-TraceToken") {
+		t.Errorf("expected trace token from header; got %q", re.TraceToken)
+	}
+	if !strings.Contains(re.Error(), re.TraceToken) {
+		t.Errorf("expected trace token in error message; got %s", re.Error())
+	}
+	var nsErr *ctxclient.NotSuccess
+	if !errors.As(err, &nsErr) || nsErr.StatusCode != re.Status {
+		t.Errorf("expected original *ctxclient.NotSuccess; got %#v", re.OriginalErr)
+	}
+	if err := esign.ToResponseError(nil, nil); err != nil {
+		t.Errorf("expected nil; got %v", err)
+	}
+}
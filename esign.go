@@ -159,21 +159,38 @@ type Op struct {
 	Accept string
 	// Leave nil for v2
 	Version APIVersion
+	// ValidatePayload causes Do to return the error of the Payload's
+	// Validate method, if any, without sending the request.  Models of
+	// the eSignature, Click and Monitor APIs have no Validate methods.
+	ValidatePayload bool
 }
 
-// ResponseError describes DocuSign's server error response.
+// ResponseError describes DocuSign's server error response.  Use
+// errors.Is with ErrNotFound, ErrRateLimited, ErrAuth, ErrValidation
+// or ErrRetryable to classify the error.
 // https://developers.docusign.com/esign-rest-api/guides/status-and-error-codes#general-error-response-handling
 type ResponseError struct {
-	ErrorCode   string `json:"errorCode,omitempty"`
-	Description string `json:"message,omitempty"`
-	Status      int    `json:"-"`
-	Raw         []byte `json:"-"`
-	OriginalErr error  `json:"-"`
+	ErrorCode   string      `json:"errorCode,omitempty"`
+	Description string      `json:"message,omitempty"`
+	Status      int         `json:"-"`
+	Raw         []byte      `json:"-"`
+	OriginalErr error       `json:"-"`
+	Header      http.Header `json:"-"`
+	// Method and URL of the failed request
+	Method string `json:"-"`
+	URL    string `json:"-"`
+	// TraceToken is the X-DocuSign-TraceToken response header value
+	// needed by DocuSign support to find the request.
+	TraceToken string `json:"-"`
 }
 
 // Error fulfills error interface
 func (r ResponseError) Error() string {
-	return fmt.Sprintf("Status: %d  %s: %s", r.Status, r.ErrorCode, r.Description)
+	msg := fmt.Sprintf("Status: %d  %s: %s", r.Status, r.ErrorCode, r.Description)
+	if r.TraceToken > "" {
+		msg += " (trace token " + r.TraceToken + ")"
+	}
+	return msg
 }
 
 // NewResponseError unmarshals buff, containing a DocuSign server error,
@@ -270,6 +287,9 @@ func (op *Op) validate(ctx context.Context) error {
 			}
 		}
 	}
+	if err == nil && op.ValidatePayload {
+		err = validatePayload(op.Payload)
+	}
 	if err != nil {
 		op.closeFiles()
 	}
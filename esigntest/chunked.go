@@ -0,0 +1,142 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esigntest
+
+// chunked.go contains the handlers for chunked uploads.
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+const chunkedUploadScheme = "docusignchunkedupload://"
+
+type chunkedUpload struct {
+	id        string
+	parts     map[int][]byte
+	committed bool
+}
+
+func (cu *chunkedUpload) response() *model.ChunkedUploadResponse {
+	res := &model.ChunkedUploadResponse{
+		ChunkedUploadID:  cu.id,
+		ChunkedUploadURI: chunkedUploadScheme + cu.id,
+		Committed:        model.Bool(strconv.FormatBool(cu.committed)),
+	}
+	total := 0
+	for _, seq := range cu.sequences() {
+		res.ChunkedUploadParts = append(res.ChunkedUploadParts, model.ChunkedUploadPart{
+			Sequence: strconv.Itoa(seq),
+			Size:     strconv.Itoa(len(cu.parts[seq])),
+		})
+		total += len(cu.parts[seq])
+	}
+	res.TotalSize = strconv.Itoa(total)
+	return res
+}
+
+func (cu *chunkedUpload) sequences() []int {
+	var seqs []int
+	for seq := range cu.parts {
+		seqs = append(seqs, seq)
+	}
+	sort.Ints(seqs)
+	return seqs
+}
+
+func chunkedUploadNotFound(id string) *apiError {
+	return newError("CHUNKED_UPLOAD_NOT_FOUND", "The chunked upload specified was not found. %s", id)
+}
+
+// chunkedUploads handles the chunked_uploads calls.  segments
+// contains the path elements following chunked_uploads.
+func (s *Server) chunkedUploads(r *http.Request, segments []string) (int, interface{}, *apiError) {
+	var req *model.ChunkedUploadRequest
+	if r.Method == "POST" || (r.Method == "PUT" && len(segments) == 2) {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req == nil || len(req.Data) == 0 {
+			return 0, nil, newError("INVALID_REQUEST_BODY", "The request body is missing or improperly formatted. chunk data is required")
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(segments) == 0 {
+		if r.Method != "POST" {
+			return http.StatusNotFound, nil, newError("RESOURCE_NOT_FOUND", "%s %s is not implemented by esigntest", r.Method, r.URL.Path)
+		}
+		cu := &chunkedUpload{id: newID(), parts: map[int][]byte{0: req.Data}}
+		s.uploads[cu.id] = cu
+		return http.StatusCreated, cu.response(), nil
+	}
+	cu, ok := s.uploads[segments[0]]
+	if !ok {
+		return 0, nil, chunkedUploadNotFound(segments[0])
+	}
+	switch {
+	case len(segments) == 1 && r.Method == "GET":
+	case len(segments) == 1 && r.Method == "DELETE":
+		delete(s.uploads, cu.id)
+	case len(segments) == 1 && r.Method == "PUT":
+		if r.URL.Query().Get("action") != "commit" {
+			return 0, nil, newError("INVALID_REQUEST_PARAMETER", "The request contained at least one invalid parameter. action must be commit")
+		}
+		for i, seq := range cu.sequences() {
+			if i != seq {
+				return 0, nil, newError("CHUNKED_UPLOAD_INCOMPLETE", "The chunked upload is missing part %d", i)
+			}
+		}
+		cu.committed = true
+	case len(segments) == 2 && r.Method == "PUT":
+		seq, err := strconv.Atoi(segments[1])
+		if err != nil || seq < 1 {
+			return 0, nil, newError("INVALID_REQUEST_PARAMETER", "The request contained at least one invalid parameter. Invalid part sequence %s", segments[1])
+		}
+		if cu.committed {
+			return 0, nil, newError("CHUNKED_UPLOAD_ALREADY_COMMITTED", "The chunked upload %s is committed", cu.id)
+		}
+		cu.parts[seq] = req.Data
+	default:
+		return http.StatusNotFound, nil, newError("RESOURCE_NOT_FOUND", "%s %s is not implemented by esigntest", r.Method, r.URL.Path)
+	}
+	return http.StatusOK, cu.response(), nil
+}
+
+// chunkedUploadContent returns the content of the committed chunked
+// upload referenced by uri.
+func (s *Server) chunkedUploadContent(uri string) ([]byte, *apiError) {
+	id := strings.TrimPrefix(uri, chunkedUploadScheme)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cu, ok := s.uploads[id]
+	if !ok {
+		return nil, chunkedUploadNotFound(id)
+	}
+	if !cu.committed {
+		return nil, newError("CHUNKED_UPLOAD_NOT_COMMITTED", "The chunked upload %s must be committed before use", id)
+	}
+	var content []byte
+	for _, seq := range cu.sequences() {
+		content = append(content, cu.parts[seq]...)
+	}
+	return content, nil
+}
+
+// ChunkedUploadIDs returns the ids of all chunked uploads that have
+// not been deleted.
+func (s *Server) ChunkedUploadIDs() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ids []string
+	for id := range s.uploads {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,844 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esigntest
+
+// envelopes.go contains the envelope state kept by a Server and the
+// handlers for the envelope api calls and ceremony pages.
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+// Envelope and recipient statuses used by the server
+const (
+	StatusCreated   = "created"
+	StatusSent      = "sent"
+	StatusDelivered = "delivered"
+	StatusCompleted = "completed"
+	StatusDeclined  = "declined"
+	StatusVoided    = "voided"
+)
+
+// envelope is the server's state for a single envelope.  Only signers
+// and carbon copies take part in routing; other recipient types are
+// stored and returned as sent.
+type envelope struct {
+	env   *model.Envelope
+	docs  []document
+	audit []model.EnvelopeAuditEvent
+}
+
+type document struct {
+	id      string
+	name    string
+	content []byte
+}
+
+// recipient points to the fields of a signer or carbon copy
+// needed for routing.
+type recipient struct {
+	id             string
+	email          string
+	name           string
+	clientUserID   string
+	order          int
+	isSigner       bool
+	status         *string
+	sent           **time.Time
+	delivered      **time.Time
+	signed         **time.Time
+	declined       **time.Time
+	declinedReason *string
+}
+
+func (e *envelope) recipients() []recipient {
+	var list []recipient
+	if e.env.Recipients == nil {
+		return list
+	}
+	for i := range e.env.Recipients.Signers {
+		sx := &e.env.Recipients.Signers[i]
+		list = append(list, recipient{
+			id: sx.RecipientID, email: sx.Email, name: sx.Name, clientUserID: sx.ClientUserID,
+			order: routingOrder(sx.RoutingOrder), isSigner: true, status: &sx.Status,
+			sent: &sx.SentDateTime, delivered: &sx.DeliveredDateTime, signed: &sx.SignedDateTime,
+			declined: &sx.DeclinedDateTime, declinedReason: &sx.DeclinedReason,
+		})
+	}
+	for i := range e.env.Recipients.CarbonCopies {
+		cx := &e.env.Recipients.CarbonCopies[i]
+		list = append(list, recipient{
+			id: cx.RecipientID, email: cx.Email, name: cx.Name, clientUserID: cx.ClientUserID,
+			order: routingOrder(cx.RoutingOrder), status: &cx.Status,
+			sent: &cx.SentDateTime, delivered: &cx.DeliveredDateTime, signed: &cx.SignedDateTime,
+			declined: &cx.DeclinedDateTime, declinedReason: &cx.DeclinedReason,
+		})
+	}
+	return list
+}
+
+func (e *envelope) recipient(id string) (recipient, bool) {
+	for _, r := range e.recipients() {
+		if r.id == id {
+			return r, true
+		}
+	}
+	return recipient{}, false
+}
+
+// routingOrder returns the numeric routing order defaulting to 1
+func routingOrder(s string) int {
+	if n, err := strconv.Atoi(s); err == nil && n > 0 {
+		return n
+	}
+	return 1
+}
+
+func (e *envelope) isActive() bool {
+	return e.env.Status == StatusSent || e.env.Status == StatusDelivered
+}
+
+// setStatus updates the envelope status and status dates
+func (e *envelope) setStatus(status string, tm time.Time) {
+	e.env.Status = status
+	e.env.StatusChangedDateTime = &tm
+	e.audit = append(e.audit, model.EnvelopeAuditEvent{EventFields: []model.NameValue{
+		{Name: "logTime", Value: tm.Format(time.RFC3339Nano)},
+		{Name: "Source", Value: "API"},
+		{Name: "Action", Value: auditActions[status]},
+		{Name: "Message", Value: "The envelope status changed to " + status},
+		{Name: "EnvelopeStatus", Value: status},
+	}})
+	switch status {
+	case StatusSent:
+		e.env.SentDateTime = &tm
+		e.env.InitialSentDateTime = &tm
+	case StatusDelivered:
+		e.env.DeliveredDateTime = &tm
+	case StatusCompleted:
+		e.env.CompletedDateTime = &tm
+	case StatusDeclined:
+		e.env.DeclinedDateTime = &tm
+	case StatusVoided:
+		e.env.VoidedDateTime = &tm
+	}
+}
+
+// auditActions are the audit event actions for each status
+var auditActions = map[string]string{
+	StatusCreated:   "Registered",
+	StatusSent:      "Sent Invitations",
+	StatusDelivered: "Viewed In-Session",
+	StatusCompleted: "Signed",
+	StatusDeclined:  "Declined",
+	StatusVoided:    "Voided",
+}
+
+// route sends the envelope to the recipients of the lowest incomplete
+// routing order.  Carbon copies are completed when reached, and the
+// envelope is completed when no signers remain.
+func (e *envelope) route(tm time.Time) {
+	if !e.isActive() {
+		return
+	}
+	rcps := e.recipients()
+	for {
+		current := 0
+		for _, r := range rcps {
+			if *r.status != StatusCompleted && (current == 0 || r.order < current) {
+				current = r.order
+			}
+		}
+		if current == 0 {
+			e.setStatus(StatusCompleted, tm)
+			return
+		}
+		e.env.Recipients.CurrentRoutingOrder = strconv.Itoa(current)
+		waiting := false
+		for _, r := range rcps {
+			if r.order != current || *r.status == StatusCompleted {
+				continue
+			}
+			if *r.status == StatusCreated || *r.status == "" {
+				*r.status, *r.sent = StatusSent, &tm
+			}
+			if !r.isSigner {
+				*r.status = StatusCompleted
+				continue
+			}
+			waiting = true
+		}
+		if waiting {
+			return
+		}
+	}
+}
+
+// handleAPI routes the account's api calls.  segments contains the
+// path elements following the account id.
+func (s *Server) handleAPI(w http.ResponseWriter, r *http.Request, segments []string) {
+	var status int
+	var res interface{}
+	var err *apiError
+	m := r.Method
+	switch {
+	case len(segments) > 0 && segments[0] == "chunked_uploads":
+		status, res, err = s.chunkedUploads(r, segments[1:])
+	case len(segments) > 0 && segments[0] == "templates":
+		status, res, err = s.templatesAPI(r, segments[1:])
+	case len(segments) == 0 || segments[0] != "envelopes":
+		status, err = http.StatusNotFound, newError("RESOURCE_NOT_FOUND", "%s %s is not implemented by esigntest", r.Method, r.URL.Path)
+	case len(segments) == 1 && m == "POST":
+		status, res, err = s.createEnvelope(r)
+	case len(segments) == 1 && m == "GET":
+		res, err = s.listStatusChanges(r.URL.Query())
+	case len(segments) == 2 && m == "PUT" && segments[1] == "status":
+		res, err = s.listStatus(r)
+	case len(segments) == 2 && m == "GET":
+		res, err = s.getEnvelope(segments[1], r.URL.Query())
+	case len(segments) == 2 && m == "PUT":
+		res, err = s.updateEnvelope(r, segments[1])
+	case len(segments) == 3 && m == "GET" && segments[2] == "recipients":
+		res, err = s.listRecipients(segments[1])
+	case len(segments) == 3 && m == "GET" && segments[2] == "audit_events":
+		res, err = s.listAuditEvents(segments[1])
+	case len(segments) == 3 && m == "GET" && segments[2] == "form_data":
+		res, err = s.getFormData(segments[1])
+	case len(segments) == 3 && m == "GET" && segments[2] == "documents":
+		res, err = s.listDocuments(segments[1])
+	case len(segments) == 4 && m == "GET" && segments[2] == "documents":
+		s.getDocument(w, segments[1], segments[3])
+		return
+	case len(segments) == 4 && m == "POST" && segments[2] == "views":
+		status, res, err = s.createView(r, segments[1], segments[3])
+	default:
+		status, err = http.StatusNotFound, newError("RESOURCE_NOT_FOUND", "%s %s is not implemented by esigntest", r.Method, r.URL.Path)
+	}
+	if err != nil {
+		if status == 0 {
+			status = http.StatusBadRequest
+		}
+		writeError(w, status, err)
+		return
+	}
+	if status == 0 {
+		status = http.StatusOK
+	}
+	writeJSON(w, status, res)
+}
+
+// envelopeNotFound is the DocuSign error for an invalid envelope id
+func envelopeNotFound(id string) *apiError {
+	return newError("ENVELOPE_DOES_NOT_EXIST", "The envelope specified either does not exist or you have no rights to the envelope. %s", id)
+}
+
+// readDefinition decodes the envelope definition from a json
+// or multipart body returning the contents of any file parts.
+func readDefinition(r *http.Request) (*model.EnvelopeDefinition, map[string][]byte, *apiError) {
+	var def *model.EnvelopeDefinition
+	files := make(map[string][]byte)
+	mediaType, params, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		if err := json.NewDecoder(r.Body).Decode(&def); err != nil || def == nil {
+			return nil, nil, newError("INVALID_REQUEST_BODY", "The request body is missing or improperly formatted. %v", err)
+		}
+		return def, files, nil
+	}
+	mr := multipart.NewReader(r.Body, params["boundary"])
+	for {
+		part, err := mr.NextPart()
+		if err != nil {
+			break
+		}
+		b, err := ioutil.ReadAll(part)
+		if err != nil {
+			return nil, nil, newError("INVALID_MULTI_PART_REQUEST", "%v", err)
+		}
+		_, dparams, _ := mime.ParseMediaType(part.Header.Get("Content-Disposition"))
+		if id := dparams["documentid"]; id > "" {
+			files[id] = b
+			continue
+		}
+		if def == nil {
+			if err = json.Unmarshal(b, &def); err != nil {
+				return nil, nil, newError("INVALID_REQUEST_BODY", "The request body is missing or improperly formatted. %v", err)
+			}
+		}
+	}
+	if def == nil {
+		return nil, nil, newError("INVALID_MULTI_PART_REQUEST", "no envelope definition found in multipart request")
+	}
+	return def, files, nil
+}
+
+func (s *Server) createEnvelope(r *http.Request) (int, interface{}, *apiError) {
+	def, files, apiErr := readDefinition(r)
+	if apiErr != nil {
+		return 0, nil, apiErr
+	}
+	if def.TemplateID > "" || len(def.TemplateRoles) > 0 {
+		return 0, nil, newError("INVALID_REQUEST_PARAMETER", "template roles are not supported by esigntest; use composite templates")
+	}
+	if len(def.CompositeTemplates) > 0 {
+		if apiErr = s.applyCompositeTemplates(def, files); apiErr != nil {
+			return 0, nil, apiErr
+		}
+	}
+	switch {
+	case def.Status != "" && def.Status != StatusCreated && def.Status != StatusSent:
+		return 0, nil, newError("INVALID_ENVELOPE_STATUS", "Envelope status must be sent or created. %s", def.Status)
+	case len(def.Documents) == 0:
+		return 0, nil, newError("ENVELOPE_IS_INCOMPLETE", "The Envelope is not Complete. A Complete Envelope Requires Documents, Recipients, Tabs, and a Subject Line.")
+	}
+	e := &envelope{}
+	for _, d := range def.Documents {
+		content := d.DocumentBase64
+		if len(content) == 0 {
+			content = files[d.DocumentID]
+		}
+		if strings.HasPrefix(d.RemoteURL, chunkedUploadScheme) {
+			if content, apiErr = s.chunkedUploadContent(d.RemoteURL); apiErr != nil {
+				return 0, nil, apiErr
+			}
+		}
+		if d.DocumentID == "" || len(content) == 0 {
+			return 0, nil, newError("UNABLE_TO_LOAD_DOCUMENT", "Unable to load the document. No content for document %q", d.DocumentID)
+		}
+		e.docs = append(e.docs, document{id: d.DocumentID, name: d.Name, content: content})
+	}
+
+	tm := now()
+	id := newID()
+	e.env = &model.Envelope{
+		EnvelopeID:           id,
+		EmailSubject:         def.EmailSubject,
+		EmailBlurb:           def.EmailBlurb,
+		Recipients:           def.Recipients,
+		CustomFields:         def.CustomFields,
+		CreatedDateTime:      &tm,
+		LastModifiedDateTime: &tm,
+		RecipientsURI:        "/envelopes/" + id + "/recipients",
+		DocumentsURI:         "/envelopes/" + id + "/documents",
+		DocumentsCombinedURI: "/envelopes/" + id + "/documents/combined",
+	}
+	e.setStatus(StatusCreated, tm)
+	for _, rx := range e.recipients() {
+		*rx.status = StatusCreated
+	}
+	if def.Status == StatusSent {
+		if apiErr = e.send(tm); apiErr != nil {
+			return 0, nil, apiErr
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.envelopes[id] = e
+	s.order = append(s.order, id)
+	return http.StatusCreated, &model.EnvelopeSummary{
+		EnvelopeID:     id,
+		Status:         e.env.Status,
+		StatusDateTime: e.env.StatusChangedDateTime,
+		URI:            "/envelopes/" + id,
+	}, nil
+}
+
+// send moves a created envelope to sent and routes it.
+func (e *envelope) send(tm time.Time) *apiError {
+	if e.env.Status != StatusCreated {
+		return newError("ENVELOPE_CANNOT_BE_SENT", "Envelope status is %s", e.env.Status)
+	}
+	if len(e.recipients()) == 0 {
+		return newError("ENVELOPE_IS_INCOMPLETE", "The Envelope is not Complete. A Complete Envelope Requires Documents, Recipients, Tabs, and a Subject Line.")
+	}
+	e.setStatus(StatusSent, tm)
+	e.env.LastModifiedDateTime = &tm
+	e.route(tm)
+	return nil
+}
+
+// lookup returns the envelope for id.  The server lock must be held.
+func (s *Server) lookup(id string) (*envelope, *apiError) {
+	e, ok := s.envelopes[id]
+	if !ok {
+		return nil, envelopeNotFound(id)
+	}
+	return e, nil
+}
+
+// copyEnvelope returns a deep copy of e's envelope suitable for
+// returning to a caller.  The server lock must be held.
+func (e *envelope) copyEnvelope(includeRecipients bool) *model.Envelope {
+	var env *model.Envelope
+	b, _ := json.Marshal(e.env)
+	json.Unmarshal(b, &env)
+	if !includeRecipients {
+		env.Recipients = nil
+	}
+	return env
+}
+
+func includes(q url.Values, val string) bool {
+	for _, s := range strings.Split(q.Get("include"), ",") {
+		if strings.TrimSpace(s) == val {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) getEnvelope(id string, q url.Values) (interface{}, *apiError) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, err := s.lookup(id)
+	if err != nil {
+		return nil, err
+	}
+	return e.copyEnvelope(includes(q, "recipients")), nil
+}
+
+// updateEnvelope sends or voids an envelope or updates the subject.
+func (s *Server) updateEnvelope(r *http.Request, id string) (interface{}, *apiError) {
+	var upd *model.Envelope
+	if err := json.NewDecoder(r.Body).Decode(&upd); err != nil || upd == nil {
+		return nil, newError("INVALID_REQUEST_BODY", "The request body is missing or improperly formatted. %v", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, apiErr := s.lookup(id)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	tm := now()
+	switch upd.Status {
+	case "":
+	case StatusSent:
+		if apiErr = e.send(tm); apiErr != nil {
+			return nil, apiErr
+		}
+	case StatusVoided:
+		if !e.isActive() {
+			return nil, newError("ENVELOPE_CANNOT_VOID_INVALID_STATE", "Only envelopes in the 'Sent' or 'Delivered' states may be voided.")
+		}
+		if upd.VoidedReason == "" {
+			return nil, newError("ENVELOPE_CANNOT_VOID_NO_REASON", "A reason must be provided to void an envelope.")
+		}
+		e.setStatus(StatusVoided, tm)
+		e.env.VoidedReason = upd.VoidedReason
+	default:
+		return nil, newError("INVALID_ENVELOPE_STATUS", "esigntest does not support updating status to %s", upd.Status)
+	}
+	if upd.EmailSubject > "" {
+		e.env.EmailSubject = upd.EmailSubject
+	}
+	e.env.LastModifiedDateTime = &tm
+	return &model.EnvelopeUpdateSummary{EnvelopeID: id}, nil
+}
+
+// listStatusChanges returns envelopes modified within the from_date and
+// to_date parameters or those listed in envelope_ids.
+func (s *Server) listStatusChanges(q url.Values) (interface{}, *apiError) {
+	var from, to time.Time
+	var err error
+	ids := make(map[string]bool)
+	if q.Get("envelope_ids") > "" {
+		for _, id := range strings.Split(q.Get("envelope_ids"), ",") {
+			ids[strings.TrimSpace(id)] = true
+		}
+	} else {
+		if q.Get("from_date") == "" {
+			return nil, newError("INVALID_REQUEST_PARAMETER", "The request contained at least one invalid parameter. A from_date, envelope_ids or transaction_ids parameter is required.")
+		}
+		if from, err = time.Parse(time.RFC3339, q.Get("from_date")); err != nil {
+			return nil, newError("INVALID_REQUEST_PARAMETER", "The request contained at least one invalid parameter. Invalid value for from_date: %v", err)
+		}
+	}
+	if q.Get("to_date") > "" {
+		if to, err = time.Parse(time.RFC3339, q.Get("to_date")); err != nil {
+			return nil, newError("INVALID_REQUEST_PARAMETER", "The request contained at least one invalid parameter. Invalid value for to_date: %v", err)
+		}
+	}
+	statuses := make(map[string]bool)
+	if q.Get("status") > "" {
+		for _, st := range strings.Split(q.Get("status"), ",") {
+			statuses[strings.ToLower(strings.TrimSpace(st))] = true
+		}
+	}
+	start, _ := strconv.Atoi(q.Get("start_position"))
+	count, _ := strconv.Atoi(q.Get("count"))
+	withRecipients := includes(q, "recipients")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var matches []*envelope
+	for _, id := range s.order {
+		e := s.envelopes[id]
+		mod := *e.env.LastModifiedDateTime
+		switch {
+		case len(ids) > 0 && !ids[id]:
+		case len(ids) == 0 && mod.Before(from):
+		case !to.IsZero() && mod.After(to):
+		case len(statuses) > 0 && !statuses[e.env.Status]:
+		default:
+			matches = append(matches, e)
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].env.LastModifiedDateTime.Before(*matches[j].env.LastModifiedDateTime)
+	})
+	total := len(matches)
+	if start > total {
+		start = total
+	}
+	matches = matches[start:]
+	if count > 0 && count < len(matches) {
+		matches = matches[:count]
+	}
+	res := &model.EnvelopesInformation{
+		ResultSetSize:       strconv.Itoa(len(matches)),
+		StartPosition:       strconv.Itoa(start),
+		EndPosition:         strconv.Itoa(start + len(matches) - 1),
+		TotalSetSize:        strconv.Itoa(total),
+		LastQueriedDateTime: now().Format(time.RFC3339Nano),
+	}
+	for _, e := range matches {
+		res.Envelopes = append(res.Envelopes, *e.copyEnvelope(withRecipients))
+	}
+	return res, nil
+}
+
+// listStatus returns the envelopes listed in the request body.
+func (s *Server) listStatus(r *http.Request) (interface{}, *apiError) {
+	q := r.URL.Query()
+	if q.Get("envelope_ids") != "request_body" {
+		return nil, newError("INVALID_REQUEST_PARAMETER", "The request contained at least one invalid parameter. envelope_ids must be request_body.")
+	}
+	var req *model.EnvelopeIdsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req == nil || len(req.EnvelopeIds) == 0 {
+		return nil, newError("INVALID_REQUEST_BODY", "The request body is missing or improperly formatted. %v", err)
+	}
+	q.Set("envelope_ids", strings.Join(req.EnvelopeIds, ","))
+	return s.listStatusChanges(q)
+}
+
+func (s *Server) listRecipients(id string) (interface{}, *apiError) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, err := s.lookup(id)
+	if err != nil {
+		return nil, err
+	}
+	rcps := e.copyEnvelope(true).Recipients
+	if rcps == nil {
+		rcps = &model.Recipients{}
+	}
+	rcps.RecipientCount = strconv.Itoa(len(e.recipients()))
+	return rcps, nil
+}
+
+func (s *Server) listDocuments(id string) (interface{}, *apiError) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, err := s.lookup(id)
+	if err != nil {
+		return nil, err
+	}
+	res := &model.EnvelopeDocumentsResult{EnvelopeID: id}
+	for i, d := range e.docs {
+		res.EnvelopeDocuments = append(res.EnvelopeDocuments, model.EnvelopeDocument{
+			DocumentID: d.id,
+			Name:       d.name,
+			Type:       "content",
+			Order:      strconv.Itoa(i + 1),
+			URI:        e.env.DocumentsURI + "/" + d.id,
+		})
+	}
+	res.EnvelopeDocuments = append(res.EnvelopeDocuments, model.EnvelopeDocument{
+		DocumentID: "certificate",
+		Name:       "Summary",
+		Type:       "summary",
+		Order:      strconv.Itoa(len(e.docs) + 1),
+		URI:        e.env.DocumentsURI + "/certificate",
+	})
+	return res, nil
+}
+
+// listAuditEvents returns an event for each change of the
+// envelope's status.
+func (s *Server) listAuditEvents(id string) (interface{}, *apiError) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, err := s.lookup(id)
+	if err != nil {
+		return nil, err
+	}
+	res := &model.EnvelopeAuditEventResponse{}
+	b, _ := json.Marshal(e.audit)
+	json.Unmarshal(b, &res.AuditEvents)
+	return res, nil
+}
+
+// getFormData returns the labeled values of each signer's tabs.
+func (s *Server) getFormData(id string) (interface{}, *apiError) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, err := s.lookup(id)
+	if err != nil {
+		return nil, err
+	}
+	env := e.copyEnvelope(true)
+	res := &model.EnvelopeFormData{
+		EmailSubject: env.EmailSubject,
+		EnvelopeID:   id,
+		SentDateTime: env.SentDateTime,
+		Status:       env.Status,
+	}
+	if env.Recipients == nil {
+		return res, nil
+	}
+	for _, sx := range env.Recipients.Signers {
+		rfd := model.RecipientFormData{RecipientID: sx.RecipientID, Name: sx.Name, Email: sx.Email}
+		if sx.SignedDateTime != nil {
+			rfd.SignedTime = sx.SignedDateTime.Format(time.RFC3339Nano)
+		}
+		values := sx.Tabs.Values()
+		labels := make([]string, 0, len(values))
+		for k := range values {
+			labels = append(labels, k)
+		}
+		sort.Strings(labels)
+		for _, k := range labels {
+			item := model.FormDataItem{Name: k, Value: fmt.Sprintf("%v", values[k])}
+			rfd.FormData = append(rfd.FormData, item)
+			res.FormData = append(res.FormData, item)
+		}
+		res.RecipientFormData = append(res.RecipientFormData, rfd)
+	}
+	return res, nil
+}
+
+// getDocument writes a document's content.  The combined document is
+// the concatenation of all documents, and the certificate is a text
+// summary of the envelope.
+func (s *Server) getDocument(w http.ResponseWriter, envelopeID, documentID string) {
+	s.mu.Lock()
+	e, apiErr := s.lookup(envelopeID)
+	if apiErr != nil {
+		s.mu.Unlock()
+		writeError(w, http.StatusBadRequest, apiErr)
+		return
+	}
+	var content []byte
+	name := documentID
+	switch documentID {
+	case "combined", "archive":
+		for _, d := range e.docs {
+			content = append(content, d.content...)
+		}
+	case "certificate":
+		name = "Summary"
+		content = []byte(fmt.Sprintf("Certificate of Completion\nEnvelope Id: %s\nStatus: %s\n", e.env.EnvelopeID, e.env.Status))
+	default:
+		for _, d := range e.docs {
+			if d.id == documentID {
+				content, name = d.content, d.name
+				break
+			}
+		}
+	}
+	s.mu.Unlock()
+	if content == nil {
+		writeError(w, http.StatusNotFound, newError("DOCUMENT_DOES_NOT_EXIST", "The document specified was not found. %s", documentID))
+		return
+	}
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("file", map[string]string{"filename": name, "documentid": documentID}))
+	w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(content)
+}
+
+// createView returns recipient and sender view urls pointing to
+// the server's ceremony pages.
+func (s *Server) createView(r *http.Request, envelopeID, view string) (int, interface{}, *apiError) {
+	var req struct {
+		ReturnURL    string `json:"returnUrl"`
+		UserName     string `json:"userName"`
+		Email        string `json:"email"`
+		ClientUserID string `json:"clientUserId"`
+		RecipientID  string `json:"recipientId"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return 0, nil, newError("INVALID_REQUEST_BODY", "The request body is missing or improperly formatted. %v", err)
+	}
+	if req.ReturnURL == "" {
+		return 0, nil, newError("INVALID_REQUEST_PARAMETER", "The request contained at least one invalid parameter. returnUrl is required.")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, apiErr := s.lookup(envelopeID)
+	if apiErr != nil {
+		return 0, nil, apiErr
+	}
+	q := url.Values{"returnUrl": {req.ReturnURL}}
+	switch view {
+	case "sender":
+		if e.env.Status != StatusCreated {
+			return 0, nil, newError("ENVELOPE_INVALID_STATUS", "Invalid envelope status %s for sender view.", e.env.Status)
+		}
+		return http.StatusCreated, &model.ViewURL{URL: s.URL + "/sending/" + envelopeID + "?" + q.Encode()}, nil
+	case "recipient":
+	default:
+		return http.StatusNotFound, nil, newError("RESOURCE_NOT_FOUND", "%s view is not implemented by esigntest", view)
+	}
+	if !e.isActive() {
+		return 0, nil, newError("ENVELOPE_INVALID_STATUS", "Invalid envelope status %s for recipient view.", e.env.Status)
+	}
+	for _, rx := range e.recipients() {
+		if rx.isSigner && strings.EqualFold(rx.email, req.Email) && rx.name == req.UserName &&
+			rx.clientUserID == req.ClientUserID && (req.RecipientID == "" || req.RecipientID == rx.id) {
+			if rx.clientUserID == "" {
+				return 0, nil, newError("UNKNOWN_ENVELOPE_RECIPIENT", "The recipient you have identified is not a valid recipient of the specified envelope.")
+			}
+			return http.StatusCreated, &model.ViewURL{URL: s.URL + "/signing/" + envelopeID + "/" + rx.id + "?" + q.Encode()}, nil
+		}
+	}
+	return 0, nil, newError("UNKNOWN_ENVELOPE_RECIPIENT", "The recipient you have identified is not a valid recipient of the specified envelope.")
+}
+
+// handleSigning completes the recipient in the url path and redirects to
+// the view's returnUrl.  A query value of action=decline declines rather
+// than signs.
+func (s *Server) handleSigning(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/signing/"), "/")
+	if len(parts) != 2 {
+		http.NotFound(w, r)
+		return
+	}
+	event, err := "signing_complete", error(nil)
+	if r.URL.Query().Get("action") == "decline" {
+		event, err = "decline", s.Decline(parts[0], parts[1], "declined in signing session")
+	} else {
+		err = s.Sign(parts[0], parts[1])
+	}
+	if err != nil {
+		event = "exception"
+	}
+	redirect(w, r, event)
+}
+
+// handleSending sends the envelope in the url path and redirects to
+// the view's returnUrl.
+func (s *Server) handleSending(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/sending/")
+	event := "Send"
+	s.mu.Lock()
+	e, apiErr := s.lookup(id)
+	if apiErr == nil {
+		apiErr = e.send(now())
+	}
+	s.mu.Unlock()
+	if apiErr != nil {
+		event = "exception"
+	}
+	redirect(w, r, event)
+}
+
+// redirect sends the browser to the returnUrl query value
+// adding the event parameter.
+func redirect(w http.ResponseWriter, r *http.Request, event string) {
+	u, err := url.Parse(r.URL.Query().Get("returnUrl"))
+	if err != nil || u.String() == "" {
+		http.Error(w, "invalid returnUrl", http.StatusBadRequest)
+		return
+	}
+	q := u.Query()
+	q.Set("event", event)
+	u.RawQuery = q.Encode()
+	http.Redirect(w, r, u.String(), http.StatusFound)
+}
+
+// Envelope returns a copy of the envelope with recipients.
+func (s *Server) Envelope(envelopeID string) (*model.Envelope, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.envelopes[envelopeID]
+	if !ok {
+		return nil, false
+	}
+	return e.copyEnvelope(true), true
+}
+
+// Sign completes the signer identified by recipientID as if the signer
+// finished a signing session.  The signer must be the envelope's current
+// recipient.  The envelope is completed when all signers have signed.
+func (s *Server) Sign(envelopeID, recipientID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, rx, err := s.currentSigner(envelopeID, recipientID)
+	if err != nil {
+		return err
+	}
+	tm := now()
+	if *rx.delivered == nil {
+		*rx.delivered = &tm
+	}
+	*rx.status, *rx.signed = StatusCompleted, &tm
+	if e.env.Status == StatusSent {
+		e.setStatus(StatusDelivered, tm)
+	}
+	e.env.LastModifiedDateTime = &tm
+	e.route(tm)
+	return nil
+}
+
+// Decline declines the envelope on behalf of the signer identified by
+// recipientID.
+func (s *Server) Decline(envelopeID, recipientID, reason string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, rx, err := s.currentSigner(envelopeID, recipientID)
+	if err != nil {
+		return err
+	}
+	tm := now()
+	*rx.status, *rx.declined, *rx.declinedReason = StatusDeclined, &tm, reason
+	e.setStatus(StatusDeclined, tm)
+	e.env.LastModifiedDateTime = &tm
+	return nil
+}
+
+// currentSigner returns the envelope and signer if the signer may
+// act on the envelope.  The server lock must be held.
+func (s *Server) currentSigner(envelopeID, recipientID string) (*envelope, recipient, error) {
+	e, apiErr := s.lookup(envelopeID)
+	if apiErr != nil {
+		return nil, recipient{}, apiErr
+	}
+	if !e.isActive() {
+		return nil, recipient{}, fmt.Errorf("envelope %s status is %s", envelopeID, e.env.Status)
+	}
+	rx, ok := e.recipient(recipientID)
+	if !ok || !rx.isSigner {
+		return nil, recipient{}, fmt.Errorf("signer %s not found in envelope %s", recipientID, envelopeID)
+	}
+	if *rx.status != StatusSent && *rx.status != StatusDelivered {
+		return nil, recipient{}, fmt.Errorf("signer %s status is %s", recipientID, *rx.status)
+	}
+	return e, rx, nil
+}
@@ -0,0 +1,249 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package esigntest provides an in-process fake of DocuSign's eSignature
+// v2.1 api for testing envelope workflows without network access.
+//
+// The Server implements a stateful subset of the api: oauth token and
+// userinfo endpoints, envelope create, get, update (send and void), list
+// status changes and list status, recipient lists, audit events, form
+// data, document lists and downloads, recipient and sender views,
+// chunked uploads, and template create, get and recipient lists.
+// Envelopes may be created from composite templates.  View urls point
+// back to the server, and an http GET of a recipient view url signs for
+// the recipient and redirects to the view's return url as an embedded
+// signing session would.  Tests may also complete recipients directly
+// using Sign and Decline.
+//
+//   srv := esigntest.NewServer()
+//   defer srv.Close()
+//
+//   sv := envelopes.New(srv.Credential())
+//   summary, err := sv.Create(envelopeDefinition).Do(ctx)
+//   ...
+//   err = srv.Sign(summary.EnvelopeID, "1")
+//   ...
+//   env, err := sv.Get(summary.EnvelopeID).Do(ctx)
+//   // env.Status == "completed"
+package esigntest // import "github.com/jfcote87/esign/esigntest"
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jfcote87/ctxclient"
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/v2.1/model"
+	"github.com/jfcote87/oauth2"
+)
+
+// Default identifiers used by a Server.
+const (
+	DefaultAccountID     = "00000000-0000-0000-0000-0000000acc01"
+	DefaultUserID        = "00000000-0000-0000-0000-00000000u5e1"
+	DefaultIntegratorKey = "esigntest-integrator-key"
+)
+
+// Server is a fake DocuSign api server.  Server fields should not
+// be changed after the first request.
+type Server struct {
+	*httptest.Server
+	// AccountID is the id of the only account on the server
+	AccountID string
+	// UserID is the api username of the user returned by /oauth/userinfo
+	UserID string
+	// UserName and Email describe the user
+	UserName string
+	Email    string
+
+	mu        sync.Mutex
+	tokens    map[string]bool
+	envelopes map[string]*envelope
+	uploads   map[string]*chunkedUpload
+	templates map[string]*model.EnvelopeTemplate
+	order     []string // envelope ids in creation order
+}
+
+// NewServer starts and returns a new Server.  The caller should
+// call Close when finished.
+func NewServer() *Server {
+	s := &Server{
+		AccountID: DefaultAccountID,
+		UserID:    DefaultUserID,
+		UserName:  "Test Sender",
+		Email:     "sender@example.com",
+		tokens:    make(map[string]bool),
+		envelopes: make(map[string]*envelope),
+		uploads:   make(map[string]*chunkedUpload),
+		templates: make(map[string]*model.EnvelopeTemplate),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.route))
+	return s
+}
+
+// HTTPClientFunc returns a ctxclient.Func whose client sends all requests
+// to the server regardless of the request's host.  Use the func for
+// credentials created outside of the server.
+func (s *Server) HTTPClientFunc() ctxclient.Func {
+	host := strings.TrimPrefix(s.URL, "http://")
+	cl := &http.Client{
+		Transport: &rewriteTransport{host: host, base: s.Client().Transport},
+	}
+	return func(ctx context.Context) (*http.Client, error) {
+		return cl, nil
+	}
+}
+
+// OAuth2Config returns a configuration that obtains tokens from the
+// server.  Any authorization code may be used with Exchange.
+func (s *Server) OAuth2Config() *esign.OAuth2Config {
+	return &esign.OAuth2Config{
+		IntegratorKey:  DefaultIntegratorKey,
+		Secret:         "esigntest-secret",
+		RedirURL:       s.URL + "/callback",
+		IsDemo:         true,
+		HTTPClientFunc: s.HTTPClientFunc(),
+	}
+}
+
+// Credential returns a credential authorized for the server's account.
+func (s *Server) Credential() *esign.OAuth2Credential {
+	cred, _ := s.OAuth2Config().Credential(&oauth2.Token{RefreshToken: "esigntest-refresh-token"}, nil)
+	return cred
+}
+
+// rewriteTransport sends all requests to host
+type rewriteTransport struct {
+	host string
+	base http.RoundTripper
+}
+
+func (t *rewriteTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r2 := r.Clone(r.Context())
+	r2.URL.Scheme = "http"
+	r2.URL.Host = t.host
+	r2.Host = t.host
+	return t.base.RoundTrip(r2)
+}
+
+// apiError is the error response returned by DocuSign
+type apiError struct {
+	ErrorCode string `json:"errorCode"`
+	Message   string `json:"message"`
+}
+
+func (e *apiError) Error() string {
+	return e.ErrorCode + ": " + e.Message
+}
+
+func newError(code, format string, args ...interface{}) *apiError {
+	return &apiError{ErrorCode: code, Message: fmt.Sprintf(format, args...)}
+}
+
+func writeError(w http.ResponseWriter, status int, err *apiError) {
+	writeJSON(w, status, err)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func (s *Server) route(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("X-DocuSign-TraceToken", newID())
+	switch {
+	case r.URL.Path == "/oauth/token":
+		s.handleToken(w, r)
+	case r.URL.Path == "/oauth/userinfo":
+		if !s.authorized(r) {
+			writeError(w, http.StatusUnauthorized, newError("AUTHORIZATION_INVALID_TOKEN", "the access token provided is expired, revoked or malformed"))
+			return
+		}
+		s.handleUserInfo(w, r)
+	case strings.HasPrefix(r.URL.Path, "/signing/"):
+		s.handleSigning(w, r)
+	case strings.HasPrefix(r.URL.Path, "/sending/"):
+		s.handleSending(w, r)
+	case strings.HasPrefix(r.URL.Path, "/restapi/v2.1/accounts/"):
+		if !s.authorized(r) {
+			writeError(w, http.StatusUnauthorized, newError("AUTHORIZATION_INVALID_TOKEN", "the access token provided is expired, revoked or malformed"))
+			return
+		}
+		segments := strings.Split(strings.TrimPrefix(r.URL.Path, "/restapi/v2.1/accounts/"), "/")
+		if segments[0] != s.AccountID {
+			writeError(w, http.StatusBadRequest, newError("USER_LACKS_MEMBERSHIP", "the user is not a member of account %s", segments[0]))
+			return
+		}
+		s.handleAPI(w, r, segments[1:])
+	default:
+		writeError(w, http.StatusNotFound, newError("RESOURCE_NOT_FOUND", "%s %s is not implemented by esigntest", r.Method, r.URL.Path))
+	}
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	tk := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tokens[tk]
+}
+
+// handleToken issues a token for any grant
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		writeError(w, http.StatusMethodNotAllowed, newError("INVALID_REQUEST", "token requests must be POST"))
+		return
+	}
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") == "" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+	tk := "esigntest-access-" + newID()
+	s.mu.Lock()
+	s.tokens[tk] = true
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token":  tk,
+		"token_type":    "Bearer",
+		"refresh_token": "esigntest-refresh-token",
+		"expires_in":    3600,
+	})
+}
+
+func (s *Server) handleUserInfo(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, esign.UserInfo{
+		APIUsername: s.UserID,
+		Name:        s.UserName,
+		Email:       s.Email,
+		Accounts: []esign.UserInfoAccount{
+			{
+				AccountID:   s.AccountID,
+				IsDefault:   true,
+				AccountName: "esigntest",
+				BaseURI:     s.URL,
+			},
+		},
+	})
+}
+
+// newID returns a random guid
+func newID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// now returns the current time truncated to milliseconds
+// as DocuSign returns.
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Millisecond)
+}
@@ -0,0 +1,228 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esigntest_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/esigntest"
+	"github.com/jfcote87/esign/v2.1/envelopes"
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+func testDefinition(status string) *model.EnvelopeDefinition {
+	return &model.EnvelopeDefinition{
+		EmailSubject: "Test Envelope",
+		Status:       status,
+		Documents: []model.Document{
+			{DocumentID: "1", Name: "doc1.pdf", DocumentBase64: []byte("%PDF-DOC1")},
+			{DocumentID: "2", Name: "doc2.pdf"},
+		},
+		Recipients: &model.Recipients{
+			Signers: []model.Signer{
+				{RecipientID: "1", Name: "Signer One", Email: "one@example.com", ClientUserID: "C1", RoutingOrder: "1"},
+				{RecipientID: "2", Name: "Signer Two", Email: "two@example.com", RoutingOrder: "3"},
+			},
+			CarbonCopies: []model.CarbonCopy{
+				{RecipientID: "3", Name: "Copy Three", Email: "three@example.com", RoutingOrder: "2"},
+			},
+		},
+	}
+}
+
+func TestServer_SendSignComplete(t *testing.T) {
+	ctx := context.Background()
+	srv := esigntest.NewServer()
+	defer srv.Close()
+	start := time.Now().Add(-time.Second)
+
+	sv := envelopes.New(srv.Credential())
+	summary, err := sv.Create(testDefinition("sent"), &esign.UploadFile{
+		ContentType: "application/pdf",
+		FileName:    "doc2.pdf",
+		ID:          "2",
+		Reader:      bytes.NewReader([]byte("%PDF-DOC2")),
+	}).Do(ctx)
+	if err != nil {
+		t.Fatalf("create expected success; got %v", err)
+	}
+	if summary.Status != "sent" || summary.EnvelopeID == "" {
+		t.Fatalf("expected sent envelope; got %#v", summary)
+	}
+	envID := summary.EnvelopeID
+
+	rcps, err := sv.RecipientsList(envID).Do(ctx)
+	if err != nil {
+		t.Fatalf("recipients list expected success; got %v", err)
+	}
+	if rcps.Signers[0].Status != "sent" || rcps.Signers[1].Status != "created" || rcps.CarbonCopies[0].Status != "created" {
+		t.Errorf("expected first signer sent; got %s %s %s", rcps.Signers[0].Status, rcps.Signers[1].Status, rcps.CarbonCopies[0].Status)
+	}
+
+	// embedded signing for first signer
+	vw, err := sv.ViewsCreateRecipient(envID, &model.RecipientViewRequest{
+		ReturnURL:    "https://example.com/return?state=abc",
+		UserName:     "Signer One",
+		Email:        "one@example.com",
+		ClientUserID: "C1",
+	}).Do(ctx)
+	if err != nil {
+		t.Fatalf("recipient view expected success; got %v", err)
+	}
+	if loc := ceremony(t, vw.URL); loc != "https://example.com/return?event=signing_complete&state=abc" {
+		t.Errorf("expected redirect with signing_complete event; got %s", loc)
+	}
+	// remote signer may not use embedded signing
+	if _, err = sv.ViewsCreateRecipient(envID, &model.RecipientViewRequest{
+		ReturnURL: "https://example.com/return",
+		UserName:  "Signer Two",
+		Email:     "two@example.com",
+	}).Do(ctx); err == nil {
+		t.Errorf("expected UNKNOWN_ENVELOPE_RECIPIENT error for remote signer")
+	}
+
+	env, err := sv.Get(envID).Include("recipients").Do(ctx)
+	if err != nil {
+		t.Fatalf("get expected success; got %v", err)
+	}
+	if env.Status != "delivered" || env.Recipients.Signers[0].Status != "completed" ||
+		env.Recipients.CarbonCopies[0].Status != "completed" || env.Recipients.Signers[1].Status != "sent" {
+		t.Errorf("expected routing to second signer; got %s %#v", env.Status, env.Recipients)
+	}
+
+	if err = srv.Sign(envID, "1"); err == nil {
+		t.Errorf("expected error signing twice")
+	}
+	if err = srv.Sign(envID, "2"); err != nil {
+		t.Fatalf("expected second signer to sign; got %v", err)
+	}
+	if env, err = sv.Get(envID).Do(ctx); err != nil || env.Status != "completed" || env.CompletedDateTime == nil {
+		t.Errorf("expected completed envelope; got %v %v", env, err)
+	}
+
+	list, err := sv.ListStatusChanges().FromDate(start).Status("completed").Do(ctx)
+	if err != nil {
+		t.Fatalf("list status changes expected success; got %v", err)
+	}
+	if len(list.Envelopes) != 1 || list.Envelopes[0].EnvelopeID != envID {
+		t.Errorf("expected completed envelope in list; got %#v", list)
+	}
+	if _, err = sv.ListStatusChanges().Do(ctx); err == nil {
+		t.Errorf("expected error for missing from_date")
+	}
+
+	docs, err := sv.DocumentsList(envID).Do(ctx)
+	if err != nil || len(docs.EnvelopeDocuments) != 3 {
+		t.Fatalf("expected 2 documents and certificate; got %v %v", docs, err)
+	}
+	for _, tt := range []struct {
+		id   string
+		want string
+	}{
+		{"1", "%PDF-DOC1"},
+		{"2", "%PDF-DOC2"},
+		{"combined", "%PDF-DOC1%PDF-DOC2"},
+	} {
+		dn, err := sv.DocumentsGet(tt.id, envID).Do(ctx)
+		if err != nil {
+			t.Errorf("document %s expected success; got %v", tt.id, err)
+			continue
+		}
+		b, _ := ioutil.ReadAll(dn)
+		dn.Close()
+		if string(b) != tt.want || dn.ContentType != "application/pdf" {
+			t.Errorf("document %s expected %s; got %s %s", tt.id, tt.want, b, dn.ContentType)
+		}
+	}
+}
+
+func TestServer_DraftVoidDecline(t *testing.T) {
+	ctx := context.Background()
+	srv := esigntest.NewServer()
+	defer srv.Close()
+	sv := envelopes.New(srv.Credential())
+
+	def := testDefinition("created")
+	def.Documents[1].DocumentBase64 = []byte("%PDF-DOC2")
+	summary, err := sv.Create(def).Do(ctx)
+	if err != nil || summary.Status != "created" {
+		t.Fatalf("expected draft envelope; got %v %v", summary, err)
+	}
+	if err = srv.Sign(summary.EnvelopeID, "1"); err == nil {
+		t.Errorf("expected error signing draft")
+	}
+	vw, err := sv.ViewsCreateSender(summary.EnvelopeID, &model.ReturnURLRequest{ReturnURL: "https://example.com/sent"}).Do(ctx)
+	if err != nil {
+		t.Fatalf("sender view expected success; got %v", err)
+	}
+	if loc := ceremony(t, vw.URL); loc != "https://example.com/sent?event=Send" {
+		t.Errorf("expected redirect with Send event; got %s", loc)
+	}
+	if _, err = sv.Update(summary.EnvelopeID, &model.Envelope{Status: "voided"}).Do(ctx); err == nil {
+		t.Errorf("expected error voiding without reason")
+	}
+	if _, err = sv.Update(summary.EnvelopeID, &model.Envelope{Status: "voided", VoidedReason: "test"}).Do(ctx); err != nil {
+		t.Errorf("expected void success; got %v", err)
+	}
+	if env, ok := srv.Envelope(summary.EnvelopeID); !ok || env.Status != "voided" || env.VoidedReason != "test" {
+		t.Errorf("expected voided envelope; got %#v", env)
+	}
+
+	// decline
+	summary, err = sv.Create(def).Do(ctx)
+	if err != nil {
+		t.Fatalf("create expected success; got %v", err)
+	}
+	if _, err = sv.Update(summary.EnvelopeID, &model.Envelope{Status: "sent"}).Do(ctx); err != nil {
+		t.Fatalf("send expected success; got %v", err)
+	}
+	if err = srv.Decline(summary.EnvelopeID, "1", "no thanks"); err != nil {
+		t.Fatalf("decline expected success; got %v", err)
+	}
+	if env, ok := srv.Envelope(summary.EnvelopeID); !ok || env.Status != "declined" || env.Recipients.Signers[0].DeclinedReason != "no thanks" {
+		t.Errorf("expected declined envelope; got %#v", env)
+	}
+
+	// errors
+	_, err = sv.Get("BAD_ID").Do(ctx)
+	if re, ok := err.(*esign.ResponseError); !ok || re.ErrorCode != "ENVELOPE_DOES_NOT_EXIST" {
+		t.Errorf("expected ENVELOPE_DOES_NOT_EXIST; got %v", err)
+	}
+	req, _ := http.NewRequest("GET", srv.URL+"/restapi/v2.1/accounts/"+srv.AccountID+"/envelopes/"+summary.EnvelopeID, nil)
+	req.Header.Set("Authorization", "Bearer INVALID")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("expected response; got %v", err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected 401 for invalid token; got %d", res.StatusCode)
+	}
+}
+
+// ceremony opens a view url and returns the redirect location
+func ceremony(t *testing.T, viewURL string) string {
+	cl := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	res, err := cl.Get(viewURL)
+	if err != nil {
+		t.Fatalf("view %s expected success; got %v", viewURL, err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusFound {
+		t.Fatalf("view %s expected redirect; got %d", viewURL, res.StatusCode)
+	}
+	return res.Header.Get("Location")
+}
@@ -0,0 +1,273 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esigntest
+
+// templates.go contains the handlers for server templates and the
+// merging of composite templates into an envelope definition.
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+// templatesAPI handles the template api calls.  segments contains the
+// path elements following "templates".
+func (s *Server) templatesAPI(r *http.Request, segments []string) (int, interface{}, *apiError) {
+	m := r.Method
+	switch {
+	case len(segments) == 0 && m == "POST":
+		return s.createTemplate(r)
+	case len(segments) == 1 && m == "GET":
+		res, err := s.getTemplate(segments[0])
+		return 0, res, err
+	case len(segments) == 2 && m == "GET" && segments[1] == "recipients":
+		res, err := s.listTemplateRecipients(segments[0], r.URL.Query().Get("include_tabs") == "true")
+		return 0, res, err
+	}
+	return http.StatusNotFound, nil, newError("RESOURCE_NOT_FOUND", "%s %s is not implemented by esigntest", r.Method, r.URL.Path)
+}
+
+// templateNotFound is the DocuSign error for an invalid template id
+func templateNotFound(id string) *apiError {
+	return newError("TEMPLATE_ID_INVALID", "Invalid template ID. %s", id)
+}
+
+// createTemplate stores a template.  Documents must be included in the
+// json body as documentBase64.
+func (s *Server) createTemplate(r *http.Request) (int, interface{}, *apiError) {
+	var tmpl *model.EnvelopeTemplate
+	if err := json.NewDecoder(r.Body).Decode(&tmpl); err != nil || tmpl == nil {
+		return 0, nil, newError("INVALID_REQUEST_BODY", "The request body is missing or improperly formatted. %v", err)
+	}
+	for _, d := range tmpl.Documents {
+		if d.DocumentID == "" || len(d.DocumentBase64) == 0 {
+			return 0, nil, newError("UNABLE_TO_LOAD_DOCUMENT", "Unable to load the document. No content for document %q", d.DocumentID)
+		}
+	}
+	tm := now()
+	tmpl.TemplateID = newID()
+	tmpl.CreatedDateTime, tmpl.LastModifiedDateTime = &tm, &tm
+	tmpl.URI = "/templates/" + tmpl.TemplateID
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.templates[tmpl.TemplateID] = tmpl
+	return http.StatusCreated, &model.TemplateSummary{
+		TemplateID: tmpl.TemplateID,
+		Name:       tmpl.Name,
+		URI:        tmpl.URI,
+	}, nil
+}
+
+// getTemplate returns the template without document content.
+func (s *Server) getTemplate(id string) (interface{}, *apiError) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tmpl, ok := s.templates[id]
+	if !ok {
+		return nil, templateNotFound(id)
+	}
+	var res *model.EnvelopeTemplate
+	b, _ := json.Marshal(tmpl)
+	json.Unmarshal(b, &res)
+	for i := range res.Documents {
+		res.Documents[i].DocumentBase64 = nil
+	}
+	return res, nil
+}
+
+// listTemplateRecipients returns the template's recipients removing
+// tabs unless includeTabs is set.
+func (s *Server) listTemplateRecipients(id string, includeTabs bool) (interface{}, *apiError) {
+	s.mu.Lock()
+	tmpl, ok := s.templates[id]
+	s.mu.Unlock()
+	if !ok {
+		return nil, templateNotFound(id)
+	}
+	lists := recipientLists(tmpl.Recipients)
+	cnt := 0
+	for _, list := range lists {
+		for _, rx := range list {
+			if !includeTabs {
+				delete(rx, "tabs")
+			}
+			cnt++
+		}
+	}
+	rcps := fromRecipientLists(lists)
+	rcps.RecipientCount = strconv.Itoa(cnt)
+	return rcps, nil
+}
+
+// recipientLists returns each recipient list of rcps keyed by its json
+// name with the recipients decoded as maps.
+func recipientLists(rcps *model.Recipients) map[string][]map[string]interface{} {
+	lists := make(map[string][]map[string]interface{})
+	if rcps == nil {
+		return lists
+	}
+	var m map[string]json.RawMessage
+	b, _ := json.Marshal(rcps)
+	json.Unmarshal(b, &m)
+	for k, raw := range m {
+		var list []map[string]interface{}
+		if json.Unmarshal(raw, &list) == nil {
+			lists[k] = list
+		}
+	}
+	return lists
+}
+
+func fromRecipientLists(lists map[string][]map[string]interface{}) *model.Recipients {
+	rcps := &model.Recipients{}
+	b, _ := json.Marshal(lists)
+	json.Unmarshal(b, rcps)
+	return rcps
+}
+
+func stringValue(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
+
+// findRecipient returns the index of the recipient in list matching rx.
+// When byRole is set, recipients with role names match by role name.
+// Otherwise recipients match by recipient id.
+func findRecipient(list []map[string]interface{}, rx map[string]interface{}, byRole bool) int {
+	role, id := stringValue(rx["roleName"]), stringValue(rx["recipientId"])
+	for i, d := range list {
+		if dRole := stringValue(d["roleName"]); byRole && role > "" && dRole > "" {
+			if dRole == role {
+				return i
+			}
+			continue
+		}
+		if id > "" && stringValue(d["recipientId"]) == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// mergeRecipients merges the recipients of src into dst.  When overlay
+// is set, a recipient of src replaces the fields of the matching dst
+// recipient and its tab values replace the values of tabs with the same
+// label.  Otherwise the tabs of recipients with the same id are combined.
+func mergeRecipients(dst, src *model.Recipients, overlay bool) *model.Recipients {
+	dl := recipientLists(dst)
+	for typ, list := range recipientLists(src) {
+		for _, rx := range list {
+			i := findRecipient(dl[typ], rx, overlay)
+			if i < 0 {
+				dl[typ] = append(dl[typ], rx)
+				continue
+			}
+			d := dl[typ][i]
+			for k, v := range rx {
+				if k != "tabs" {
+					d[k] = v
+				}
+			}
+			if rx["tabs"] == nil {
+				continue
+			}
+			var dt, st model.Tabs
+			b, _ := json.Marshal(d["tabs"])
+			json.Unmarshal(b, &dt)
+			b, _ = json.Marshal(rx["tabs"])
+			json.Unmarshal(b, &st)
+			for _, tab := range st.All() {
+				if v, ok := tab.Value(); overlay && ok && dt.SetValue(tab.Label(), v) > 0 {
+					continue
+				}
+				dt.Add(tab.Ptr)
+			}
+			d["tabs"] = &dt
+		}
+	}
+	return fromRecipientLists(dl)
+}
+
+// templateLayer is a server or inline template of a composite template
+type templateLayer struct {
+	seq        int
+	docs       []model.Document
+	recipients *model.Recipients
+}
+
+// applyCompositeTemplates replaces the composite templates of def with
+// the documents and recipients they produce.  The server and inline
+// templates of a composite template are applied in sequence order.  The
+// first template with documents supplies the composite template's
+// documents, and recipients overlay earlier recipients with the same
+// role name.  Recipients of different composite templates with the same
+// recipient id are combined, and documents with duplicate ids are
+// renumbered.
+func (s *Server) applyCompositeTemplates(def *model.EnvelopeDefinition, files map[string][]byte) *apiError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var docs []model.Document
+	var rcps *model.Recipients
+	for _, ct := range def.CompositeTemplates {
+		var layers []templateLayer
+		for _, st := range ct.ServerTemplates {
+			tmpl, ok := s.templates[st.TemplateID]
+			if !ok {
+				return templateNotFound(st.TemplateID)
+			}
+			layers = append(layers, templateLayer{seq: routingOrder(st.Sequence), docs: tmpl.Documents, recipients: tmpl.Recipients})
+		}
+		for _, it := range ct.InlineTemplates {
+			layers = append(layers, templateLayer{seq: routingOrder(it.Sequence), docs: it.Documents, recipients: it.Recipients})
+		}
+		sort.SliceStable(layers, func(i, j int) bool {
+			return layers[i].seq < layers[j].seq
+		})
+		var ctDocs []model.Document
+		var ctRcps *model.Recipients
+		for _, l := range layers {
+			if len(ctDocs) == 0 {
+				ctDocs = l.docs
+			}
+			ctRcps = mergeRecipients(ctRcps, l.recipients, true)
+		}
+		if ct.Document != nil {
+			ctDocs = []model.Document{*ct.Document}
+		}
+		for _, d := range ctDocs {
+			if len(d.DocumentBase64) == 0 {
+				d.DocumentBase64 = files[d.DocumentID]
+			}
+			docs = append(docs, d)
+		}
+		rcps = mergeRecipients(rcps, ctRcps, false)
+	}
+	used := make(map[string]bool)
+	for i := range docs {
+		for n := 1; used[docs[i].DocumentID]; n++ {
+			docs[i].DocumentID = strconv.Itoa(n)
+		}
+		used[docs[i].DocumentID] = true
+	}
+	if def.Status == StatusSent {
+		for _, sx := range rcps.Signers {
+			if sx.Email == "" {
+				return newError("INVALID_EMAIL_ADDRESS_FOR_RECIPIENT", "The email address for the recipient is invalid. The recipient Id follows. %s", sx.RecipientID)
+			}
+		}
+		for _, cx := range rcps.CarbonCopies {
+			if cx.Email == "" {
+				return newError("INVALID_EMAIL_ADDRESS_FOR_RECIPIENT", "The email address for the recipient is invalid. The recipient Id follows. %s", cx.RecipientID)
+			}
+		}
+	}
+	def.Documents, def.Recipients, def.CompositeTemplates = docs, rcps, nil
+	return nil
+}
@@ -0,0 +1,13 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+import "time"
+
+// SetPoolClock replaces the time source of p for testing.
+func SetPoolClock(p *JWTCredentialPool, now func() time.Time) {
+	p.now = now
+}
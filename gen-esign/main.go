@@ -5,6 +5,23 @@
 
 // gen-esign creates the esign subpackages based upon DocuSign's
 // esignature.rest.swagger.json definition file.
+//
+// Specification files may be Swagger 2.0 or OpenAPI 3.x documents.  To
+// add an api family, add its esign.APIVersion to definitionFileMap and
+// apiParametersMap and place its specification in -swaggerfiles.
+//
+// Renamed services, skipped operations and type overrides are compiled
+// into gen-esign.  Use -dump-overrides to write them as json and
+// -overrides=<file> to generate using an edited copy in json or yaml
+// (.yaml or .yml extension).
+//
+// The diff subcommand reports the changes between two specification
+// files as Markdown or JSON:
+//
+//   gen-esign diff [-format=json] [old.json] new.json
+//
+// When only new.json is given, it is compared to the specification of
+// the same api in -swaggerfiles, the source of the generated packages.
 
 // Package main is the executable for gen-esign
 package main
@@ -12,9 +29,11 @@ package main
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"go/format"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
@@ -52,9 +71,7 @@ var (
 			ModelPackage:     "model",
 			ModelPackagePath: "v2/model",
 			ModelIsPackage:   true,
-			ModelImports:     []string{"fmt", "strings", "time"},
-			fldOverrides:     swagger.GetFieldOverrides(),
-			paramOverrides:   swagger.GetParameterOverrides(),
+			ModelImports:     []string{"fmt", "sort", "strconv", "strings", "time"},
 		},
 		esign.APIv21: {
 			DocPrefix:        "esign-rest-api/",
@@ -63,10 +80,8 @@ var (
 			ModelFile:        "v2.1/model/model.go",
 			ModelPackage:     "model",
 			ModelPackagePath: "v2.1/model",
-			ModelImports:     []string{"fmt", "strings", "time"},
+			ModelImports:     []string{"fmt", "sort", "strconv", "strings", "time"},
 			ModelIsPackage:   true,
-			fldOverrides:     swagger.GetFieldOverrides(),
-			paramOverrides:   swagger.GetParameterOverrides(),
 		},
 		esign.AdminV2: {
 			DocPrefix:        "admin-api/",
@@ -77,8 +92,6 @@ var (
 			ModelPackagePath: "admin",
 			ModelIsPackage:   true,
 			UseMethodName:    true,
-			fldOverrides:     make(map[string]map[string]string),
-			paramOverrides:   make(map[string]map[string]string),
 		},
 		esign.RoomsV2: {
 			DocPrefix:        "rooms-api/",
@@ -89,8 +102,6 @@ var (
 			ModelPackagePath: "rooms",
 			ModelIsPackage:   true,
 			UseMethodName:    true,
-			fldOverrides:     make(map[string]map[string]string),
-			paramOverrides:   make(map[string]map[string]string),
 		},
 		esign.ClickV1: {
 			DocPrefix:        "click-api/",
@@ -102,8 +113,6 @@ var (
 			ModelPackagePath: "click",
 			ModelIsPackage:   false,
 			UseMethodName:    true,
-			fldOverrides:     make(map[string]map[string]string),
-			paramOverrides:   make(map[string]map[string]string),
 		},
 		esign.MonitorV2: {
 			DocPrefix:        "monitor-api/",
@@ -115,16 +124,18 @@ var (
 			ModelPackagePath: "monitor",
 			ModelIsPackage:   false,
 			UseMethodName:    true,
-			fldOverrides:     make(map[string]map[string]string),
-			paramOverrides:   make(map[string]map[string]string),
 		},
 	}
 
 	baseDir     = flag.String("src", ".", "source directory")
 	serviceTmpl = flag.String("service_templ", "", "override service package template")
 	modelTmpl   = flag.String("model_templ", "", "api definitions template")
+	apiTmpl     = flag.String("api_templ", "", "override service api interface template")
 	specsFolder = flag.String("swaggerfiles", "gen-esign/specs", "directory containing swagger specification files")
 	skipFormat  = flag.Bool("skip_format", false, "skip gofmt command on generated files")
+
+	overridesFile = flag.String("overrides", "", "json or yaml overrides file replacing the built-in overrides")
+	dumpOverrides = flag.Bool("dump-overrides", false, "write the built-in overrides as json to stdout and exit")
 )
 
 // APIGenerateCfg contains parameters for generating an eSignature version
@@ -147,20 +158,44 @@ type APIGenerateCfg struct {
 	ModelIsPackage   bool
 	UseMethodName    bool
 	//ResourceMap      map[string]string
+	overrides      *swagger.Overrides
 	fldOverrides   map[string]map[string]string
 	paramOverrides map[string]map[string]string
+	// validated lists the struct names having a Validate method
+	validated map[string]bool
 }
 
 // ResourceMap returns a map of tags to service name
 func (api APIGenerateCfg) ResourceMap() map[string]string {
-	return swagger.ResourceMaps[api.APIVersion]
+	return api.overrides.API(api.Name).Resources
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "diff" {
+		if err := diffSpecs(os.Args[2:], os.Stdout); err != nil {
+			log.Fatalf("diff: %v", err)
+		}
+		return
+	}
 	flag.Parse()
+	if *dumpOverrides {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(swagger.BuiltinOverrides()); err != nil {
+			log.Fatalf("dump overrides: %v", err)
+		}
+		return
+	}
+	overrides := swagger.BuiltinOverrides()
+	if *overridesFile > "" {
+		var err error
+		if overrides, err = swagger.LoadOverrides(*overridesFile); err != nil {
+			log.Fatalf("Overrides: %v", err)
+		}
+	}
 
 	pkgBaseDir, pkgSwaggerDir, skipFormatting := *baseDir, *specsFolder, *skipFormat
-	codeTmpl, err := parseTemplates(*serviceTmpl, *modelTmpl)
+	codeTmpl, err := parseTemplates(*serviceTmpl, *modelTmpl, *apiTmpl)
 	if err != nil {
 		log.Fatalf("Templates: %v", err)
 	}
@@ -189,6 +224,9 @@ func main() {
 		cfg.BasePkg = pkgBaseName
 		cfg.Templates = codeTmpl
 		cfg.SkipFormat = skipFormatting
+		cfg.overrides = overrides
+		apo := overrides.API(cfg.Name)
+		cfg.fldOverrides, cfg.paramOverrides = apo.Fields, apo.Parameters
 
 		if err := cfg.genVersion(&doc); err != nil {
 			log.Printf("%s %v", cfg.Name, err)
@@ -197,9 +235,77 @@ func main() {
 	}
 }
 
-func parseTemplates(serviceTmplFile, modelTmplFile string) (*template.Template, error) {
+// diffSpecs runs the diff subcommand writing the report to w.
+func diffSpecs(args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("diff", flag.ContinueOnError)
+	reportFormat := fs.String("format", "markdown", "report format: markdown or json")
+	specs := fs.String("swaggerfiles", "gen-esign/specs", "directory containing the specifications of the generated packages")
+	ovrFile := fs.String("overrides", "", "json or yaml overrides file replacing the built-in overrides")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: gen-esign diff [flags] [old.json] new.json\n")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() < 1 || fs.NArg() > 2 {
+		fs.Usage()
+		return errors.New("one or two specification files required")
+	}
+	if *reportFormat != "markdown" && *reportFormat != "json" {
+		return fmt.Errorf("invalid format %q", *reportFormat)
+	}
+	var docs []*swagger.Document
+	names := fs.Args()
+	for _, fn := range names {
+		b, err := ioutil.ReadFile(fn)
+		if err != nil {
+			return err
+		}
+		doc, err := swagger.DecodeDocument(b)
+		if err != nil {
+			return fmt.Errorf("%s decode %w", fn, err)
+		}
+		for _, w := range doc.Warnings {
+			log.Printf("%s warning: %s", fn, w)
+		}
+		docs = append(docs, doc)
+	}
+	newDoc := docs[len(docs)-1]
+	apikey := newDoc.Info.Title + ":" + newDoc.Info.Version
+	if len(docs) == 1 {
+		docmap, err := decodeSwaggerDocs(*specs)
+		if err != nil {
+			return err
+		}
+		current, ok := docmap[definitionFileMap[apikey]]
+		if !ok {
+			return fmt.Errorf("no specification for %s in %s", apikey, *specs)
+		}
+		docs = append([]*swagger.Document{&current}, docs...)
+		names = append([]string{*specs + " (" + apikey + ")"}, names...)
+	}
+	overrides := swagger.BuiltinOverrides()
+	if *ovrFile > "" {
+		var err error
+		if overrides, err = swagger.LoadOverrides(*ovrFile); err != nil {
+			return err
+		}
+	}
+	sd := swagger.DiffSpecs(docs[0], newDoc, overrides.API(apikey))
+	sd.Old, sd.New = names[0], names[1]
+	if *reportFormat == "json" {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(sd)
+	}
+	_, err := io.WriteString(w, sd.Markdown())
+	return err
+}
+
+func parseTemplates(serviceTmplFile, modelTmplFile, apiTmplFile string) (*template.Template, error) {
 	var err error
-	svc, model := templates.Service, templates.Model
+	svc, model, apiText := templates.Service, templates.Model, templates.API
 	if serviceTmplFile > "" {
 		b, err := ioutil.ReadFile(serviceTmplFile)
 		if err != nil {
@@ -221,6 +327,16 @@ func parseTemplates(serviceTmplFile, modelTmplFile string) (*template.Template,
 	if _, err = tmpl.New("model.tmpl").Parse(model); err != nil {
 		return nil, fmt.Errorf("model.tmpl: %w", err)
 	}
+	if apiTmplFile > "" {
+		b, err := ioutil.ReadFile(apiTmplFile)
+		if err != nil {
+			return nil, fmt.Errorf("%s read %w", apiTmplFile, err)
+		}
+		apiText = string(b)
+	}
+	if _, err = tmpl.New("api.tmpl").Parse(apiText); err != nil {
+		return nil, fmt.Errorf("api.tmpl: %w", err)
+	}
 
 	return tmpl, err
 }
@@ -242,10 +358,13 @@ func decodeSwaggerDocs(folderName string) (map[esign.APIVersion]swagger.Document
 		if err != nil {
 			return nil, err
 		}
-		var doc *swagger.Document
-		if err = json.Unmarshal(b, &doc); err != nil {
+		doc, err := swagger.DecodeDocument(b)
+		if err != nil {
 			return nil, fmt.Errorf("%s decode %w", f.Name(), err)
 		}
+		for _, w := range doc.Warnings {
+			log.Printf("%s warning: %s", f.Name(), w)
+		}
 		apikey := doc.Info.Title + ":" + doc.Info.Version
 		apiVersion, ok := definitionFileMap[apikey]
 		if !ok {
@@ -293,11 +412,11 @@ func (api *APIGenerateCfg) genVersion(doc *swagger.Document) error {
 			continue
 		}
 		fullService := api.Version + ":" + op.Service
-		if newServiceName, ok := swagger.ServiceNameOverride[fullService]; ok {
+		if newServiceName, ok := api.overrides.ServiceNames[fullService]; ok {
 			op.Service = newServiceName
 		}
 		fullOpName := api.Version + ":" + op.OperationID
-		if !swagger.OperationSkipList[fullOpName] {
+		if !api.overrides.IsSkipped(fullOpName) {
 			serviceName, ok := api.ResourceMap()[op.Tags[0]]
 			if ok {
 				op.Service = serviceName
@@ -330,11 +449,26 @@ func (api *APIGenerateCfg) doModel(defList []swagger.Definition, defMap map[stri
 	// create model.go
 	// get field overrides and tab overrides
 	tabDefs := swagger.TabDefs(api.Name, defMap, api.fldOverrides)
+	tabCode, err := swagger.TabAccessorCode(swagger.TabKinds(api.Name, defMap, api.fldOverrides))
+	if err != nil {
+		return err
+	}
+	defs := append(tabDefs, defList...) // Prepend tab definitions
+	defs = swagger.ApplyConstraints(defs, api.overrides.API(api.Name).Constraints)
+	if api.validated, err = swagger.ValidatedTypes(defs, defMap, api.fldOverrides); err != nil {
+		return err
+	}
+	imports := api.ModelImports
+	if len(api.validated) > 0 {
+		imports = append(append([]string{}, imports...), pkgBaseName)
+	}
 	var data = struct {
 		Definitions      []swagger.Definition
 		DefMap           map[string]swagger.Definition
 		FldOverrides     map[string]map[string]string
+		Validated        map[string]bool
 		CustomCode       string
+		TabCode          string
 		DocPrefix        string
 		VersionID        string
 		IsPackage        bool
@@ -343,17 +477,19 @@ func (api *APIGenerateCfg) doModel(defList []swagger.Definition, defMap map[stri
 		ModelImports     []string
 		Scopes           string
 	}{
-		Definitions:  append(tabDefs, defList...), // Prepend tab definitions
+		Definitions:  defs,
 		DefMap:       defMap,
 		FldOverrides: api.fldOverrides,
+		Validated:    api.validated,
 		CustomCode:   swagger.CustomCode(api.Name),
+		TabCode:      tabCode,
 		DocPrefix:    api.DocPrefix,
 
 		VersionID:        api.Version,
 		IsPackage:        api.ModelIsPackage,
 		ModelPackage:     api.ModelPackage,
 		ModelPackagePath: api.ModelPackagePath,
-		ModelImports:     api.ModelImports,
+		ModelImports:     imports,
 		Scopes:           swagger.PackageScopes(api.APIVersion),
 	}
 	modelBuffer := &bytes.Buffer{}
@@ -385,6 +521,76 @@ type ExtOperation struct {
 	Result            string
 	DownloadAdditions []swagger.DownloadAddition
 	JSONResponse      bool
+	Paging            *swagger.Paging
+	// ValidatesPayload indicates the payload has a Validate method
+	ValidatesPayload bool
+}
+
+// APIMethod describes a Service method for the api template
+type APIMethod struct {
+	Name   string
+	Params []APIParam
+	Result string
+}
+
+// APIParam is a parameter of a Service method.  A variadic parameter's
+// Type begins with "...".
+type APIParam struct {
+	Name string
+	Type string
+}
+
+// ParamList returns the method's parameters following the context.
+func (m APIMethod) ParamList() string {
+	var s string
+	for _, p := range m.Params {
+		s += ", " + p.Name + " " + p.Type
+	}
+	return s
+}
+
+// Args returns the arguments for calling the Service method.
+func (m APIMethod) Args() string {
+	return strings.TrimPrefix(m.CallArgs(), ", ")
+}
+
+// CallArgs returns the arguments following the context for calling a
+// function with the method's signature.
+func (m APIMethod) CallArgs() string {
+	var s string
+	for _, p := range m.Params {
+		s += ", " + p.Name
+		if strings.HasPrefix(p.Type, "...") {
+			s += "..."
+		}
+	}
+	return s
+}
+
+// Results returns the method's result list.
+func (m APIMethod) Results() string {
+	if m.Result == "" {
+		return "error"
+	}
+	return "(" + m.Result + ", error)"
+}
+
+// APIMethod returns the description of the op's Service method.
+func (o ExtOperation) APIMethod() APIMethod {
+	m := APIMethod{Name: o.FuncName, Result: o.Result}
+	for _, p := range o.PathParams {
+		m.Params = append(m.Params, APIParam{Name: p.GoName, Type: "string"})
+	}
+	switch {
+	case o.IsMediaUpload:
+		m.Params = append(m.Params, APIParam{Name: "media", Type: "io.Reader"}, APIParam{Name: "mimeType", Type: "string"})
+	case o.OpPayload != nil:
+		m.Params = append(m.Params, APIParam{Name: o.OpPayload.GoName, Type: o.OpPayload.Type})
+	}
+	if o.HasUploads {
+		m.Params = append(m.Params, APIParam{Name: "uploads", Type: "...*esign.UploadFile"})
+	}
+	return m
 }
 
 // doPackage creates a subpackage go file
@@ -401,6 +607,8 @@ func (api *APIGenerateCfg) doPackage(resTempl *template.Template, serviceName st
 	}
 
 	extOps := make([]ExtOperation, 0, len(ops))
+	var funcNames []string
+	var opQueryOpts [][]swagger.QueryOpt
 	modelPkg := ""
 	if api.ModelIsPackage {
 		modelPkg = api.ModelPackage
@@ -411,19 +619,26 @@ func (api *APIGenerateCfg) doPackage(resTempl *template.Template, serviceName st
 			modelPackage = ""
 		}
 		payload := op.Payload(defMap, modelPackage)
+		queryOpts := op.QueryOpts(api.paramOverrides)
+		result := op.Result(defMap, modelPkg)
+		funcName := op.GoFuncName(api.UseMethodName, api.overrides.Prefixes(op.Service))
+		funcNames, opQueryOpts = append(funcNames, funcName), append(opQueryOpts, queryOpts)
 		extOps = append(extOps, ExtOperation{
 			Operation:         op,
 			OpPayload:         payload,
-			HasUploads:        swagger.IsUploadFilesOperation(api.Version + ":" + op.OperationID),
+			HasUploads:        api.overrides.IsUploadOperation(api.Version + ":" + op.OperationID),
 			IsMediaUpload:     payload != nil && payload.Type == "*esign.UploadFile",
 			PathParams:        op.PathParameters(),
-			FuncName:          op.GoFuncName(api.UseMethodName, swagger.GetServicePrefixes(op.Service)),
-			QueryOptions:      op.QueryOpts(api.paramOverrides),
-			Result:            op.Result(defMap, modelPkg),
-			DownloadAdditions: swagger.GetDownloadAdditions(api.Version + ":" + op.OperationID),
+			FuncName:          funcName,
+			QueryOptions:      queryOpts,
+			Result:            result,
+			DownloadAdditions: api.overrides.Downloads[api.Version+":"+op.OperationID],
 			JSONResponse:      op.ReturnsJSON(),
+			Paging:            op.Paging(defMap, api.fldOverrides, queryOpts, result),
+			ValidatesPayload:  payload != nil && !strings.HasPrefix(payload.Type, "*esign.") && api.validated[swagger.PayloadType(payload.Type)],
 		})
 	}
+	enumTypes := swagger.QueryEnumTypes(funcNames, opQueryOpts)
 	docService := serviceName
 	if api.DocService > "" {
 		docService = api.DocService
@@ -445,6 +660,8 @@ func (api *APIGenerateCfg) doPackage(resTempl *template.Template, serviceName st
 		CallVersion      string
 		AddDocLinks      bool
 		Accept           string
+		EnumTypes        []*swagger.EnumType
+		NoValidation     bool
 	}{
 		Service:          serviceName,
 		Package:          packageName,
@@ -461,6 +678,8 @@ func (api *APIGenerateCfg) doPackage(resTempl *template.Template, serviceName st
 		VersionID:        api.Version,
 		CallVersion:      api.CallVersion,
 		AddDocLinks:      (serviceName != "Uncategorized"),
+		EnumTypes:        enumTypes,
+		NoValidation:     len(api.validated) == 0,
 	}
 	importMap := make(map[string]bool)
 	for _, op := range extOps {
@@ -471,20 +690,23 @@ func (api *APIGenerateCfg) doPackage(resTempl *template.Template, serviceName st
 	}
 	for _, o := range extOps {
 		for _, q := range o.QueryOptions {
-			switch q.Type {
-			case "...string":
+			switch {
+			case q.IsVariadic():
 				importMap[`"strings"`] = true
-			case "int", "int32", "int64":
+			case q.Type == "int", q.Type == "int32", q.Type == "int64":
 				importMap[`"fmt"`] = true
-			case "float64":
+			case q.Type == "float64":
 				importMap[`"fmt"`] = true
-			case "time.Time":
+			case q.Type == "time.Time":
 				importMap[`"time"`] = true
 			}
 		}
 		if o.IsMediaUpload {
 			importMap[`"io"`] = true
 		}
+		if o.Paging != nil && o.Paging.UsesFmt {
+			importMap[`"fmt"`] = true
+		}
 	}
 
 	for k, v := range importMap {
@@ -511,8 +733,53 @@ func (api *APIGenerateCfg) doPackage(resTempl *template.Template, serviceName st
 			pkgBuffer = bytes.NewBuffer(pkgBytes)
 		}
 	}
-	return api.makePackageFile(packageFile, pkgBuffer.Bytes())
+	if err := api.makePackageFile(packageFile, pkgBuffer.Bytes()); err != nil {
+		return err
+	}
+	return api.doAPI(path.Dir(packageFile)+"/api.go", serviceName, packageName, extOps)
+}
 
+// doAPI creates the api.go file containing the API interface and Stub
+// for a subpackage's operations.
+func (api *APIGenerateCfg) doAPI(fileName, serviceName, packageName string, ops []ExtOperation) error {
+	var data = struct {
+		Service  string
+		Package  string
+		Packages []string
+		Methods  []APIMethod
+	}{
+		Service:  serviceName,
+		Package:  packageName,
+		Packages: []string{`"context"`},
+	}
+	usesModel := false
+	for _, op := range ops {
+		m := op.APIMethod()
+		for _, p := range append(m.Params, APIParam{Type: m.Result}) {
+			if api.ModelIsPackage && strings.Contains(p.Type, api.ModelPackage+".") {
+				usesModel = true
+			}
+		}
+		if op.IsMediaUpload && len(data.Packages) == 1 {
+			data.Packages = append(data.Packages, `"io"`)
+		}
+		data.Methods = append(data.Methods, m)
+	}
+	data.Packages = append(data.Packages, "", "\""+api.BasePkg+"\"")
+	if usesModel {
+		data.Packages = append(data.Packages, "\""+api.BasePkg+"/"+api.ModelPackagePath+"\"")
+	}
+	buf := &bytes.Buffer{}
+	if err := api.Templates.Lookup("api.tmpl").Execute(buf, data); err != nil {
+		return err
+	}
+	if !*skipFormat {
+		b, err := format.Source(buf.Bytes())
+		if err == nil {
+			buf = bytes.NewBuffer(b)
+		}
+	}
+	return api.makePackageFile(fileName, buf.Bytes())
 }
 
 func (api *APIGenerateCfg) makePackageFile(fileName string, content []byte) error {
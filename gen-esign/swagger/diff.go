@@ -0,0 +1,365 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package swagger
+
+// diff.go contains the comparison of two versions of a specification
+// for reviewing upgrades and writing release notes.
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// SpecDiff contains the differences between two versions of a
+// specification.  Parameter and field types are the generated Go types.
+type SpecDiff struct {
+	Old                string            `json:"old"`
+	New                string            `json:"new"`
+	AddedOperations    []OperationRef    `json:"addedOperations,omitempty"`
+	RemovedOperations  []OperationRef    `json:"removedOperations,omitempty"`
+	RenamedOperations  []OperationRename `json:"renamedOperations,omitempty"`
+	AddedDefinitions   []string          `json:"addedDefinitions,omitempty"`
+	RemovedDefinitions []string          `json:"removedDefinitions,omitempty"`
+	ParameterChanges   []TypeChange      `json:"parameterChanges,omitempty"`
+	FieldChanges       []TypeChange      `json:"fieldChanges,omitempty"`
+	EnumChanges        []EnumChange      `json:"enumChanges,omitempty"`
+	NewlyRequired      []RequiredChange  `json:"newlyRequired,omitempty"`
+}
+
+// OperationRef identifies an operation.
+type OperationRef struct {
+	OperationID string `json:"operationId"`
+	Method      string `json:"method"`
+	Path        string `json:"path"`
+}
+
+// OperationRename describes an operation whose id changed while its
+// method and path remain.
+type OperationRename struct {
+	OldID  string `json:"oldId"`
+	NewID  string `json:"newId"`
+	Method string `json:"method"`
+	Path   string `json:"path"`
+}
+
+// TypeChange describes an added, removed or retyped query parameter
+// or struct field.  Owner is the operation id or struct name.  An empty
+// OldType indicates an addition and an empty NewType a removal.
+type TypeChange struct {
+	Owner   string `json:"owner"`
+	Name    string `json:"name"`
+	OldType string `json:"oldType,omitempty"`
+	NewType string `json:"newType,omitempty"`
+}
+
+// EnumChange lists the values added to and removed from a query
+// parameter or field.
+type EnumChange struct {
+	Owner   string   `json:"owner"`
+	Name    string   `json:"name"`
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+// RequiredChange identifies a query parameter or field that became
+// required.
+type RequiredChange struct {
+	Owner string `json:"owner"`
+	Name  string `json:"name"`
+}
+
+// IsEmpty reports whether the specifications have no differences.
+func (sd *SpecDiff) IsEmpty() bool {
+	return len(sd.AddedOperations)+len(sd.RemovedOperations)+len(sd.RenamedOperations)+
+		len(sd.AddedDefinitions)+len(sd.RemovedDefinitions)+len(sd.ParameterChanges)+
+		len(sd.FieldChanges)+len(sd.EnumChanges)+len(sd.NewlyRequired) == 0
+}
+
+var pathParamPattern = regexp.MustCompile(`\{[^}]*\}`)
+
+// endpoint returns the op's method and path ignoring path parameter names.
+func endpoint(op Operation) string {
+	return op.HTTPMethod + " " + pathParamPattern.ReplaceAllString(op.Path, "{}")
+}
+
+// DiffSpecs compares the old and new versions of a specification.
+// apo's parameter and field overrides are applied to both versions
+// before comparing types.
+func DiffSpecs(oldDoc, newDoc *Document, apo *APIOverrides) *SpecDiff {
+	if apo == nil {
+		apo = &APIOverrides{}
+	}
+	sd := &SpecDiff{
+		Old: oldDoc.Info.Title + " " + oldDoc.Info.Version,
+		New: newDoc.Info.Title + " " + newDoc.Info.Version,
+	}
+	sd.diffOperations(oldDoc.Operations, newDoc.Operations, apo.Parameters)
+	sd.diffDefinitions(oldDoc.Definitions, newDoc.Definitions, apo.Fields)
+	return sd
+}
+
+func (sd *SpecDiff) diffOperations(oldOps, newOps OpList, overrides map[string]map[string]string) {
+	oldMap, newMap := make(map[string]Operation), make(map[string]Operation)
+	for _, op := range oldOps {
+		oldMap[op.OperationID] = op
+	}
+	for _, op := range newOps {
+		newMap[op.OperationID] = op
+	}
+	// removed operations keyed by endpoint for matching renames
+	removed := make(map[string][]Operation)
+	for _, id := range sortedOpIDs(oldMap) {
+		if _, ok := newMap[id]; !ok {
+			ep := endpoint(oldMap[id])
+			removed[ep] = append(removed[ep], oldMap[id])
+		}
+	}
+	for _, id := range sortedOpIDs(newMap) {
+		op := newMap[id]
+		prev, ok := oldMap[id]
+		if !ok {
+			ep := endpoint(op)
+			if len(removed[ep]) == 0 {
+				sd.AddedOperations = append(sd.AddedOperations, OperationRef{OperationID: id, Method: op.HTTPMethod, Path: op.Path})
+				continue
+			}
+			prev, removed[ep] = removed[ep][0], removed[ep][1:]
+			sd.RenamedOperations = append(sd.RenamedOperations, OperationRename{OldID: prev.OperationID, NewID: id, Method: op.HTTPMethod, Path: op.Path})
+		}
+		sd.diffParameters(id, prev, op, overrides)
+	}
+	for _, ops := range removed {
+		for _, op := range ops {
+			sd.RemovedOperations = append(sd.RemovedOperations, OperationRef{OperationID: op.OperationID, Method: op.HTTPMethod, Path: op.Path})
+		}
+	}
+	sort.Slice(sd.RemovedOperations, func(i, j int) bool {
+		return sd.RemovedOperations[i].OperationID < sd.RemovedOperations[j].OperationID
+	})
+}
+
+func sortedOpIDs(m map[string]Operation) []string {
+	var ids []string
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// diffParameters compares the query parameters of an operation.  The
+// parameter overrides of the new operation id are applied to both.
+func (sd *SpecDiff) diffParameters(id string, oldOp, newOp Operation, overrides map[string]map[string]string) {
+	oldOp.OperationID = newOp.OperationID
+	oldOpts, newOpts := make(map[string]QueryOpt), make(map[string]QueryOpt)
+	for _, q := range oldOp.QueryOpts(overrides) {
+		oldOpts[q.Name] = q
+	}
+	for _, q := range newOp.QueryOpts(overrides) {
+		newOpts[q.Name] = q
+	}
+	oldRequired, newRequired := requiredQueryParams(oldOp), requiredQueryParams(newOp)
+	var names []string
+	for name := range oldOpts {
+		names = append(names, name)
+	}
+	for name := range newOpts {
+		if _, ok := oldOpts[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		oq, nq := oldOpts[name], newOpts[name]
+		if oq.Type != nq.Type {
+			sd.ParameterChanges = append(sd.ParameterChanges, TypeChange{Owner: id, Name: name, OldType: oq.Type, NewType: nq.Type})
+		}
+		if oq.Type > "" && nq.Type > "" {
+			sd.addEnumChange(id, name, oq.Values, nq.Values)
+		}
+		if newRequired[name] && !oldRequired[name] {
+			sd.NewlyRequired = append(sd.NewlyRequired, RequiredChange{Owner: id, Name: name})
+		}
+	}
+}
+
+func requiredQueryParams(op Operation) map[string]bool {
+	m := make(map[string]bool)
+	for _, p := range op.Parameters {
+		if p.In == "query" && p.Required {
+			m[p.Name] = true
+		}
+	}
+	return m
+}
+
+func (sd *SpecDiff) diffDefinitions(oldDefs, newDefs DefSlice, overrides map[string]map[string]string) {
+	oldMap, newMap := definitionMap(oldDefs), definitionMap(newDefs)
+	var ids []string
+	for id := range oldMap {
+		if _, ok := newMap[id]; !ok {
+			sd.RemovedDefinitions = append(sd.RemovedDefinitions, oldMap[id].StructName())
+		}
+	}
+	for id := range newMap {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	sort.Strings(sd.RemovedDefinitions)
+	for _, id := range ids {
+		nd := newMap[id]
+		od, ok := oldMap[id]
+		if !ok {
+			sd.AddedDefinitions = append(sd.AddedDefinitions, nd.StructName())
+			continue
+		}
+		owner := nd.StructName()
+		oldTypes, newTypes := fieldTypes(od, oldMap, overrides), fieldTypes(nd, newMap, overrides)
+		oldEnums, newEnums := make(map[string][]string), make(map[string][]string)
+		for _, f := range od.Fields {
+			oldEnums[f.Name] = f.Enum
+		}
+		var names []string
+		for _, f := range nd.Fields {
+			names = append(names, f.Name)
+			newEnums[f.Name] = f.Enum
+		}
+		for _, f := range od.Fields {
+			if _, ok := newEnums[f.Name]; !ok {
+				names = append(names, f.Name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if oldTypes[name] != newTypes[name] {
+				sd.FieldChanges = append(sd.FieldChanges, TypeChange{Owner: owner, Name: name, OldType: oldTypes[name], NewType: newTypes[name]})
+			}
+			if oldTypes[name] > "" && newTypes[name] > "" {
+				sd.addEnumChange(owner, name, oldEnums[name], newEnums[name])
+			}
+		}
+		oldRequired := make(map[string]bool)
+		for _, name := range od.Required {
+			oldRequired[name] = true
+		}
+		for _, name := range nd.Required {
+			if !oldRequired[name] {
+				sd.NewlyRequired = append(sd.NewlyRequired, RequiredChange{Owner: owner, Name: name})
+			}
+		}
+	}
+}
+
+// definitionMap returns the definitions keyed as $ref values.
+func definitionMap(defs DefSlice) map[string]Definition {
+	m := make(map[string]Definition)
+	for _, d := range defs {
+		m["#/definitions/"+d.ID] = d
+	}
+	return m
+}
+
+// fieldTypes returns the Go type of each field keyed by json name.
+func fieldTypes(d Definition, defMap map[string]Definition, overrides map[string]map[string]string) map[string]string {
+	m := make(map[string]string)
+	for _, f := range d.StructFields(defMap, overrides) {
+		if f.JSON > "" {
+			m[f.JSON] = f.Type
+		}
+	}
+	return m
+}
+
+func (sd *SpecDiff) addEnumChange(owner, name string, oldValues, newValues []string) {
+	added, removed := listDiff(oldValues, newValues), listDiff(newValues, oldValues)
+	if len(added) > 0 || len(removed) > 0 {
+		sd.EnumChanges = append(sd.EnumChanges, EnumChange{Owner: owner, Name: name, Added: added, Removed: removed})
+	}
+}
+
+// listDiff returns the values of b not found in a.
+func listDiff(a, b []string) []string {
+	m := make(map[string]bool)
+	for _, v := range a {
+		m[v] = true
+	}
+	var res []string
+	for _, v := range b {
+		if !m[v] {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// Markdown returns the differences as a Markdown report.
+func (sd *SpecDiff) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Specification changes\n\n%s → %s\n", sd.Old, sd.New)
+	if sd.IsEmpty() {
+		b.WriteString("\nNo changes.\n")
+		return b.String()
+	}
+	opList := func(title string, ops []OperationRef) {
+		if len(ops) > 0 {
+			fmt.Fprintf(&b, "\n## %s\n\n", title)
+			for _, op := range ops {
+				fmt.Fprintf(&b, "- `%s` %s %s\n", op.OperationID, op.Method, op.Path)
+			}
+		}
+	}
+	opList("Added operations", sd.AddedOperations)
+	opList("Removed operations", sd.RemovedOperations)
+	if len(sd.RenamedOperations) > 0 {
+		b.WriteString("\n## Renamed operations\n\n")
+		for _, r := range sd.RenamedOperations {
+			fmt.Fprintf(&b, "- `%s` → `%s` %s %s\n", r.OldID, r.NewID, r.Method, r.Path)
+		}
+	}
+	nameList := func(title string, names []string) {
+		if len(names) > 0 {
+			fmt.Fprintf(&b, "\n## %s\n\n", title)
+			for _, nm := range names {
+				fmt.Fprintf(&b, "- `%s`\n", nm)
+			}
+		}
+	}
+	nameList("Added definitions", sd.AddedDefinitions)
+	nameList("Removed definitions", sd.RemovedDefinitions)
+	typeTable := func(title, ownerTitle, nameTitle string, changes []TypeChange) {
+		if len(changes) > 0 {
+			fmt.Fprintf(&b, "\n## %s\n\n| %s | %s | Old type | New type |\n| --- | --- | --- | --- |\n", title, ownerTitle, nameTitle)
+			for _, c := range changes {
+				fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", c.Owner, c.Name, markdownCode(c.OldType, "added"), markdownCode(c.NewType, "removed"))
+			}
+		}
+	}
+	typeTable("Parameter changes", "Operation", "Parameter", sd.ParameterChanges)
+	typeTable("Field changes", "Struct", "Field", sd.FieldChanges)
+	if len(sd.EnumChanges) > 0 {
+		b.WriteString("\n## Enum changes\n\n| Owner | Name | Added | Removed |\n| --- | --- | --- | --- |\n")
+		for _, c := range sd.EnumChanges {
+			fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", c.Owner, c.Name, strings.Join(c.Added, ", "), strings.Join(c.Removed, ", "))
+		}
+	}
+	if len(sd.NewlyRequired) > 0 {
+		b.WriteString("\n## Newly required\n\n")
+		for _, r := range sd.NewlyRequired {
+			fmt.Fprintf(&b, "- %s `%s`\n", r.Owner, r.Name)
+		}
+	}
+	return b.String()
+}
+
+// markdownCode formats a type as code or returns empty when ty is blank.
+func markdownCode(ty, empty string) string {
+	if ty == "" {
+		return "_" + empty + "_"
+	}
+	return "`" + strings.Replace(ty, "|", "\\|", -1) + "`"
+}
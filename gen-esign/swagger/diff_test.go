@@ -0,0 +1,175 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package swagger
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// diffBase returns a small specification for diff tests.
+func diffBase() *Document {
+	return &Document{
+		Info: Info{Title: "Test API", Version: "v1"},
+		Operations: OpList{
+			{
+				OperationID: "Rooms_GetRooms", HTTPMethod: "GET", Path: "/v1/rooms",
+				Parameters: []Property{
+					{Name: "count", In: "query", Type: "integer"},
+					{Name: "status", In: "query", Type: "string", Enum: []string{"active", "closed"}},
+				},
+			},
+			{OperationID: "Rooms_GetRoom", HTTPMethod: "GET", Path: "/v1/rooms/{roomId}"},
+			{OperationID: "Rooms_DeleteRoom", HTTPMethod: "DELETE", Path: "/v1/rooms/{roomId}"},
+		},
+		Definitions: DefSlice{
+			{
+				ID: "Room", Name: "room",
+				Required: []string{"name"},
+				Fields: FieldList{
+					{Name: "name", Type: "string"},
+					{Name: "officeId", Type: "integer", Format: "int32"},
+					{Name: "status", Type: "string", Enum: []string{"active", "closed"}},
+				},
+			},
+		},
+	}
+}
+
+func TestDiffSpecs(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(d *Document)
+		want   *SpecDiff
+	}{
+		{
+			name:   "no change",
+			change: func(d *Document) {},
+			want:   &SpecDiff{},
+		},
+		{
+			name: "added op",
+			change: func(d *Document) {
+				d.Operations = append(d.Operations, Operation{OperationID: "Rooms_PostRoom", HTTPMethod: "POST", Path: "/v1/rooms"})
+			},
+			want: &SpecDiff{AddedOperations: []OperationRef{{OperationID: "Rooms_PostRoom", Method: "POST", Path: "/v1/rooms"}}},
+		},
+		{
+			name: "removed op",
+			change: func(d *Document) {
+				d.Operations = d.Operations[:2]
+			},
+			want: &SpecDiff{RemovedOperations: []OperationRef{{OperationID: "Rooms_DeleteRoom", Method: "DELETE", Path: "/v1/rooms/{roomId}"}}},
+		},
+		{
+			name: "renamed op",
+			change: func(d *Document) {
+				d.Operations[1].OperationID = "Rooms_GetRoomById"
+				d.Operations[1].Path = "/v1/rooms/{id}"
+			},
+			want: &SpecDiff{RenamedOperations: []OperationRename{{OldID: "Rooms_GetRoom", NewID: "Rooms_GetRoomById", Method: "GET", Path: "/v1/rooms/{id}"}}},
+		},
+		{
+			name: "type change",
+			change: func(d *Document) {
+				d.Operations[0].Parameters[0].Type = "string"
+				d.Definitions[0].Fields[1] = Field{Name: "officeId", Type: "string"}
+			},
+			want: &SpecDiff{
+				ParameterChanges: []TypeChange{{Owner: "Rooms_GetRooms", Name: "count", OldType: "int", NewType: "string"}},
+				FieldChanges:     []TypeChange{{Owner: "Room", Name: "officeId", OldType: "int32", NewType: "string"}},
+			},
+		},
+		{
+			name: "added and removed fields",
+			change: func(d *Document) {
+				d.Definitions[0].Fields = append(d.Definitions[0].Fields[1:], Field{Name: "ownerId", Type: "string"})
+			},
+			want: &SpecDiff{
+				FieldChanges: []TypeChange{
+					{Owner: "Room", Name: "name", OldType: "string"},
+					{Owner: "Room", Name: "ownerId", NewType: "string"},
+				},
+			},
+		},
+		{
+			name: "enum add and remove",
+			change: func(d *Document) {
+				d.Operations[0].Parameters[1].Enum = []string{"active", "pending"}
+				d.Definitions[0].Fields[2].Enum = []string{"closed", "archived"}
+			},
+			want: &SpecDiff{
+				EnumChanges: []EnumChange{
+					{Owner: "Rooms_GetRooms", Name: "status", Added: []string{"pending"}, Removed: []string{"closed"}},
+					{Owner: "Room", Name: "status", Added: []string{"archived"}, Removed: []string{"active"}},
+				},
+			},
+		},
+		{
+			name: "newly required",
+			change: func(d *Document) {
+				d.Operations[0].Parameters[0].Required = true
+				d.Definitions[0].Required = append(d.Definitions[0].Required, "officeId")
+			},
+			want: &SpecDiff{
+				NewlyRequired: []RequiredChange{
+					{Owner: "Rooms_GetRooms", Name: "count"},
+					{Owner: "Room", Name: "officeId"},
+				},
+			},
+		},
+		{
+			name: "definitions",
+			change: func(d *Document) {
+				d.Definitions[0].ID, d.Definitions[0].Name = "Office", "office"
+			},
+			want: &SpecDiff{AddedDefinitions: []string{"Office"}, RemovedDefinitions: []string{"Room"}},
+		},
+	}
+	for _, tt := range tests {
+		newDoc := diffBase()
+		tt.change(newDoc)
+		got := DiffSpecs(diffBase(), newDoc, nil)
+		tt.want.Old, tt.want.New = "Test API v1", "Test API v1"
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: expected %#v; got %#v", tt.name, tt.want, got)
+		}
+		if got.IsEmpty() != (tt.name == "no change") {
+			t.Errorf("%s: IsEmpty returned %v", tt.name, got.IsEmpty())
+		}
+	}
+}
+
+func TestDiffSpecs_Overrides(t *testing.T) {
+	newDoc := diffBase()
+	newDoc.Operations[0].Parameters[0].Type = "string"
+	apo := &APIOverrides{
+		Parameters: map[string]map[string]string{"Rooms_GetRooms": {"count": "string"}},
+	}
+	if sd := DiffSpecs(diffBase(), newDoc, apo); !sd.IsEmpty() {
+		t.Errorf("expected overridden types to match; got %#v", sd)
+	}
+}
+
+func TestSpecDiff_Markdown(t *testing.T) {
+	newDoc := diffBase()
+	newDoc.Operations = append(newDoc.Operations[1:], Operation{OperationID: "Rooms_PostRoom", HTTPMethod: "POST", Path: "/v1/rooms"})
+	newDoc.Definitions[0].Fields[1] = Field{Name: "officeId", Type: "string"}
+	md := DiffSpecs(diffBase(), newDoc, nil).Markdown()
+	for _, want := range []string{
+		"## Added operations\n\n- `Rooms_PostRoom` POST /v1/rooms\n",
+		"## Removed operations\n\n- `Rooms_GetRooms` GET /v1/rooms\n",
+		"| Room | officeId | `int32` | `string` |\n",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("expected %q in\n%s", want, md)
+		}
+	}
+	if md := DiffSpecs(diffBase(), diffBase(), nil).Markdown(); !strings.Contains(md, "No changes.") {
+		t.Errorf("expected no changes; got %s", md)
+	}
+}
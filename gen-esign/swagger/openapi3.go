@@ -0,0 +1,574 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package swagger
+
+// openapi3.go contains the conversion of OpenAPI 3.x specifications
+// into the Swagger 2.0 model used for generation.
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// DecodeDocument decodes a Swagger 2.0 or OpenAPI 3.x specification.
+// OpenAPI 3 documents are converted so that schemas become Definitions
+// referenced as "#/definitions/<ID>", request bodies become body
+// parameters and response content becomes response schemas.
+func DecodeDocument(b []byte) (*Document, error) {
+	var hdr struct {
+		OpenAPI string `json:"openapi"`
+	}
+	if err := json.Unmarshal(b, &hdr); err != nil {
+		return nil, err
+	}
+	if hdr.OpenAPI == "" {
+		var doc *Document
+		if err := json.Unmarshal(b, &doc); err != nil {
+			return nil, err
+		}
+		if doc == nil {
+			return nil, fmt.Errorf("empty specification")
+		}
+		return doc, nil
+	}
+	if !strings.HasPrefix(hdr.OpenAPI, "3.") {
+		return nil, fmt.Errorf("unsupported openapi version %s", hdr.OpenAPI)
+	}
+	var spec *openAPI3
+	if err := json.Unmarshal(b, &spec); err != nil {
+		return nil, err
+	}
+	// keep file order of paths and schemas as with Swagger 2.0 files
+	var order struct {
+		Paths      objectKeys `json:"paths"`
+		Components struct {
+			Schemas objectKeys `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(b, &order); err != nil {
+		return nil, err
+	}
+	return spec.document(order.Paths, order.Components.Schemas)
+}
+
+// objectKeys decodes the keys of a json object in order.
+type objectKeys []string
+
+// UnmarshalJSON reads the object's keys skipping their values.
+func (k *objectKeys) UnmarshalJSON(b []byte) error {
+	d := json.NewDecoder(bytes.NewReader(b))
+	if _, err := d.Token(); err != nil {
+		return err
+	}
+	for d.More() {
+		tk, err := d.Token()
+		if err != nil {
+			return err
+		}
+		var skip json.RawMessage
+		if err := d.Decode(&skip); err != nil {
+			return err
+		}
+		*k = append(*k, fmt.Sprint(tk))
+	}
+	return nil
+}
+
+// openAPI3 contains the portions of an OpenAPI 3 document used
+// for generation.
+type openAPI3 struct {
+	OpenAPI string `json:"openapi"`
+	Info    Info   `json:"info"`
+	Servers []struct {
+		URL string `json:"url"`
+	} `json:"servers"`
+	Paths      map[string]map[string]json.RawMessage `json:"paths"`
+	Components struct {
+		Schemas       map[string]*schema3      `json:"schemas"`
+		Parameters    map[string]*parameter3   `json:"parameters"`
+		RequestBodies map[string]*requestBody3 `json:"requestBodies"`
+		Responses     map[string]*response3    `json:"responses"`
+	} `json:"components"`
+	ExternalDocs ExternalDocs `json:"externalDocs"`
+	Tags         []Tag        `json:"tags"`
+	DSTags       []Tag        `json:"x-ds-categories"`
+
+	warnings []string
+}
+
+// schemaType decodes a 3.0 type string or a 3.1 type list such
+// as ["string", "null"].
+type schemaType string
+
+// UnmarshalJSON sets t to the first non-null type.
+func (t *schemaType) UnmarshalJSON(b []byte) error {
+	var types []string
+	if err := json.Unmarshal(b, &types); err != nil {
+		var s string
+		if err = json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		types = []string{s}
+	}
+	for _, s := range types {
+		if s != "null" {
+			*t = schemaType(s)
+			return nil
+		}
+	}
+	return nil
+}
+
+type schema3 struct {
+	Ref                  string              `json:"$ref"`
+	Type                 schemaType          `json:"type"`
+	Format               string              `json:"format"`
+	Description          string              `json:"description"`
+	Items                *schema3            `json:"items"`
+	Properties           map[string]*schema3 `json:"properties"`
+	AdditionalProperties json.RawMessage     `json:"additionalProperties"`
+	Required             []string            `json:"required"`
+	Enum                 []interface{}       `json:"enum"`
+	MinLength            *int                `json:"minLength"`
+	MaxLength            *int                `json:"maxLength"`
+	Pattern              string              `json:"pattern"`
+	AllOf                []*schema3          `json:"allOf"`
+	OneOf                []*schema3          `json:"oneOf"`
+	AnyOf                []*schema3          `json:"anyOf"`
+	Name                 string              `json:"x-ds-definition-name"`
+	Summary              string              `json:"x-ms-summary"`
+	Category             string              `json:"x-ds-category"`
+	Order                string              `json:"x-ds-order"`
+}
+
+type parameter3 struct {
+	Ref         string   `json:"$ref"`
+	Name        string   `json:"name"`
+	In          string   `json:"in"`
+	Description string   `json:"description"`
+	Required    bool     `json:"required"`
+	Schema      *schema3 `json:"schema"`
+}
+
+type mediaType3 struct {
+	Schema *schema3 `json:"schema"`
+}
+
+type requestBody3 struct {
+	Ref         string                `json:"$ref"`
+	Description string                `json:"description"`
+	Required    bool                  `json:"required"`
+	Content     map[string]mediaType3 `json:"content"`
+}
+
+type response3 struct {
+	Ref         string                `json:"$ref"`
+	Description string                `json:"description"`
+	Content     map[string]mediaType3 `json:"content"`
+}
+
+type operation3 struct {
+	Tags        []string              `json:"tags"`
+	Summary     string                `json:"summary"`
+	Description string                `json:"description"`
+	OperationID string                `json:"operationId"`
+	Parameters  []*parameter3         `json:"parameters"`
+	RequestBody *requestBody3         `json:"requestBody"`
+	Responses   map[string]*response3 `json:"responses"`
+	Deprecated  bool                  `json:"deprecated"`
+	Examples    []Example             `json:"x-ds-example"`
+	MethodName  string                `json:"x-ds-methodname"`
+	Method      string                `json:"x-ds-method"`
+	Service     string                `json:"x-ds-service"`
+	InSDK       bool                  `json:"x-ds-in-sdk"`
+	Status      string                `json:"x-ds-api-status"`
+	BodyName    string                `json:"x-codegen-request-body-name"`
+}
+
+var httpMethods = map[string]bool{
+	"get": true, "put": true, "post": true, "delete": true,
+	"patch": true, "head": true, "options": true,
+}
+
+const schemaRefPrefix = "#/components/schemas/"
+
+// definitionRef converts a schema reference to a definition reference.
+func definitionRef(ref string) string {
+	if strings.HasPrefix(ref, schemaRefPrefix) {
+		return "#/definitions/" + ref[len(schemaRefPrefix):]
+	}
+	return ref
+}
+
+// document converts spec to a Document adding paths and schemas in
+// the order listed.
+func (spec *openAPI3) document(paths, ids []string) (*Document, error) {
+	doc := &Document{
+		Version:      spec.OpenAPI,
+		Info:         spec.Info,
+		ExternalDocs: spec.ExternalDocs,
+		Tags:         spec.Tags,
+		DSTags:       spec.DSTags,
+	}
+	if len(spec.Servers) > 0 {
+		if u, err := url.Parse(spec.Servers[0].URL); err == nil {
+			doc.Host, doc.BasePath = u.Host, u.Path
+			if u.Scheme > "" {
+				doc.Schemes = []string{u.Scheme}
+			}
+		}
+	}
+	for _, id := range ids {
+		doc.Definitions = append(doc.Definitions, spec.definition(id))
+	}
+	for _, path := range paths {
+		ops, err := spec.operations(path, spec.Paths[path])
+		if err != nil {
+			return nil, fmt.Errorf("paths %s: %w", path, err)
+		}
+		doc.Operations = append(doc.Operations, ops...)
+	}
+	doc.Warnings = spec.warnings
+	return doc, nil
+}
+
+// definition converts the component schema id.  Properties and
+// required lists of allOf schemas are merged into the definition.
+func (spec *openAPI3) definition(id string) Definition {
+	s := spec.Components.Schemas[id]
+	def := Definition{
+		ID:          id,
+		Description: s.Description,
+		Type:        "object",
+		Name:        s.Name,
+		Summary:     s.Summary,
+		Category:    s.Category,
+		Order:       s.Order,
+	}
+	// unnamed schemas without a type or properties only describe a
+	// category and, as in Swagger 2.0 files, produce no struct
+	if def.Name == "" && (s.Type > "" || s.Ref > "" || len(s.Properties)+len(s.AllOf)+len(s.OneOf)+len(s.AnyOf) > 0) {
+		def.Name = id
+	}
+	props := make(map[string]*schema3)
+	spec.collectProperties(s, props, &def.Required, make(map[*schema3]bool))
+	for name, ps := range props {
+		f := spec.field(ps, id+"."+name)
+		f.Name = name
+		def.Fields = append(def.Fields, f)
+	}
+	sort.Slice(def.Fields, func(i, j int) bool { return def.Fields[i].Name < def.Fields[j].Name })
+	return def
+}
+
+func (spec *openAPI3) collectProperties(s *schema3, props map[string]*schema3, required *[]string, seen map[*schema3]bool) {
+	if s == nil || seen[s] {
+		return
+	}
+	seen[s] = true
+	if s.Ref > "" {
+		spec.collectProperties(spec.schema(s.Ref), props, required, seen)
+		return
+	}
+	for _, sub := range s.AllOf {
+		spec.collectProperties(sub, props, required, seen)
+	}
+	for k, v := range s.Properties {
+		props[k] = v
+	}
+	*required = append(*required, s.Required...)
+}
+
+// schema returns the component schema referenced by ref.
+func (spec *openAPI3) schema(ref string) *schema3 {
+	if !strings.HasPrefix(ref, schemaRefPrefix) {
+		return nil
+	}
+	return spec.Components.Schemas[ref[len(schemaRefPrefix):]]
+}
+
+// simplify reduces an allOf, oneOf or anyOf schema to a single schema
+// when all alternatives are the same reference.  Other compositions
+// are treated as an untyped object, adding a warning naming the schema
+// at location.
+func (spec *openAPI3) simplify(s *schema3, location string) *schema3 {
+	if s == nil {
+		return &schema3{Type: "object"}
+	}
+	var alts []*schema3
+	for _, list := range [][]*schema3{s.AllOf, s.OneOf, s.AnyOf} {
+		alts = append(alts, list...)
+	}
+	if s.Ref > "" || len(alts) == 0 {
+		return s
+	}
+	var ref string
+	for _, alt := range alts {
+		if alt.Ref == "" || (ref > "" && alt.Ref != ref) {
+			spec.warnings = append(spec.warnings, fmt.Sprintf("%s: composition of %s is generated as interface{}", location, describeAlternatives(alts)))
+			return &schema3{Type: "object", Description: s.Description}
+		}
+		ref = alt.Ref
+	}
+	return &schema3{Ref: ref, Description: s.Description}
+}
+
+// describeAlternatives lists the references or types of a
+// composition's schemas.
+func describeAlternatives(alts []*schema3) string {
+	names := make([]string, 0, len(alts))
+	for _, alt := range alts {
+		switch {
+		case alt.Ref > "":
+			names = append(names, strings.TrimPrefix(alt.Ref, schemaRefPrefix))
+		case alt.Type > "":
+			names = append(names, string(alt.Type))
+		default:
+			names = append(names, "inline schema")
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// field converts a property schema to a Field.
+func (spec *openAPI3) field(s *schema3, location string) Field {
+	desc := s.Description
+	s = spec.simplify(s, location)
+	f := Field{
+		Description: desc,
+		Type:        string(s.Type),
+		Ref:         definitionRef(s.Ref),
+		Format:      s.Format,
+		Enum:        enumStrings(s.Enum),
+		MinLength:   s.MinLength,
+		MaxLength:   s.MaxLength,
+		Pattern:     s.Pattern,
+	}
+	if f.Ref == "" && f.Type == "" {
+		f.Type = "object"
+	}
+	if s.Items != nil {
+		f.Items = spec.schemaRef(s.Items, location+" items")
+	}
+	if f.Type == "object" && len(s.AdditionalProperties) > 0 {
+		var ap *schema3
+		if err := json.Unmarshal(s.AdditionalProperties, &ap); err == nil && ap != nil {
+			f.AdditionalProperties = &AdditionalProperty{Type: string(spec.simplify(ap, location+" additionalProperties").Type)}
+		} else if string(s.AdditionalProperties) == "true" {
+			f.AdditionalProperties = &AdditionalProperty{Type: "object"}
+		}
+	}
+	return f
+}
+
+// schemaRef converts a parameter, item or response schema to a SchemaRef.
+func (spec *openAPI3) schemaRef(s *schema3, location string) *SchemaRef {
+	s = spec.simplify(s, location)
+	if s.Ref > "" {
+		return &SchemaRef{Ref: definitionRef(s.Ref)}
+	}
+	ty := string(s.Type)
+	if ty == "" {
+		ty = "object"
+	}
+	return &SchemaRef{Type: ty, Format: s.Format}
+}
+
+func enumStrings(vals []interface{}) []string {
+	var enum []string
+	for _, v := range vals {
+		if v != nil {
+			enum = append(enum, fmt.Sprint(v))
+		}
+	}
+	return enum
+}
+
+// operations converts the operations of a path item.
+func (spec *openAPI3) operations(path string, item map[string]json.RawMessage) ([]Operation, error) {
+	var pathParams []*parameter3
+	if b, ok := item["parameters"]; ok {
+		if err := json.Unmarshal(b, &pathParams); err != nil {
+			return nil, err
+		}
+	}
+	var methods []string
+	for k := range item {
+		if httpMethods[k] {
+			methods = append(methods, k)
+		}
+	}
+	sort.Strings(methods)
+	var ops []Operation
+	for _, m := range methods {
+		var o3 *operation3
+		if err := json.Unmarshal(item[m], &o3); err != nil {
+			return nil, fmt.Errorf("%s: %w", m, err)
+		}
+		op, err := spec.operation(path, strings.ToUpper(m), o3, pathParams)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", m, err)
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+func (spec *openAPI3) operation(path, method string, o3 *operation3, pathParams []*parameter3) (Operation, error) {
+	op := Operation{
+		HTTPMethod:  method,
+		Path:        path,
+		Tags:        o3.Tags,
+		Summary:     o3.Summary,
+		Description: o3.Description,
+		OperationID: o3.OperationID,
+		Deprecated:  o3.Deprecated,
+		Examples:    o3.Examples,
+		MethodName:  o3.MethodName,
+		Method:      o3.Method,
+		Service:     o3.Service,
+		InSDK:       o3.InSDK,
+		Status:      o3.Status,
+		Responses:   make(map[string]Response),
+	}
+	// specifications without DocuSign extensions use the
+	// operation id and first tag for naming
+	if op.MethodName == "" {
+		op.MethodName = op.OperationID
+	}
+	if op.Method == "" {
+		op.Method = op.MethodName
+	}
+	if op.Service == "" && len(op.Tags) > 0 {
+		op.Service = op.Tags[0]
+	}
+	for _, p := range append(o3.Parameters, pathParams...) {
+		if p.Ref > "" {
+			name := strings.TrimPrefix(p.Ref, "#/components/parameters/")
+			if p = spec.Components.Parameters[name]; p == nil {
+				return op, fmt.Errorf("parameter %s not found", name)
+			}
+		}
+		op.Parameters = append(op.Parameters, spec.parameterProperty(p, op.OperationID+" parameter "+p.Name))
+	}
+	if rb := o3.RequestBody; rb != nil {
+		if rb.Ref > "" {
+			name := strings.TrimPrefix(rb.Ref, "#/components/requestBodies/")
+			if rb = spec.Components.RequestBodies[name]; rb == nil {
+				return op, fmt.Errorf("request body %s not found", name)
+			}
+		}
+		op.Consumes = contentTypes(rb.Content)
+		if s := contentSchema(rb.Content); s != nil {
+			op.Parameters = append(op.Parameters, spec.bodyProperty(o3.BodyName, rb, s, op.OperationID+" requestBody"))
+		}
+	}
+	produces := make(map[string]bool)
+	for code, r := range o3.Responses {
+		if r.Ref > "" {
+			name := strings.TrimPrefix(r.Ref, "#/components/responses/")
+			if r = spec.Components.Responses[name]; r == nil {
+				return op, fmt.Errorf("response %s not found", name)
+			}
+		}
+		resp := Response{Description: r.Description}
+		if s := contentSchema(r.Content); s != nil {
+			resp.Schema = spec.schemaRef(s, op.OperationID+" response "+code)
+			if !isJSONContent(r.Content) && resp.Schema.Type == "string" && resp.Schema.Format == "binary" {
+				resp.Schema = &SchemaRef{Type: "file"}
+			}
+		}
+		for _, ct := range contentTypes(r.Content) {
+			produces[ct] = true
+		}
+		op.Responses[code] = resp
+	}
+	for ct := range produces {
+		op.Produces = append(op.Produces, ct)
+	}
+	sort.Strings(op.Produces)
+	return op, nil
+}
+
+func (spec *openAPI3) parameterProperty(p *parameter3, location string) Property {
+	prop := Property{
+		Name:        p.Name,
+		In:          p.In,
+		Description: p.Description,
+		Required:    p.Required,
+	}
+	if p.Schema != nil {
+		s := spec.simplify(p.Schema, location)
+		if s.Ref > "" {
+			prop.Schema = &SchemaRef{Ref: definitionRef(s.Ref)}
+		}
+		prop.Type, prop.Format = string(s.Type), s.Format
+		prop.Enum = enumStrings(s.Enum)
+	}
+	return prop
+}
+
+// bodyProperty returns a body parameter for the request body.  The
+// parameter is named by x-codegen-request-body-name or the referenced
+// schema.
+func (spec *openAPI3) bodyProperty(name string, rb *requestBody3, s *schema3, location string) Property {
+	sr := spec.schemaRef(s, location)
+	if name == "" {
+		name = "body"
+		if sr.Ref > "" {
+			name = sr.Ref[strings.LastIndex(sr.Ref, "/")+1:]
+		}
+	}
+	if sr.Type == "array" {
+		sr.Type = "object"
+	}
+	return Property{
+		Name:        name,
+		In:          "body",
+		Description: rb.Description,
+		Required:    rb.Required,
+		Schema:      sr,
+	}
+}
+
+func contentTypes(content map[string]mediaType3) []string {
+	var cts []string
+	for ct := range content {
+		cts = append(cts, ct)
+	}
+	sort.Strings(cts)
+	return cts
+}
+
+func isJSONContent(content map[string]mediaType3) bool {
+	for ct := range content {
+		if strings.Contains(ct, "json") {
+			return true
+		}
+	}
+	return false
+}
+
+// contentSchema returns the json schema of content or the schema of
+// the first content type.
+func contentSchema(content map[string]mediaType3) *schema3 {
+	cts := contentTypes(content)
+	for _, ct := range cts {
+		if strings.Contains(ct, "json") && content[ct].Schema != nil {
+			return content[ct].Schema
+		}
+	}
+	for _, ct := range cts {
+		if content[ct].Schema != nil {
+			return content[ct].Schema
+		}
+	}
+	return nil
+}
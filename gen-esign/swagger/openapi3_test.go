@@ -0,0 +1,162 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package swagger
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testOpenAPI3 = `{
+	"openapi": "3.0.1",
+	"info": {"title": "Test API", "version": "v1"},
+	"servers": [{"url": "https://example.com/api"}],
+	"paths": {
+		"/v1/rooms": {
+			"post": {
+				"tags": ["Rooms"],
+				"operationId": "Rooms_PostRoom",
+				"requestBody": {
+					"description": "room to add",
+					"required": true,
+					"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Room"}}}
+				},
+				"responses": {
+					"201": {
+						"description": "created",
+						"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Room"}}}
+					}
+				}
+			}
+		},
+		"/v1/rooms/{roomId}": {
+			"parameters": [{"name": "roomId", "in": "path", "required": true, "schema": {"type": "integer", "format": "int32"}}],
+			"put": {
+				"tags": ["Rooms"],
+				"operationId": "Rooms_PutRoom",
+				"x-codegen-request-body-name": "room",
+				"requestBody": {"$ref": "#/components/requestBodies/RoomBody"},
+				"responses": {"200": {"description": "ok"}}
+			}
+		}
+	},
+	"components": {
+		"schemas": {
+			"RoomBase": {
+				"type": "object",
+				"required": ["name"],
+				"properties": {
+					"name": {"type": "string", "maxLength": 100},
+					"status": {"type": "string", "enum": ["active", "closed"]}
+				}
+			},
+			"Room": {
+				"allOf": [
+					{"$ref": "#/components/schemas/RoomBase"},
+					{
+						"type": "object",
+						"required": ["roomId"],
+						"properties": {
+							"roomId": {"type": "integer", "format": "int32"},
+							"office": {"allOf": [{"$ref": "#/components/schemas/Office"}], "description": "room office"},
+							"owner": {"oneOf": [{"$ref": "#/components/schemas/Office"}, {"$ref": "#/components/schemas/RoomBase"}]}
+						}
+					}
+				]
+			},
+			"Office": {
+				"type": "object",
+				"properties": {"officeId": {"type": "integer", "format": "int32"}}
+			}
+		},
+		"requestBodies": {
+			"RoomBody": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Room"}}}}
+		}
+	}
+}`
+
+func TestDecodeDocument_OpenAPI3(t *testing.T) {
+	doc, err := DecodeDocument([]byte(testOpenAPI3))
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if doc.Host != "example.com" || doc.BasePath != "/api" || !reflect.DeepEqual(doc.Schemes, []string{"https"}) {
+		t.Errorf("expected server example.com/api; got %s %s %v", doc.Host, doc.BasePath, doc.Schemes)
+	}
+
+	var ids []string
+	for _, d := range doc.Definitions {
+		ids = append(ids, d.ID)
+	}
+	if want := []string{"RoomBase", "Room", "Office"}; !reflect.DeepEqual(ids, want) {
+		t.Fatalf("expected definitions %v in file order; got %v", want, ids)
+	}
+	room := doc.Definitions[1]
+	if want := []string{"name", "roomId"}; !reflect.DeepEqual(room.Required, want) {
+		t.Errorf("expected merged required %v; got %v", want, room.Required)
+	}
+	maxLen := 100
+	wantFields := FieldList{
+		{Name: "name", Type: "string", MaxLength: &maxLen},
+		{Name: "office", Description: "room office", Ref: "#/definitions/Office"},
+		{Name: "owner", Type: "object"},
+		{Name: "roomId", Type: "integer", Format: "int32"},
+		{Name: "status", Type: "string", Enum: []string{"active", "closed"}},
+	}
+	if !reflect.DeepEqual(room.Fields, wantFields) {
+		t.Errorf("expected merged allOf fields %#v; got %#v", wantFields, room.Fields)
+	}
+	if want := []string{"Room.owner: composition of Office, RoomBase is generated as interface{}"}; !reflect.DeepEqual(doc.Warnings, want) {
+		t.Errorf("expected warnings %q; got %q", want, doc.Warnings)
+	}
+
+	if len(doc.Operations) != 2 {
+		t.Fatalf("expected 2 operations; got %d", len(doc.Operations))
+	}
+	post := doc.Operations[0]
+	wantBody := []Property{{
+		Name: "Room", In: "body", Description: "room to add", Required: true,
+		Schema: &SchemaRef{Ref: "#/definitions/Room"},
+	}}
+	if post.OperationID != "Rooms_PostRoom" || post.Service != "Rooms" || !reflect.DeepEqual(post.Parameters, wantBody) {
+		t.Errorf("expected Rooms_PostRoom with body %#v; got %s %s %#v", wantBody, post.OperationID, post.Service, post.Parameters)
+	}
+	if sr := post.Responses["201"].Schema; sr == nil || sr.Ref != "#/definitions/Room" {
+		t.Errorf("expected 201 response of Room; got %#v", sr)
+	}
+	if !reflect.DeepEqual(post.Consumes, []string{"application/json"}) || !reflect.DeepEqual(post.Produces, []string{"application/json"}) {
+		t.Errorf("expected json content; got %v %v", post.Consumes, post.Produces)
+	}
+
+	put := doc.Operations[1]
+	wantParams := []Property{
+		{Name: "roomId", In: "path", Required: true, Type: "integer", Format: "int32"},
+		{Name: "room", In: "body", Schema: &SchemaRef{Ref: "#/definitions/Room"}},
+	}
+	if !reflect.DeepEqual(put.Parameters, wantParams) {
+		t.Errorf("expected put parameters %#v; got %#v", wantParams, put.Parameters)
+	}
+}
+
+func TestDecodeDocument_Versions(t *testing.T) {
+	doc, err := DecodeDocument([]byte(`{"swagger": "2.0", "info": {"title": "Test API", "version": "v1"}}`))
+	if err != nil || doc.Info.Title != "Test API" || len(doc.Warnings) > 0 {
+		t.Errorf("expected swagger 2.0 document; got %v %v", doc, err)
+	}
+	for _, tt := range []struct {
+		spec    string
+		errText string
+	}{
+		{spec: `{"openapi": "2.5"}`, errText: "unsupported openapi version 2.5"},
+		{spec: `null`, errText: "empty specification"},
+		{spec: `{"openapi": "3.0.0", "paths": {"/x": {"get": {"parameters": [{"$ref": "#/components/parameters/Missing"}]}}}}`, errText: "paths /x: get: parameter Missing not found"},
+	} {
+		if _, err := DecodeDocument([]byte(tt.spec)); err == nil || !strings.Contains(err.Error(), tt.errText) {
+			t.Errorf("expected error containing %q; got %v", tt.errText, err)
+		}
+	}
+}
@@ -263,29 +263,45 @@ var ResourceMaps = map[esign.APIVersion]map[string]string{
 	},
 }
 
+// ServicePrefixes lists additional prefixes to remove from
+// a service's Tags for creating op FuncName
+var ServicePrefixes = map[string][]string{
+	"BulkEnvelopes": {"EnvelopeBulk"},
+}
+
 // GetServicePrefixes provides a list of prefixes
 // to remove from Tags for creating op FuncName
 func GetServicePrefixes(service string) []string {
+	return servicePrefixes(service, ServicePrefixes)
+}
+
+func servicePrefixes(service string, additions map[string][]string) []string {
 	var list = []string{service}
 	if strings.HasSuffix(service, "s") {
 		list = append(list, service[:len(service)-1])
 	}
-	if service == "BulkEnvelopes" {
-		list = append(list, "EnvelopeBulk")
-	}
-	return list
+	return append(list, additions[service]...)
+}
+
+// UploadFilesOperations lists the operations that allow
+// multipart file uploads
+var UploadFilesOperations = []string{
+	"v2:Envelopes_PostEnvelopes", "v2.1:Envelopes_PostEnvelopes",
+	"v2:Templates_PostTemplates", "v2.1:Templates_PostTemplates",
+	"v2:UserSignatures_PostUserSignatures", "v2.1:UserSignatures_PostUserSignatures",
 }
 
 // IsUploadFilesOperation checks whether the operation
 // allow multipart file uploads
 func IsUploadFilesOperation(opID string) bool {
-	switch opID {
-	case "v2:Envelopes_PostEnvelopes", "v2.1:Envelopes_PostEnvelopes":
-		return true
-	case "v2:Templates_PostTemplates", "v2.1:Templates_PostTemplates":
-		return true
-	case "v2:UserSignatures_PostUserSignatures", "v2.1:UserSignatures_PostUserSignatures":
-		return true
+	return contains(UploadFilesOperations, opID)
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
 	}
 	return false
 }
@@ -633,34 +649,35 @@ func GetParameterOverrides() map[string]map[string]string {
 // will return an esign.DownloadFile when the Accept header
 // is set to the MimeType value.
 type DownloadAddition struct {
-	Name     string
-	MimeType string
-	Comments []string
+	Name     string   `json:"name"`
+	MimeType string   `json:"mimeType"`
+	Comments []string `json:"comments,omitempty"`
 }
 
-// GetDownloadAdditions returns the special download funcs for
-// an operation.  These are gleaned from the documentation
+// DownloadAdditions contains the special download funcs for
+// operations.  These are gleaned from the documentation
 // not the swagger file.
+var DownloadAdditions = map[string][]DownloadAddition{
+	"BillingInvoices_GetBillingInvoice": {
+		{
+			Name:     "PDF",
+			MimeType: "application/pdf",
+			Comments: []string{"PDF returns a pdf version of the invoice by setting", "the Accept header to application/pdf", "", "**not included in swagger definition"},
+		},
+	},
+	"APIRequestLog_GetRequestLogs": {
+		{
+			Name:     "Zip",
+			MimeType: "application/zip",
+			Comments: []string{"Zip returns a zip file containing log files by setting", "the Accept header to application/zip", "", "**not included in swagger definition"},
+		},
+	},
+}
+
+// GetDownloadAdditions returns the special download funcs for
+// an operation.
 func GetDownloadAdditions(opID string) []DownloadAddition {
-	switch opID {
-	case "BillingInvoices_GetBillingInvoice":
-		return []DownloadAddition{
-			{
-				Name:     "PDF",
-				MimeType: "application/pdf",
-				Comments: []string{"PDF returns a pdf version of the invoice by setting", "the Accept header to application/pdf", "", "**not included in swagger definition"},
-			},
-		}
-	case "APIRequestLog_GetRequestLogs":
-		return []DownloadAddition{
-			{
-				Name:     "Zip",
-				MimeType: "application/zip",
-				Comments: []string{"Zip returns a zip file containing log files by setting", "the Accept header to application/zip", "", "**not included in swagger definition"},
-			},
-		}
-	}
-	return nil
+	return DownloadAdditions[opID]
 }
 
 // TabDefs return a list of embeded tab structs based upon the version
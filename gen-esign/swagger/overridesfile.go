@@ -0,0 +1,326 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package swagger
+
+// overridesfile.go contains the declarative form of the generation
+// overrides allowing them to be loaded from json or yaml and exported
+// to json.
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/jfcote87/esign"
+	"gopkg.in/yaml.v3"
+)
+
+// OverridesVersion is the format version of an overrides file.
+const OverridesVersion = 1
+
+// Overrides contains the adjustments made to DocuSign's specification
+// files during generation.  Keys of ServiceNames, Downloads,
+// SkipOperations and UploadOperations are prefixed by the specification's
+// version (e.g. "v2.1:Envelopes_PostEnvelopes").
+type Overrides struct {
+	// Version must equal OverridesVersion
+	Version int `json:"version"`
+	// ServiceNames renames an x-ds-service value
+	ServiceNames map[string]string `json:"serviceNames,omitempty"`
+	// SkipOperations lists operations to ignore
+	SkipOperations []string `json:"skipOperations,omitempty"`
+	// UploadOperations lists operations allowing multipart file uploads
+	UploadOperations []string `json:"uploadOperations,omitempty"`
+	// ServicePrefixes lists additional prefixes to remove from a
+	// service's operation names
+	ServicePrefixes map[string][]string `json:"servicePrefixes,omitempty"`
+	// Downloads adds download funcs to an operation
+	Downloads map[string][]DownloadAddition `json:"downloads,omitempty"`
+	// APIs contains the overrides for each specification keyed
+	// by the api name (e.g. "DocuSign REST API:v2.1")
+	APIs map[string]*APIOverrides `json:"apis,omitempty"`
+}
+
+// APIOverrides contains the overrides for a single specification.
+type APIOverrides struct {
+	// Resources maps an operation's tag to its service
+	Resources map[string]string `json:"resources,omitempty"`
+	// Parameters sets query parameter types,
+	// map[<operationID>]map[<parameter>]<GoType>
+	Parameters map[string]map[string]string `json:"parameters,omitempty"`
+	// Fields sets struct field types,
+	// map[<structID>]map[<FieldName>]<GoType>
+	Fields map[string]map[string]string `json:"fields,omitempty"`
+	// Constraints adds validation constraints missing from the
+	// specification, map[<structID>]map[<jsonField>]FieldConstraint.
+	// DocuSign's eSignature specifications list no required fields, so
+	// their Validate methods only check constraints added here.
+	Constraints map[string]map[string]FieldConstraint `json:"constraints,omitempty"`
+}
+
+// FieldConstraint is a validation constraint added to a field.
+type FieldConstraint struct {
+	Required bool     `json:"required,omitempty"`
+	Enum     []string `json:"enum,omitempty"`
+}
+
+// overrideAPIs lists the api versions that may be overridden
+var overrideAPIs = []esign.APIVersion{esign.APIv2, esign.APIv21, esign.AdminV2, esign.ClickV1, esign.MonitorV2, esign.RoomsV2}
+
+// BuiltinOverrides returns the overrides compiled into gen-esign.
+func BuiltinOverrides() *Overrides {
+	o := &Overrides{
+		Version:          OverridesVersion,
+		ServiceNames:     make(map[string]string),
+		UploadOperations: append([]string{}, UploadFilesOperations...),
+		ServicePrefixes:  make(map[string][]string),
+		Downloads:        make(map[string][]DownloadAddition),
+		APIs:             make(map[string]*APIOverrides),
+	}
+	for k, v := range ServiceNameOverride {
+		o.ServiceNames[k] = v
+	}
+	for k, skip := range OperationSkipList {
+		if skip {
+			o.SkipOperations = append(o.SkipOperations, k)
+		}
+	}
+	sort.Strings(o.SkipOperations)
+	for k, v := range ServicePrefixes {
+		o.ServicePrefixes[k] = append([]string{}, v...)
+	}
+	for k, v := range DownloadAdditions {
+		o.Downloads[k] = append([]DownloadAddition{}, v...)
+	}
+	for _, v := range overrideAPIs {
+		// empty maps are left nil so that a saved copy loads
+		// as an equal value
+		apo := &APIOverrides{Resources: make(map[string]string)}
+		for k, svc := range ResourceMaps[v] {
+			apo.Resources[k] = svc
+		}
+		if v == esign.APIv2 || v == esign.APIv21 {
+			apo.Parameters, apo.Fields = GetParameterOverrides(), GetFieldOverrides()
+		}
+		o.APIs[v.Name()] = apo
+	}
+	return o
+}
+
+// LoadOverrides reads and validates the overrides file fn.  Files
+// ending in .yaml or .yml are decoded as yaml, others as json.  Unknown
+// keys are an error.
+func LoadOverrides(fn string) (*Overrides, error) {
+	b, err := ioutil.ReadFile(fn)
+	if err != nil {
+		return nil, err
+	}
+	ext := strings.ToLower(filepath.Ext(fn))
+	o, err := DecodeOverrides(b, ext == ".yaml" || ext == ".yml")
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", fn, err)
+	}
+	return o, nil
+}
+
+// DecodeOverrides decodes and validates the json, or yaml if isYAML
+// is true, overrides in b.  Unknown keys are an error.
+func DecodeOverrides(b []byte, isYAML bool) (*Overrides, error) {
+	if isYAML {
+		// convert to json so that the json field names and
+		// unknown key checks apply to both formats
+		var v interface{}
+		if err := yaml.Unmarshal(b, &v); err != nil {
+			return nil, fmt.Errorf("decode %w", err)
+		}
+		var err error
+		if b, err = json.Marshal(v); err != nil {
+			return nil, fmt.Errorf("decode %w", err)
+		}
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	var o *Overrides
+	if err := dec.Decode(&o); err != nil {
+		return nil, fmt.Errorf("decode %w", err)
+	}
+	if o == nil {
+		return nil, errors.New("contains no overrides")
+	}
+	if err := o.Validate(); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+var (
+	goIdentifier = regexp.MustCompile(`^[A-Z][A-Za-z0-9_]*$`)
+	versionedOp  = regexp.MustCompile(`^[^:\s]+:[A-Za-z0-9_]+$`)
+	paramTypes   = map[string]bool{
+		"string": true, "...string": true, "bool": true, "int": true,
+		"int32": true, "int64": true, "float64": true, "time.Time": true,
+	}
+)
+
+// Validate checks the version of o, the api names and the format of
+// operation keys, service names and types.
+func (o *Overrides) Validate() error {
+	var errs []string
+	addErr := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Sprintf(format, args...))
+	}
+	if o.Version != OverridesVersion {
+		addErr("version %d is not supported; expected %d", o.Version, OverridesVersion)
+	}
+	for _, k := range sortedKeys(o.ServiceNames) {
+		if !goIdentifier.MatchString(o.ServiceNames[k]) {
+			addErr("serviceNames %s: %q is not an exported Go name", k, o.ServiceNames[k])
+		}
+	}
+	for _, list := range []struct {
+		name string
+		ops  []string
+	}{{"skipOperations", o.SkipOperations}, {"uploadOperations", o.UploadOperations}} {
+		for _, op := range list.ops {
+			if !versionedOp.MatchString(op) {
+				addErr("%s: %q must be <version>:<operationId>", list.name, op)
+			}
+		}
+	}
+	for _, k := range sortedKeys(o.Downloads) {
+		for _, d := range o.Downloads[k] {
+			if !goIdentifier.MatchString(d.Name) {
+				addErr("downloads %s: %q is not an exported Go name", k, d.Name)
+			}
+			if !strings.Contains(d.MimeType, "/") {
+				addErr("downloads %s: invalid mimeType %q", k, d.MimeType)
+			}
+		}
+	}
+	known := make(map[string]bool)
+	for _, v := range overrideAPIs {
+		known[v.Name()] = true
+	}
+	for _, name := range sortedKeys(o.APIs) {
+		apo := o.APIs[name]
+		if !known[name] {
+			addErr("apis: unknown api %q", name)
+			continue
+		}
+		if apo == nil {
+			continue
+		}
+		for _, tag := range sortedKeys(apo.Resources) {
+			if !goIdentifier.MatchString(apo.Resources[tag]) {
+				addErr("apis %s resources %s: %q is not an exported Go name", name, tag, apo.Resources[tag])
+			}
+		}
+		for _, op := range sortedKeys(apo.Parameters) {
+			for _, p := range sortedKeys(apo.Parameters[op]) {
+				if ty := apo.Parameters[op][p]; !paramTypes[ty] {
+					addErr("apis %s parameters %s.%s: unsupported type %q", name, op, p, ty)
+				}
+			}
+		}
+		for _, def := range sortedKeys(apo.Fields) {
+			for _, f := range sortedKeys(apo.Fields[def]) {
+				if apo.Fields[def][f] == "" {
+					addErr("apis %s fields %s.%s: empty type", name, def, f)
+				}
+			}
+		}
+		for _, def := range sortedKeys(apo.Constraints) {
+			for _, f := range sortedKeys(apo.Constraints[def]) {
+				if c := apo.Constraints[def][f]; !c.Required && len(c.Enum) == 0 {
+					addErr("apis %s constraints %s.%s: no required or enum value", name, def, f)
+				}
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid overrides:\n\t%s", strings.Join(errs, "\n\t"))
+	}
+	return nil
+}
+
+// sortedKeys returns the keys of map m in order.
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch mx := m.(type) {
+	case map[string]string:
+		for k := range mx {
+			keys = append(keys, k)
+		}
+	case map[string][]DownloadAddition:
+		for k := range mx {
+			keys = append(keys, k)
+		}
+	case map[string]*APIOverrides:
+		for k := range mx {
+			keys = append(keys, k)
+		}
+	case map[string]map[string]string:
+		for k := range mx {
+			keys = append(keys, k)
+		}
+	case map[string]map[string]FieldConstraint:
+		for k := range mx {
+			keys = append(keys, k)
+		}
+	case map[string]FieldConstraint:
+		for k := range mx {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// API returns the overrides for the api name.  The returned maps are
+// non-nil as the generator adds field overrides for tab definitions.
+func (o *Overrides) API(name string) *APIOverrides {
+	if o.APIs == nil {
+		o.APIs = make(map[string]*APIOverrides)
+	}
+	apo := o.APIs[name]
+	if apo == nil {
+		apo = &APIOverrides{}
+		o.APIs[name] = apo
+	}
+	if apo.Resources == nil {
+		apo.Resources = make(map[string]string)
+	}
+	if apo.Parameters == nil {
+		apo.Parameters = make(map[string]map[string]string)
+	}
+	if apo.Fields == nil {
+		apo.Fields = make(map[string]map[string]string)
+	}
+	return apo
+}
+
+// IsSkipped reports whether the versioned operation is ignored.
+func (o *Overrides) IsSkipped(opID string) bool {
+	return contains(o.SkipOperations, opID)
+}
+
+// IsUploadOperation reports whether the versioned operation allows
+// multipart file uploads.
+func (o *Overrides) IsUploadOperation(opID string) bool {
+	return contains(o.UploadOperations, opID)
+}
+
+// Prefixes returns the prefixes to remove from the operation names of
+// service.
+func (o *Overrides) Prefixes(service string) []string {
+	return servicePrefixes(service, o.ServicePrefixes)
+}
@@ -0,0 +1,132 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package swagger
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestBuiltinOverrides_RoundTrip(t *testing.T) {
+	builtin := BuiltinOverrides()
+	if err := builtin.Validate(); err != nil {
+		t.Fatalf("expected valid built-in overrides; got %v", err)
+	}
+	b, err := json.Marshal(builtin)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	o, err := DecodeOverrides(b, false)
+	if err != nil {
+		t.Fatalf("expected json to decode; got %v", err)
+	}
+	if !reflect.DeepEqual(o, builtin) {
+		t.Errorf("expected loaded json overrides to equal built-in overrides")
+	}
+
+	var v interface{}
+	if err = json.Unmarshal(b, &v); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	yb, err := yaml.Marshal(v)
+	if err != nil {
+		t.Fatalf("yaml marshal: %v", err)
+	}
+	if o, err = DecodeOverrides(yb, true); err != nil {
+		t.Fatalf("expected yaml to decode; got %v", err)
+	}
+	if !reflect.DeepEqual(o, builtin) {
+		t.Errorf("expected loaded yaml overrides to equal built-in overrides")
+	}
+}
+
+func TestLoadOverrides(t *testing.T) {
+	dir, err := ioutil.TempDir("", "overrides")
+	if err != nil {
+		t.Fatalf("tempdir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		errText string
+	}{
+		{name: "json", file: "o.json", content: `{"version":1,"serviceNames":{"v2.1:Groups":"Groups"}}`},
+		{name: "yaml", file: "o.yaml", content: "version: 1\nserviceNames:\n  v2.1:Groups: Groups\n"},
+		{name: "unknown key", file: "o.json", content: `{"version":1,"serviceName":{}}`, errText: "unknown field"},
+		{name: "unknown nested key", file: "o.yml", content: "version: 1\napis:\n  DocuSign REST API:v2.1:\n    field: {}\n", errText: "unknown field"},
+		{name: "bad version", file: "o.json", content: `{"version":2}`, errText: "version 2 is not supported"},
+		{name: "missing version", file: "o.yaml", content: "skipOperations: []\n", errText: "version 0 is not supported"},
+		{name: "null", file: "o.json", content: `null`, errText: "contains no overrides"},
+		{name: "bad yaml", file: "o.yaml", content: "version: [1\n", errText: "decode"},
+	}
+	for _, tt := range tests {
+		fn := filepath.Join(dir, tt.file)
+		if err := ioutil.WriteFile(fn, []byte(tt.content), 0600); err != nil {
+			t.Fatalf("%s: write: %v", tt.name, err)
+		}
+		o, err := LoadOverrides(fn)
+		if tt.errText == "" {
+			if err != nil || o.ServiceNames["v2.1:Groups"] != "Groups" {
+				t.Errorf("%s: expected overrides; got %v %v", tt.name, o, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.errText) {
+			t.Errorf("%s: expected error containing %q; got %v", tt.name, tt.errText, err)
+		}
+	}
+}
+
+func TestOverrides_Validate(t *testing.T) {
+	o := &Overrides{
+		Version:          OverridesVersion,
+		ServiceNames:     map[string]string{"v2.1:Groups": "groups"},
+		SkipOperations:   []string{"Envelopes_PostEnvelopes"},
+		UploadOperations: []string{"v2.1:Envelopes PostEnvelopes"},
+		Downloads: map[string][]DownloadAddition{
+			"v2.1:Documents_GetDocument": {{Name: "pdf", MimeType: "pdf"}},
+		},
+		APIs: map[string]*APIOverrides{
+			"Unknown API:v1": {},
+			"DocuSign REST API:v2.1": {
+				Resources:   map[string]string{"Envelopes": "envelopes"},
+				Parameters:  map[string]map[string]string{"Envelopes_GetEnvelopes": {"count": "uint8"}},
+				Fields:      map[string]map[string]string{"envelope": {"status": ""}},
+				Constraints: map[string]map[string]FieldConstraint{"envelope": {"status": {}}},
+			},
+		},
+	}
+	err := o.Validate()
+	if err == nil {
+		t.Fatalf("expected errors")
+	}
+	for _, want := range []string{
+		`serviceNames v2.1:Groups: "groups" is not an exported Go name`,
+		`skipOperations: "Envelopes_PostEnvelopes" must be <version>:<operationId>`,
+		`uploadOperations: "v2.1:Envelopes PostEnvelopes" must be <version>:<operationId>`,
+		`downloads v2.1:Documents_GetDocument: "pdf" is not an exported Go name`,
+		`downloads v2.1:Documents_GetDocument: invalid mimeType "pdf"`,
+		`apis: unknown api "Unknown API:v1"`,
+		`resources Envelopes: "envelopes" is not an exported Go name`,
+		`parameters Envelopes_GetEnvelopes.count: unsupported type "uint8"`,
+		`fields envelope.status: empty type`,
+		`constraints envelope.status: no required or enum value`,
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error %q in %v", want, err)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package swagger
+
+// queryvalues.go contains the typing of query options: named string
+// types for options with a list of values, time.Time for dates and
+// variadic arguments for comma separated lists.
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// EnumType is a named string type declared for the values of a
+// query option.
+type EnumType struct {
+	Name   string      // Go type name
+	Param  string      // query parameter name
+	Values []EnumValue // declared constants
+}
+
+// EnumValue is a constant of an EnumType.
+type EnumValue struct {
+	Name  string // Go constant name
+	Value string
+}
+
+var (
+	valuesHeader = regexp.MustCompile(`(?i)(valid|possible) values( are)?:?`)
+	enumToken    = regexp.MustCompile("^`?([A-Za-z][A-Za-z0-9_]*)`?$")
+	enumBullet   = regexp.MustCompile("^[-*] +(?:`([A-Za-z][A-Za-z0-9_]*)`|([A-Za-z][A-Za-z0-9_]*)$)")
+	enumNote     = regexp.MustCompile("^The `([A-Za-z][A-Za-z0-9_]*)` value ")
+)
+
+// commentValues returns the values listed in a query option's comments.
+// Values are either listed following "Valid values:" (or "Possible values
+// are:") as a comma separated list or as bullet lines containing a single
+// word or beginning with the value in backquotes.  A bullet list may be
+// followed by a note adding a value ("The `any` value is equivalent to
+// any status.").  Lists that offer values in addition to other
+// input ("either valid folder Guids or the following values") are not
+// returned.
+func commentValues(comments []string) []string {
+	for i, line := range comments {
+		loc := valuesHeader.FindStringIndex(line)
+		if loc == nil || strings.Contains(strings.ToLower(line), "either") {
+			continue
+		}
+		if rest := strings.TrimSpace(line[loc[1]:]); rest > "" {
+			return inlineValues(rest)
+		}
+		var vals []string
+		rest := comments[i+1:]
+		for len(rest) > 0 {
+			l := strings.TrimSpace(rest[0])
+			if l == "" && len(vals) == 0 {
+				rest = rest[1:]
+				continue
+			}
+			m := enumBullet.FindStringSubmatch(l)
+			if m == nil {
+				break
+			}
+			vals, rest = append(vals, m[1]+m[2]), rest[1:]
+		}
+		for _, l := range rest {
+			if m := enumNote.FindStringSubmatch(strings.TrimSpace(l)); m != nil && len(vals) > 0 {
+				vals = append(vals, m[1])
+			}
+		}
+		return uniqueValues(vals)
+	}
+	return nil
+}
+
+// inlineValues parses a list such as "Unknown, Original, or Deposited."
+func inlineValues(s string) []string {
+	if ix := strings.Index(s, ". "); ix >= 0 {
+		s = s[:ix]
+	}
+	s = strings.TrimSuffix(s, ".")
+	var vals []string
+	for _, tok := range strings.Split(s, ",") {
+		tok = strings.TrimSpace(tok)
+		for _, prefix := range []string{"or ", "and "} {
+			tok = strings.TrimPrefix(tok, prefix)
+		}
+		for _, w := range strings.Split(tok, " and ") {
+			m := enumToken.FindStringSubmatch(strings.TrimSpace(w))
+			if m == nil {
+				return nil
+			}
+			vals = append(vals, m[1])
+		}
+	}
+	if len(vals) < 2 {
+		return nil
+	}
+	return uniqueValues(vals)
+}
+
+func uniqueValues(vals []string) []string {
+	var res []string
+	seen := make(map[string]bool)
+	for _, v := range vals {
+		if !seen[v] {
+			seen[v] = true
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// isCommaList reports whether the comments describe a comma separated list.
+func isCommaList(comments []string) bool {
+	s := strings.ToLower(strings.Join(comments, " "))
+	return strings.Contains(s, "comma-separated list") || strings.Contains(s, "comma separated list")
+}
+
+// typeQueryOpt sets the Type and Values of q for options that are not
+// overridden.  Options ending in "_date" are time.Time, comma separated
+// lists are variadic, and options with listed values use a named type
+// assigned by QueryEnumTypes.
+func typeQueryOpt(q *QueryOpt, enum []string) {
+	if q.Type == "string" {
+		switch {
+		case strings.HasSuffix(q.Name, "_date"):
+			q.Type = "time.Time"
+		case isCommaList(q.Comments):
+			q.Type = "...string"
+		}
+	}
+	if q.Type == "string" || q.Type == "...string" {
+		if q.Values = enum; len(q.Values) == 0 {
+			q.Values = commentValues(q.Comments)
+		}
+	}
+	q.Value = valueCode(q.Type)
+}
+
+// TypeQueryOpt sets the type of an option using its name, Go type and
+// comments.  It allows existing generated code to be retyped without a
+// specification.
+func TypeQueryOpt(q *QueryOpt) {
+	typeQueryOpt(q, nil)
+}
+
+// QueryEnumTypes names the types of options with values and returns the
+// types to declare in the package.  funcNames[i] is the function name of
+// the operation with options opts[i].  An option's type is named for the
+// option (e.g. FromToStatus) when every option of that name in the
+// package has the same values and the name is not otherwise used;
+// otherwise the function name is prefixed (e.g. ListStatusChangesInclude).
+func QueryEnumTypes(funcNames []string, opts [][]QueryOpt) []*EnumType {
+	used := map[string]bool{"Service": true, "New": true}
+	valueSets := make(map[string]map[string]bool)
+	for i, fn := range funcNames {
+		used[fn+"Op"] = true
+		for _, q := range opts[i] {
+			if len(q.Values) == 0 {
+				continue
+			}
+			if valueSets[q.GoName] == nil {
+				valueSets[q.GoName] = make(map[string]bool)
+			}
+			valueSets[q.GoName][strings.Join(q.Values, ",")] = true
+		}
+	}
+	var types []*EnumType
+	byKey := make(map[string]*EnumType)
+	for i, fn := range funcNames {
+		for j := range opts[i] {
+			q := &opts[i][j]
+			if len(q.Values) == 0 {
+				continue
+			}
+			key := q.GoName + ":" + strings.Join(q.Values, ",")
+			et, ok := byKey[key]
+			if !ok {
+				name := q.GoName
+				if len(valueSets[q.GoName]) > 1 || used[name] {
+					name = fn + q.GoName
+				}
+				used[name] = true
+				et = &EnumType{Name: name, Param: q.Name}
+				names := make(map[string]bool)
+				for _, v := range q.Values {
+					cn := name + ToGoName(v)
+					if names[cn] {
+						continue
+					}
+					names[cn] = true
+					et.Values = append(et.Values, EnumValue{Name: cn, Value: v})
+				}
+				byKey[key] = et
+				types = append(types, et)
+			}
+			q.Enum = et
+			if q.IsVariadic() {
+				q.Type = "..." + et.Name
+			} else {
+				q.Type, q.Value = et.Name, "string(val)"
+			}
+		}
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].Name < types[j].Name
+	})
+	return types
+}
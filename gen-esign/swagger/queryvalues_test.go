@@ -0,0 +1,56 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package swagger
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCommentValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		comments []string
+		want     []string
+	}{
+		{
+			name:     "inline",
+			comments: []string{"AcStatus specifies the status. Valid values are: Unknown, Original, Transferred, AuthoritativeCopy, or Deposited."},
+			want:     []string{"Unknown", "Original", "Transferred", "AuthoritativeCopy", "Deposited"},
+		},
+		{
+			name:     "bullets",
+			comments: []string{"Include specifies extra data. Valid values:", "", "- `recipients`", "- `documents`: the documents", "", "Other text"},
+			want:     []string{"recipients", "documents"},
+		},
+		{
+			name: "bullets with note",
+			comments: []string{
+				"Status is a comma-separated list of statuses. Possible values are:", "",
+				"* `completed`", "* `sent`", "", "The `any` value is equivalent to any status.",
+			},
+			want: []string{"completed", "sent", "any"},
+		},
+		{
+			name:     "either",
+			comments: []string{"FolderIDs is either valid folder Guids or the following values: drafts, inbox."},
+		},
+		{
+			name:     "note without list",
+			comments: []string{"Valid values:", "some text", "The `any` value is equivalent to any status."},
+			want:     []string{},
+		},
+	}
+	for _, tt := range tests {
+		got := commentValues(tt.comments)
+		if len(got) == 0 && len(tt.want) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: expected %q; got %q", tt.name, tt.want, got)
+		}
+	}
+}
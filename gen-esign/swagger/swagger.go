@@ -43,6 +43,9 @@ type Document struct {
 	Definitions  DefSlice     `json:"definitions,omitempty"`
 	Tags         []Tag        `json:"tags,omitempty"`
 	DSTags       []Tag        `json:"x-ds-categories,omitempty"`
+	// Warnings lists the schemas that could not be converted exactly
+	// from an OpenAPI 3 specification.
+	Warnings []string `json:"-"`
 }
 
 // OpList provides custom json decoding for
@@ -140,6 +143,7 @@ type Definition struct {
 	Summary     string    `json:"x-ms-summary,omitempty"`
 	Category    string    `json:"x-ds-category,omitempty"`
 	Order       string    `json:"x-ds-order,omitempty"`
+	Required    []string  `json:"required,omitempty"`
 }
 
 // CommentLines converts the lines of Description
@@ -318,6 +322,10 @@ type Field struct {
 	Ref                  string              `json:"$ref,omitempty"`
 	Format               string              `json:"format,omitempty"`
 	AdditionalProperties *AdditionalProperty `json:"additionalProperties,omitempty"`
+	Enum                 []string            `json:"enum,omitempty"`
+	MinLength            *int                `json:"minLength,omitempty"`
+	MaxLength            *int                `json:"maxLength,omitempty"`
+	Pattern              string              `json:"pattern,omitempty"`
 }
 
 // AdditionalProperty defines the value type of a map
@@ -555,6 +563,15 @@ type QueryOpt struct {
 	Type     string
 	Value    string
 	Comments []string
+	// Values are the allowed values of the option
+	Values []string
+	// Enum is the named type of the option's values
+	Enum *EnumType
+}
+
+// IsVariadic reports whether the option accepts a list of values.
+func (q QueryOpt) IsVariadic() bool {
+	return strings.HasPrefix(q.Type, "...")
 }
 
 func queryComments(p Property) []string {
@@ -592,6 +609,9 @@ func (o Operation) QueryOpts(overrides map[string]map[string]string) []QueryOpt
 			ty, ok := opOverrides[p.Name]
 			if !ok {
 				ty = p.Type
+				if p.Format == "date-time" || p.Format == "date" {
+					ty = "time.Time"
+				}
 			}
 			switch ty {
 			case "integer":
@@ -604,18 +624,167 @@ func (o Operation) QueryOpts(overrides map[string]map[string]string) []QueryOpt
 				ty = "bool"
 			}
 
-			params = append(params, QueryOpt{
+			q := QueryOpt{
 				Name:     p.Name,
 				GoName:   ToGoName(p.Name),
 				Type:     ty,
-				Value:    valueCode(ty),
 				Comments: queryComments(p),
-			})
+			}
+			typeQueryOpt(&q, p.Enum)
+			if q.Type == "time.Time" && p.Format == "date" {
+				q.Value = `val.Format("2006-01-02")`
+			}
+			params = append(params, q)
 		}
 	}
 	return params
 }
 
+// Paging describes the query parameters and result fields used by
+// a list operation's Pages func.
+type Paging struct {
+	StartParam string
+	TokenParam string
+	PageParam  string
+	// NilCheck is the condition indicating a result without paging values
+	NilCheck string
+	// Fields are the esign.PageInfo field assignments
+	Fields []PagingField
+	// UsesFmt indicates that a numeric field is converted using fmt.Sprint
+	UsesFmt bool
+}
+
+// PagingField is an assignment of a result field to an esign.PageInfo field.
+type PagingField struct {
+	Name  string
+	Value string
+}
+
+// pagingFields maps result json names to esign.PageInfo fields
+var pagingFields = map[string]string{
+	"startPosition":             "StartPosition",
+	"result_set_start_position": "StartPosition",
+	"endPosition":               "EndPosition",
+	"result_set_end_position":   "EndPosition",
+	"resultSetSize":             "ResultSetSize",
+	"result_set_size":           "ResultSetSize",
+	"totalSetSize":              "TotalSetSize",
+	"total_set_size":            "TotalSetSize",
+	"totalRowCount":             "TotalSetSize",
+	"continuationToken":         "ContinuationToken",
+	"nextUri":                   "NextURI",
+	"next":                      "NextURI",
+	"page":                      "Page",
+	"minimumPagesRemaining":     "PagesRemaining",
+	"pageSize":                  "PageSize",
+	"page_size":                 "PageSize",
+	"total_count":               "TotalCount",
+}
+
+// pagingParams returns the first query parameter name found in names
+func pagingParam(params []QueryOpt, names ...string) string {
+	for _, nm := range names {
+		for _, p := range params {
+			if p.Name == nm {
+				return nm
+			}
+		}
+	}
+	return ""
+}
+
+// Paging returns the paging description for the operation or nil if the
+// operation's query parameters and result do not support paging. result
+// is the go type returned by the operation.
+func (o Operation) Paging(defMap map[string]Definition, overrides map[string]map[string]string, params []QueryOpt, result string) *Paging {
+	pg := &Paging{
+		StartParam: pagingParam(params, "start_position", "startPosition", "start"),
+		TokenParam: pagingParam(params, "continuation_token"),
+		PageParam:  pagingParam(params, "page_number", "page"),
+	}
+	if pg.StartParam == "" && pg.TokenParam == "" && pg.PageParam == "" {
+		return nil
+	}
+	var def Definition
+	var ok bool
+	for k, v := range o.Responses {
+		if (k == "200" || k == "201") && v.Schema != nil {
+			def, ok = defMap[v.Schema.Ref]
+		}
+	}
+	if !ok {
+		return nil
+	}
+	container := "res"
+	var nilChecks []string
+	if strings.HasPrefix(result, "*") {
+		nilChecks = append(nilChecks, "res == nil")
+	}
+	fldmap := pagingFieldsFor(def, defMap, overrides)
+	if len(fldmap) == 0 {
+		// check for nested paging struct
+		for _, f := range def.Fields {
+			if f.Name == "paging" && f.Ref > "" {
+				fldmap = pagingFieldsFor(defMap[f.Ref], defMap, overrides)
+				container = "res." + ToGoName(f.Name)
+				nilChecks = append(nilChecks, container+" == nil")
+				break
+			}
+		}
+	}
+	hasStart := pg.StartParam > "" && (fldmap["EndPosition"].Name > "" || fldmap["NextURI"].Name > "" ||
+		(fldmap["StartPosition"].Name > "" && fldmap["ResultSetSize"].Name > ""))
+	hasToken := pg.TokenParam > "" && fldmap["ContinuationToken"].Name > ""
+	hasPage := pg.PageParam > "" && fldmap["Page"].Name > "" &&
+		(fldmap["PagesRemaining"].Name > "" || (fldmap["PageSize"].Name > "" && fldmap["TotalCount"].Name > ""))
+	if !hasStart {
+		pg.StartParam = ""
+	}
+	if !hasToken {
+		pg.TokenParam = ""
+	}
+	if !hasPage {
+		pg.PageParam = ""
+	}
+	if !hasStart && !hasToken && !hasPage {
+		return nil
+	}
+	pg.NilCheck = strings.Join(nilChecks, " || ")
+	var names []string
+	for nm := range fldmap {
+		names = append(names, nm)
+	}
+	sort.Strings(names)
+	for _, nm := range names {
+		sf := fldmap[nm]
+		value := container + "." + sf.Name
+		switch {
+		case sf.Type == "string" && nm == "NextURI":
+			value = "&" + value
+		case sf.Type == "string":
+		case nm == "NextURI":
+			continue
+		default:
+			value = "fmt.Sprint(" + value + ")"
+			pg.UsesFmt = true
+		}
+		pg.Fields = append(pg.Fields, PagingField{Name: nm, Value: value})
+	}
+	return pg
+}
+
+// pagingFieldsFor returns a map of esign.PageInfo field names to
+// the definition's struct fields.
+func pagingFieldsFor(def Definition, defMap map[string]Definition, overrides map[string]map[string]string) map[string]StructField {
+	fldmap := make(map[string]StructField)
+	for _, sf := range def.StructFields(defMap, overrides) {
+		if nm, ok := pagingFields[sf.JSON]; ok && !strings.HasPrefix(sf.Type, "*") && !strings.HasPrefix(sf.Type, "[]") {
+			fldmap[nm] = sf
+		}
+	}
+	return fldmap
+}
+
 // SDK returns the operation's DocuSign SDK ID
 func (o Operation) SDK() string {
 	return o.Service + "::" + o.MethodName
@@ -660,6 +829,7 @@ type Property struct {
 	Type        string     `json:"type,omitempty"`
 	Format      string     `json:"format,omitempty"`
 	Schema      *SchemaRef `json:"schema,omitempty"`
+	Enum        []string   `json:"enum,omitempty"`
 }
 
 // valueCode generates code for updating a call's
@@ -673,7 +843,7 @@ func valueCode(ty string) string {
 	case "float64":
 		return "fmt.Sprintf(\"%f\", val )"
 	case "time.Time":
-		return "val.Format(time.RFC3339)"
+		return "val.Format(time.RFC3339Nano)"
 	case "...string":
 		return `strings.Join(val,",")`
 	}
@@ -0,0 +1,348 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package swagger
+
+// tabs.go contains the generation of typed accessors for the
+// tab lists of the esignature Tabs definition.
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+)
+
+// TabKind describes a tab list field of the Tabs definition.
+type TabKind struct {
+	Field    string // Go field name in Tabs (e.g. TextTabs)
+	Kind     string // kind value (e.g. text)
+	Const    string // Go name of the kind constant (e.g. TabKindText)
+	Type     string // Go struct name of the tab (e.g. Text)
+	HasLabel bool   // tab has a TabLabel
+	HasValue bool   // tab has a string Value
+	Special  string // checkbox, list or radioGroup for tabs whose value is a selection
+}
+
+// TabKinds returns a TabKind for each tab list of the Tabs definition.
+// Tab properties are determined from the definitions so that new tab
+// types are included as they are added to the specification.
+func TabKinds(apiname string, defMap map[string]Definition, overrides map[string]map[string]string) []TabKind {
+	tabsDef, ok := defMap["#/definitions/tabs"]
+	if !ok || len(TabDefs(apiname, defMap, overrides)) == 0 {
+		return nil
+	}
+	var kinds []TabKind
+	for _, f := range tabsDef.Fields {
+		if f.Type != "array" || f.Items == nil || f.Items.Ref == "" {
+			continue
+		}
+		itemDef, ok := defMap[f.Items.Ref]
+		if !ok {
+			continue
+		}
+		kind := strings.TrimSuffix(f.Name, "Tabs")
+		if kind == f.Name {
+			kind = strings.TrimSuffix(f.Name, "s")
+		}
+		tk := TabKind{
+			Field: ToGoName(f.Name),
+			Kind:  kind,
+			Const: "TabKind" + ToGoName(kind),
+			Type:  itemDef.StructName(),
+		}
+		flds := make(map[string]string)
+		for _, sf := range itemDef.StructFields(defMap, overrides) {
+			flds[sf.Name] = sf.Type
+		}
+		_, hasPosition := flds["TabPosition"]
+		_, hasValue := flds["TabValue"]
+		tk.HasLabel = hasPosition || flds["TabLabel"] == "string"
+		tk.HasValue = hasValue || flds["Value"] == "string"
+		switch {
+		case flds["GroupName"] == "string" && strings.HasPrefix(flds["Radios"], "[]"):
+			tk.Special, tk.HasLabel, tk.HasValue = "radioGroup", true, true
+		case strings.HasPrefix(flds["ListItems"], "[]") && tk.HasValue:
+			tk.Special = "list"
+		case flds["Selected"] == "Bool" && !tk.HasValue:
+			tk.Special, tk.HasValue = "checkbox", true
+		}
+		kinds = append(kinds, tk)
+	}
+	return kinds
+}
+
+// TabAccessorCode returns the code for the TabKind constants, the Tab type
+// and the Tabs accessor methods.
+func TabAccessorCode(kinds []TabKind) (string, error) {
+	if len(kinds) == 0 {
+		return "", nil
+	}
+	buf := &bytes.Buffer{}
+	if err := tabAccessorTemplate.Execute(buf, kinds); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+var tabAccessorTemplate = template.Must(template.New("tabs").Parse(`
+// TabKind identifies a tab list of Tabs
+type TabKind string
+
+// TabKind values for each list of Tabs
+const ({{range .}}
+	// {{.Const}} identifies {{.Field}}
+	{{.Const}} TabKind = "{{.Kind}}"{{end}}
+)
+
+// TabKinds lists the kind of every tab list in Tabs
+var TabKinds = []TabKind{ {{range .}}
+	{{.Const}},{{end}}
+}
+
+// Tab is a tab found in a Tabs struct.  Ptr points to the tab's struct
+// in its Tabs list (e.g. a *Text for TabKindText), so changes made via
+// the Tab update the Tabs.  Appending to a list invalidates the list's
+// Tab values.
+type Tab struct {
+	Kind TabKind
+	Ptr  interface{}
+}
+
+// Label returns the tab's TabLabel.  The label of a radio group is its
+// GroupName.
+func (t Tab) Label() string {
+	switch p := t.Ptr.(type) { {{range .}}{{if .HasLabel}}
+	case *{{.Type}}:
+		return p.{{if eq .Special "radioGroup"}}GroupName{{else}}TabLabel{{end}}{{end}}{{end}}
+	}
+	return ""
+}
+
+func (t Tab) setLabel(label string) bool {
+	switch p := t.Ptr.(type) { {{range .}}{{if .HasLabel}}
+	case *{{.Type}}:
+		p.{{if eq .Special "radioGroup"}}GroupName{{else}}TabLabel{{end}} = label{{end}}{{end}}
+	default:
+		return false
+	}
+	return true
+}
+
+// Value returns the tab's value and true.  ok is false for tabs
+// without a value (e.g. SignHere).  The value of a checkbox is "true"
+// or "false", and the value of a list or radio group is the value of
+// its selected item.
+func (t Tab) Value() (value string, ok bool) {
+	switch p := t.Ptr.(type) { {{range .}}{{if .HasValue}}
+	case *{{.Type}}:
+		return {{if eq .Special "checkbox"}}string(DSBool(p.Selected.True())){{else if eq .Special "list"}}listValue(p.ListItems){{else if eq .Special "radioGroup"}}radioGroupValue(p.Radios){{else}}p.Value{{end}}, true{{end}}{{end}}
+	}
+	return "", false
+}
+
+// SetValue sets the tab's value and reports whether the value was set.
+// A checkbox accepts values parsed by strconv.ParseBool, and a list or
+// radio group selects the item with a matching value.
+func (t Tab) SetValue(value string) bool {
+	switch p := t.Ptr.(type) { {{range .}}{{if .HasValue}}
+	case *{{.Type}}:
+		{{if eq .Special "checkbox"}}return setSelected(&p.Selected, value){{else if eq .Special "list"}}if !setListValue(p.ListItems, value) {
+			return false
+		}
+		p.Value = value{{else if eq .Special "radioGroup"}}return setRadioGroupValue(p.Radios, value){{else}}p.Value = value{{end}}{{end}}{{end}}
+	default:
+		return false
+	}
+	return true
+}
+
+// All returns every tab of t in TabKinds order.
+func (t *Tabs) All() []Tab {
+	if t == nil {
+		return nil
+	}
+	var tabs []Tab{{range .}}
+	for i := range t.{{.Field}} {
+		tabs = append(tabs, Tab{Kind: {{.Const}}, Ptr: &t.{{.Field}}[i]})
+	}{{end}}
+	return tabs
+}
+
+// Find returns all tabs with a Label of label.
+func (t *Tabs) Find(label string) []Tab {
+	var tabs []Tab
+	for _, tab := range t.All() {
+		if tab.Label() == label {
+			tabs = append(tabs, tab)
+		}
+	}
+	return tabs
+}
+
+// GetValue returns the value of the first tab with a Label of label that
+// has a value.
+func (t *Tabs) GetValue(label string) (string, bool) {
+	for _, tab := range t.Find(label) {
+		if v, ok := tab.Value(); ok {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// SetValue sets the value of all tabs with a Label of label and returns
+// the number of tabs updated.
+func (t *Tabs) SetValue(label, value string) int {
+	var cnt int
+	for _, tab := range t.Find(label) {
+		if tab.SetValue(value) {
+			cnt++
+		}
+	}
+	return cnt
+}
+
+// Add appends tab to the list of its type and returns the added Tab.
+// tab must be a tab struct or a pointer to one (e.g. Text or *Text).
+func (t *Tabs) Add(tab interface{}) (Tab, error) {
+	switch v := tab.(type) { {{range .}}
+	case {{.Type}}:
+		t.{{.Field}} = append(t.{{.Field}}, v)
+		return Tab{Kind: {{.Const}}, Ptr: &t.{{.Field}}[len(t.{{.Field}})-1]}, nil
+	case *{{.Type}}:
+		if v != nil {
+			return t.Add(*v)
+		}{{end}}
+	}
+	return Tab{}, fmt.Errorf("unable to add %T to Tabs", tab)
+}
+
+// Values returns a map of labels to values for every labeled tab of t
+// that has a value.  Checkbox values are bools and all other values are
+// strings.  When labels are shared, the first tab's value is used.
+// TabsFromMap(t.Values()) creates text and checkbox tabs with the same
+// labels and values.
+func (t *Tabs) Values() map[string]interface{} {
+	m := make(map[string]interface{})
+	for _, tab := range t.All() {
+		label := tab.Label()
+		if _, ok := m[label]; ok || label == "" {
+			continue
+		}
+		if v, ok := tab.Value(); ok {
+			if tab.Kind == TabKindCheckbox {
+				m[label] = v == string(TRUE)
+				continue
+			}
+			m[label] = v
+		}
+	}
+	return m
+}
+
+// TabsFromMap creates Tabs for prefilling values.  Each key is used as a
+// tab label, and the value determines the tab type:
+//   string        Text tab
+//   bool          Checkbox tab
+//   int, int64,
+//   float64       Number tab
+//   tab struct    the tab, labeled with the key if it has no label
+//                 (e.g. model.Currency or *model.Currency)
+func TabsFromMap(m map[string]interface{}) (*Tabs, error) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	tabs := &Tabs{}
+	for _, k := range keys {
+		var tab interface{}
+		var value string
+		switch v := m[k].(type) {
+		case string:
+			tab, value = Text{}, v
+		case bool:
+			tab, value = Checkbox{}, strconv.FormatBool(v)
+		case int:
+			tab, value = Number{}, strconv.Itoa(v)
+		case int64:
+			tab, value = Number{}, strconv.FormatInt(v, 10)
+		case float64:
+			tab, value = Number{}, strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			tab = v
+		}
+		tx, err := tabs.Add(tab)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", k, err)
+		}
+		if tx.Label() == "" && !tx.setLabel(k) {
+			return nil, fmt.Errorf("%s: %s tabs may not be labeled", k, tx.Kind)
+		}
+		if value > "" {
+			tx.SetValue(value)
+		}
+	}
+	return tabs, nil
+}
+
+func listValue(items []ListItem) string {
+	for _, item := range items {
+		if item.Selected.True() {
+			return item.Value
+		}
+	}
+	return ""
+}
+
+func setListValue(items []ListItem, value string) bool {
+	return selectItem(len(items), func(i int) (string, *Bool) {
+		return items[i].Value, &items[i].Selected
+	}, value)
+}
+
+func radioGroupValue(radios []Radio) string {
+	for _, rb := range radios {
+		if rb.Selected.True() {
+			return rb.Value
+		}
+	}
+	return ""
+}
+
+func setRadioGroupValue(radios []Radio, value string) bool {
+	return selectItem(len(radios), func(i int) (string, *Bool) {
+		return radios[i].Value, &radios[i].Selected
+	}, value)
+}
+
+// selectItem selects the item with a matching value and deselects all
+// others.  An empty value deselects all items.
+func selectItem(cnt int, item func(int) (string, *Bool), value string) bool {
+	found := value == ""
+	for i := 0; i < cnt; i++ {
+		if v, _ := item(i); v == value {
+			found = true
+		}
+	}
+	if !found {
+		return false
+	}
+	for i := 0; i < cnt; i++ {
+		v, sel := item(i)
+		*sel = DSBool(value != "" && v == value)
+	}
+	return true
+}
+
+func setSelected(sel *Bool, value string) bool {
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false
+	}
+	*sel = DSBool(b)
+	return true
+}
+`))
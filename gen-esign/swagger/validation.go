@@ -0,0 +1,172 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package swagger
+
+// validation.go contains the generation of Validate methods from the
+// required lists and field constraints of definitions.
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/jfcote87/esign"
+)
+
+// ValidatedTypes returns the struct names of defs having constraints
+// either directly or through a nested definition.  An error is returned
+// for a pattern that is not a valid regular expression.
+func ValidatedTypes(defs []Definition, defMap map[string]Definition, overrides map[string]map[string]string) (map[string]bool, error) {
+	validated := make(map[string]bool)
+	for changed := true; changed; {
+		changed = false
+		for _, d := range defs {
+			nm := d.StructName()
+			if validated[nm] {
+				continue
+			}
+			lines, err := d.Validations(defMap, overrides, validated)
+			if err != nil {
+				return nil, err
+			}
+			if len(lines) > 0 {
+				validated[nm], changed = true, true
+			}
+		}
+	}
+	return validated, nil
+}
+
+// Validations returns the statements of the definition's Validate
+// method using an esign.Validator v and receiver r.  validated lists
+// the struct names having a Validate method.  An error is returned for
+// a pattern that is not a valid regular expression.
+func (d Definition) Validations(defMap map[string]Definition, overrides map[string]map[string]string, validated map[string]bool) ([]string, error) {
+	required := make(map[string]bool)
+	for _, nm := range d.Required {
+		required[nm] = true
+	}
+	fields := make(map[string]Field)
+	for _, f := range d.Fields {
+		fields[f.Name] = f
+	}
+	var lines []string
+	for _, sf := range d.StructFields(defMap, overrides) {
+		if sf.JSON == "" {
+			continue
+		}
+		f, fld := fields[sf.JSON], "r."+sf.Name
+		if required[sf.JSON] && canBeMissing(sf.Type) {
+			lines = append(lines, fmt.Sprintf("v.Required(%q, %s)", sf.JSON, fld))
+		}
+		if sf.Type == "string" {
+			checks, err := stringValidations(sf.JSON, fld, f)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", d.ID, sf.JSON, err)
+			}
+			lines = append(lines, checks...)
+		}
+		elemType := strings.TrimPrefix(strings.TrimPrefix(sf.Type, "[]"), "*")
+		if !validated[elemType] {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(sf.Type, "[]"):
+			lines = append(lines, fmt.Sprintf("for i := range %s {\nv.NestedIndex(%q, i, %s[i].Validate())\n}", fld, sf.JSON, fld))
+		case strings.HasPrefix(sf.Type, "*"):
+			lines = append(lines, fmt.Sprintf("if %s != nil {\nv.Nested(%q, %s.Validate())\n}", fld, sf.JSON, fld))
+		default:
+			lines = append(lines, fmt.Sprintf("v.Nested(%q, %s.Validate())", sf.JSON, fld))
+		}
+	}
+	return lines, nil
+}
+
+// canBeMissing reports whether a Go type has a value that
+// Validator.Required reports as missing.  Numbers, bools and struct
+// values are always present.
+func canBeMissing(goType string) bool {
+	switch {
+	case goType == "string", goType == "interface{}", goType == "json.RawMessage":
+		return true
+	}
+	for _, prefix := range []string{"*", "[]", "map["} {
+		if strings.HasPrefix(goType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyConstraints returns copies of defs adding the required fields
+// and enums of constraints, keyed by definition name and json field
+// name.  An enum constraint replaces the specification's enum.
+func ApplyConstraints(defs []Definition, constraints map[string]map[string]FieldConstraint) []Definition {
+	if len(constraints) == 0 {
+		return defs
+	}
+	results := make([]Definition, len(defs))
+	for i, d := range defs {
+		fc, ok := constraints[d.Name]
+		if !ok {
+			results[i] = d
+			continue
+		}
+		d.Required = append([]string{}, d.Required...)
+		d.Fields = append([]Field{}, d.Fields...)
+		for j, f := range d.Fields {
+			c, ok := fc[f.Name]
+			if !ok {
+				continue
+			}
+			if c.Required && !contains(d.Required, f.Name) {
+				d.Required = append(d.Required, f.Name)
+			}
+			if len(c.Enum) > 0 {
+				d.Fields[j].Enum = append([]string{}, c.Enum...)
+			}
+		}
+		results[i] = d
+	}
+	return results
+}
+
+// stringValidations returns the enum, length, pattern and format checks
+// of a string field.
+func stringValidations(name, fld string, f Field) ([]string, error) {
+	var lines []string
+	if len(f.Enum) > 0 {
+		values := make([]string, 0, len(f.Enum))
+		for _, e := range f.Enum {
+			values = append(values, fmt.Sprintf("%q", e))
+		}
+		lines = append(lines, fmt.Sprintf("v.Enum(%q, %s, %s)", name, fld, strings.Join(values, ", ")))
+	}
+	if f.MinLength != nil && *f.MinLength > 0 {
+		lines = append(lines, fmt.Sprintf("v.MinLength(%q, %s, %d)", name, fld, *f.MinLength))
+	}
+	if f.MaxLength != nil {
+		lines = append(lines, fmt.Sprintf("v.MaxLength(%q, %s, %d)", name, fld, *f.MaxLength))
+	}
+	if f.Pattern > "" {
+		// patterns must be supported by the regexp package
+		if _, err := regexp.Compile(f.Pattern); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %v", f.Pattern, err)
+		}
+		lines = append(lines, fmt.Sprintf("v.Pattern(%q, %s, %q)", name, fld, f.Pattern))
+	}
+	if contains(esign.ValidatedFormats, f.Format) {
+		lines = append(lines, fmt.Sprintf("v.Format(%q, %s, %q)", name, fld, f.Format))
+	}
+	return lines, nil
+}
+
+// PayloadType returns the struct name of a payload type such as
+// "*model.EnvelopeDefinition".
+func PayloadType(payloadType string) string {
+	nm := strings.TrimPrefix(payloadType, "*")
+	return nm[strings.LastIndex(nm, ".")+1:]
+}
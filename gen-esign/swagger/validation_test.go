@@ -0,0 +1,128 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package swagger
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func validationDefs() ([]Definition, map[string]Definition) {
+	defs := []Definition{
+		{
+			ID:       "Room",
+			Name:     "room",
+			Required: []string{"name", "officeId", "isActive", "owner", "tags"},
+			Fields: FieldList{
+				{Name: "name", Type: "string", MinLength: intPtr(2), MaxLength: intPtr(100)},
+				{Name: "officeId", Type: "integer", Format: "int32"},
+				{Name: "isActive", Type: "boolean"},
+				{Name: "status", Type: "string", Enum: []string{"active", "closed"}},
+				{Name: "code", Type: "string", Pattern: "^[A-Z]+$"},
+				{Name: "roomId", Type: "string", Format: "uuid"},
+				{Name: "owner", Ref: "#/definitions/Owner"},
+				{Name: "tags", Type: "array", Items: &SchemaRef{Type: "string"}},
+				{Name: "notes", Type: "array", Items: &SchemaRef{Ref: "#/definitions/Note"}},
+			},
+		},
+		{
+			ID:       "Owner",
+			Name:     "owner",
+			Required: []string{"email"},
+			Fields: FieldList{
+				{Name: "email", Type: "string", Format: "email"},
+			},
+		},
+		{
+			ID:   "Note",
+			Name: "note",
+			Fields: FieldList{
+				{Name: "text", Type: "string"},
+				{Name: "owner", Ref: "#/definitions/Owner"},
+			},
+		},
+		{
+			ID:   "Plain",
+			Name: "plain",
+			Fields: FieldList{
+				{Name: "text", Type: "string", MinLength: intPtr(0)},
+			},
+		},
+	}
+	defMap := make(map[string]Definition)
+	for _, d := range defs {
+		defMap["#/definitions/"+d.ID] = d
+	}
+	return defs, defMap
+}
+
+func TestDefinition_Validations(t *testing.T) {
+	defs, defMap := validationDefs()
+	validated, err := ValidatedTypes(defs, defMap, nil)
+	if err != nil {
+		t.Fatalf("expected validated types; got %v", err)
+	}
+	// Note is validated through its Owner field
+	if want := map[string]bool{"Room": true, "Owner": true, "Note": true}; !reflect.DeepEqual(validated, want) {
+		t.Errorf("expected validated types %v; got %v", want, validated)
+	}
+
+	want := []string{
+		`v.Required("name", r.Name)`,
+		`v.MinLength("name", r.Name, 2)`,
+		`v.MaxLength("name", r.Name, 100)`,
+		`v.Enum("status", r.Status, "active", "closed")`,
+		`v.Pattern("code", r.Code, "^[A-Z]+$")`,
+		`v.Format("roomId", r.RoomID, "uuid")`,
+		`v.Required("owner", r.Owner)`,
+		"if r.Owner != nil {\nv.Nested(\"owner\", r.Owner.Validate())\n}",
+		`v.Required("tags", r.Tags)`,
+		"for i := range r.Notes {\nv.NestedIndex(\"notes\", i, r.Notes[i].Validate())\n}",
+	}
+	if got, err := defs[0].Validations(defMap, nil, validated); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("expected room validations\n%q\ngot\n%q %v", want, got, err)
+	}
+	if got, err := defs[3].Validations(defMap, nil, validated); err != nil || len(got) != 0 {
+		t.Errorf("expected no validations for plain; got %q %v", got, err)
+	}
+}
+
+func TestDefinition_InvalidPattern(t *testing.T) {
+	defs, defMap := validationDefs()
+	// lookahead is unsupported by the regexp package
+	defs[3].Fields = append(defs[3].Fields, Field{Name: "code", Type: "string", Pattern: "^(?!x)"})
+	_, err := ValidatedTypes(defs, defMap, nil)
+	if err == nil || !strings.Contains(err.Error(), `Plain.code: invalid pattern "^(?!x)"`) {
+		t.Errorf("expected invalid pattern error; got %v", err)
+	}
+}
+
+func TestApplyConstraints(t *testing.T) {
+	defs, defMap := validationDefs()
+	constraints := map[string]map[string]FieldConstraint{
+		"plain": {"text": {Required: true, Enum: []string{"a", "b"}}},
+	}
+	applied := ApplyConstraints(defs, constraints)
+	if len(defs[3].Required) != 0 || len(defs[3].Fields[0].Enum) != 0 {
+		t.Errorf("expected original definitions unchanged")
+	}
+	want := []string{`v.Required("text", r.Text)`, `v.Enum("text", r.Text, "a", "b")`}
+	validated, err := ValidatedTypes(applied, defMap, nil)
+	if err != nil {
+		t.Fatalf("expected validated types; got %v", err)
+	}
+	if got, err := applied[3].Validations(defMap, nil, validated); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %q; got %q %v", want, got, err)
+	}
+	if !reflect.DeepEqual(applied[:3], defs[:3]) {
+		t.Errorf("expected unconstrained definitions unchanged")
+	}
+}
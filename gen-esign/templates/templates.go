@@ -18,7 +18,11 @@ const Service = `// Copyright 2022 James Cote
 // Package {{.Package}} implements the DocuSign SDK
 // category {{.Service}}.
 // {{range .Comments}}
-// {{.}}{{end}}
+// {{.}}{{end}}{{if .NoValidation}}
+//
+// The specification lists no field constraints for this API's models,
+// so they have no Validate methods and ops have no ValidateFirst option.
+// Constraints may be added with a gen-esign overrides file.{{end}}
 //
 // {{$callVersion := .CallVersion}}{{$verPrefix := .VersionID}}{{$docPrefix := .DocPrefix}}{{$docService := .DocService}}{{if .AddDocLinks}}
 // Service Api documentation may be found at:
@@ -69,12 +73,61 @@ func (op *{{.FuncName}}Op) Do(ctx context.Context)  {{if .Result}}({{.Result}},
     {{if .Result}}var res {{.Result}}
     {{end}}return {{if .Result}}res, {{end}}((*esign.Op)(op)).Do(ctx, {{if .Result}}&res{{else}}nil{{end}})
 }
-
+{{if .ValidatesPayload}}
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *{{.FuncName}}Op) ValidateFirst() *{{.FuncName}}Op {
+    if op != nil {
+        op.ValidatePayload = true
+    }
+    return op
+}
+{{end}}{{if .Paging}}
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *{{.FuncName}}Op) Pages(ctx context.Context, f func({{.Result}}) error) error {
+    if op == nil {
+        return esign.ErrNilOp
+    }
+    pageOp := *op
+    pageOp.QueryOpts = make(url.Values)
+    for k, v := range op.QueryOpts {
+        pageOp.QueryOpts[k] = v
+    }
+    for {
+        res, err := pageOp.Do(ctx)
+        if err != nil {
+            return err
+        }
+        if err = f(res); err != nil {
+            if err == esign.ErrStopPaging {
+                return nil
+            }
+            return err
+        }
+        {{if .Paging.NilCheck}}if {{.Paging.NilCheck}} {
+            return nil
+        }
+        {{end}}pg := esign.PageInfo{ {{range .Paging.Fields}}
+            {{.Name}}: {{.Value}},{{end}}
+        }
+        if more, err := pg.SetNext(pageOp.QueryOpts, "{{.Paging.StartParam}}", "{{.Paging.TokenParam}}", "{{.Paging.PageParam}}"); !more || err != nil {
+            return err
+        }
+    }
+}
+{{end}}
 {{$funcName := .FuncName}}{{range .QueryOptions}}{{range .Comments}}// {{.}}
 {{end}}func (op *{{$funcName}}Op) {{.GoName}}({{if ne .Type "bool"}}val {{.Type}}{{end}}) *{{$funcName}}Op {
     if op != nil {
-        op.QueryOpts.Set("{{.Name}}", {{.Value}})
-    }
+{{if and .Enum .IsVariadic}}        vals := make([]string, len(val))
+        for i, v := range val {
+            vals[i] = string(v)
+        }
+        op.QueryOpts.Set("{{.Name}}", strings.Join(vals, ","))
+{{else}}        op.QueryOpts.Set("{{.Name}}", {{.Value}})
+{{end}}    }
     return op
 }
 
@@ -90,7 +143,15 @@ func (op *{{.FuncName}}Op) Do(ctx context.Context)  {{if .Result}}({{.Result}},
     return res, (&newOp).Do(ctx, &res)
 }
 
-{{end}}{{end}}`
+{{end}}{{end}}{{range .EnumTypes}}{{$typeName := .Name}}
+// {{.Name}} is a value of the {{.Param}} query option.
+type {{.Name}} string
+
+// {{.Name}} values
+const ({{range .Values}}
+    {{.Name}} {{$typeName}} = "{{.Value}}"{{end}}
+)
+{{end}}`
 
 // Model is the default template for defining packages input and output structures
 const Model = `// Copyright 2022 James Cote
@@ -98,7 +159,7 @@ const Model = `// Copyright 2022 James Cote
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// Code generated by gen-esign; DO NOT EDIT. {{$defMap := .DefMap}}{{$fldOverrides := .FldOverrides}}
+// Code generated by gen-esign; DO NOT EDIT. {{$defMap := .DefMap}}{{$fldOverrides := .FldOverrides}}{{$validated := .Validated}}
 {{if .IsPackage}}
 // Package model provides definitions for all input
 // and output parameters types found in DocuSign's 
@@ -119,5 +180,71 @@ type {{.StructName}} struct { {{range .StructFields $defMap $fldOverrides}}
     {{range .Comments}}// {{.}}
     {{end}}{{.Name}}{{if .Type}} {{.Type}}{{end}}{{if .JSON}} ` + "`json:\"{{.JSON}},omitempty\"`" + `{{end}}{{end }}
 }
-{{ end }}
-{{.CustomCode}}`
+{{$checks := .Validations $defMap $fldOverrides $validated}}{{if $checks}}
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r {{.StructName}}) Validate() error {
+    var v esign.Validator{{range $checks}}
+    {{.}}{{end}}
+    return v.Err("{{.StructName}}")
+}
+{{end}}{{ end }}
+{{.CustomCode}}
+{{.TabCode}}`
+
+// API is the default template for the interface and stub of a service
+// package's operations
+const API = `// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-esign; DO NOT EDIT.
+
+package {{.Package}}
+
+import ({{range .Packages}}
+    {{.}}{{end}}
+)
+
+// API describes the {{.Service}} operations as functions so that code
+// using a Service may be tested with a Stub.  Query options are not
+// available; use the Service's ops directly when options are needed.
+type API interface { {{range .Methods}}
+    {{.Name}}(ctx context.Context{{.ParamList}}) {{.Results}}{{end}}
+}
+
+// API returns s as an API.  Each method executes the op with no
+// query options.
+func (s *Service) API() API {
+    return serviceAPI{sv: s}
+}
+
+type serviceAPI struct {
+    sv *Service
+}
+{{range .Methods}}
+func (a serviceAPI) {{.Name}}(ctx context.Context{{.ParamList}}) {{.Results}} {
+    return a.sv.{{.Name}}({{.Args}}).Do(ctx)
+}
+{{end}}
+// Stub is an API for testing.  Each method records its call and returns
+// the results of the matching Func field or, when the field is nil, zero
+// values.
+type Stub struct {
+    esign.StubRecorder{{range .Methods}}
+    {{.Name}}Func func(ctx context.Context{{.ParamList}}) {{.Results}}{{end}}
+}
+
+var _ API = (*Stub)(nil)
+{{range .Methods}}
+// {{.Name}} records the call and returns the results of {{.Name}}Func.
+func (s *Stub) {{.Name}}(ctx context.Context{{.ParamList}}) {{.Results}} {
+    s.Record("{{.Name}}"{{range .Params}}, {{.Name}}{{end}})
+    if s.{{.Name}}Func != nil {
+        return s.{{.Name}}Func(ctx{{.CallArgs}})
+    }
+    {{if .Result}}var res {{.Result}}
+    return res, nil{{else}}return nil{{end}}
+}
+{{end}}`
@@ -6,4 +6,5 @@ require (
 	github.com/jfcote87/ctxclient v0.6.1
 	github.com/jfcote87/oauth2 v0.4.0
 	github.com/jfcote87/testutils v0.1.0
+	gopkg.in/yaml.v3 v3.0.1
 )
@@ -0,0 +1,224 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+// jwtpool.go contains a cache of impersonation credentials
+// for services acting on behalf of many users.
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/jfcote87/oauth2/jws"
+)
+
+// JWTCredentialPool creates, caches and evicts credentials for impersonated
+// users.  Credentials are evicted when the pool exceeds its maximum size
+// (least recently used first) or when unused for longer than its ttl.  A
+// pool is safe for concurrent use.
+type JWTCredentialPool struct {
+	cfg     JWTConfig
+	signer  jws.Signer
+	scopes  []string
+	maxSize int
+	ttl     time.Duration
+	now     func() time.Time
+
+	mu      sync.Mutex
+	lru     *list.List // front is most recently used
+	entries map[poolKey]*list.Element
+}
+
+type poolKey struct {
+	userID    string
+	accountID string
+}
+
+type poolEntry struct {
+	key      poolKey
+	cred     *OAuth2Credential
+	lastUsed time.Time
+	flight   *tokenFlight
+}
+
+// tokenFlight is an in-progress token call shared by
+// concurrent requests for the same credential.
+type tokenFlight struct {
+	done     chan struct{}
+	err      error
+	canceled bool // leader's ctx was done
+}
+
+// NewPool returns a pool of credentials created by c.  A maxSize of zero
+// indicates no limit to the number of cached credentials and a ttl of
+// zero indicates that credentials are never evicted for age.  The pool
+// uses a copy of c, so later changes to c do not affect the pool.  If no
+// scopes are listed, signature is assumed.  An error is returned if
+// c.PrivateKey is invalid.
+func (c *JWTConfig) NewPool(maxSize int, ttl time.Duration, scopes ...string) (*JWTCredentialPool, error) {
+	signer, err := jws.RS256FromPEM([]byte(c.PrivateKey), c.KeyPairID)
+	if err != nil {
+		return nil, err
+	}
+	return &JWTCredentialPool{
+		cfg:     *c,
+		signer:  signer,
+		scopes:  scopes,
+		maxSize: maxSize,
+		ttl:     ttl,
+		now:     time.Now,
+		lru:     list.New(),
+		entries: make(map[poolKey]*list.Element),
+	}, nil
+}
+
+// For returns a credential impersonating userID for accountID.  A blank
+// accountID indicates the user's default account.  The credential's token
+// is retrieved before returning, and concurrent calls for the same user
+// and account share a single token request.  If the ctx of the call
+// making the shared request is done, waiting calls make a new request.
+func (p *JWTCredentialPool) For(ctx context.Context, userID, accountID string) (Credential, error) {
+	if p == nil {
+		return nil, errors.New("nil pool")
+	}
+	if ctx == nil {
+		return nil, errors.New("context may not be nil")
+	}
+	key := poolKey{userID: userID, accountID: accountID}
+	for {
+		e, err := p.entry(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		fl, err := p.flight(ctx, e)
+		if err != nil {
+			return nil, err
+		}
+		if fl.err == nil {
+			return e.cred, nil
+		}
+		if !fl.canceled || ctx.Err() != nil {
+			return nil, fl.err
+		}
+	}
+}
+
+// flight starts or joins the token request for e and waits until the
+// request completes or ctx is done.
+func (p *JWTCredentialPool) flight(ctx context.Context, e *poolEntry) (*tokenFlight, error) {
+	p.mu.Lock()
+	fl := e.flight
+	isLeader := fl == nil
+	if isLeader {
+		fl = &tokenFlight{done: make(chan struct{})}
+		e.flight = fl
+	}
+	p.mu.Unlock()
+
+	if isLeader {
+		_, fl.err = e.cred.Token(ctx)
+		fl.canceled = fl.err != nil && ctx.Err() != nil
+		p.mu.Lock()
+		e.flight = nil
+		if el, ok := p.entries[e.key]; ok && fl.err != nil && !fl.canceled && el.Value == e {
+			// do not cache credentials that are unable to authorize
+			p.remove(e.key)
+		}
+		p.mu.Unlock()
+		close(fl.done)
+	}
+	select {
+	case <-fl.done:
+		return fl, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// entry returns the pool entry for key creating a new credential if
+// needed.  The credential is created without holding the pool lock,
+// and ctx is used to load a saved token.
+func (p *JWTCredentialPool) entry(ctx context.Context, key poolKey) (*poolEntry, error) {
+	if e := p.lookup(key); e != nil {
+		return e, nil
+	}
+	cfg := p.cfg
+	cfg.AccountID = key.accountID
+	cred, err := cfg.credential(ctx, p.signer, key.userID, nil, nil, p.scopes...)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	// another request may have added the key while unlocked
+	if el, ok := p.entries[key]; ok {
+		p.lru.MoveToFront(el)
+		return el.Value.(*poolEntry), nil
+	}
+	e := &poolEntry{key: key, cred: cred, lastUsed: p.now()}
+	p.entries[key] = p.lru.PushFront(e)
+	for p.maxSize > 0 && p.lru.Len() > p.maxSize {
+		p.remove(p.lru.Back().Value.(*poolEntry).key)
+	}
+	return e, nil
+}
+
+// lookup returns the cached entry for key marking it as
+// most recently used, or nil if not found.
+func (p *JWTCredentialPool) lookup(key poolKey) *poolEntry {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := p.now()
+	p.evictExpired(now)
+	el, ok := p.entries[key]
+	if !ok {
+		return nil
+	}
+	p.lru.MoveToFront(el)
+	e := el.Value.(*poolEntry)
+	e.lastUsed = now
+	return e
+}
+
+// evictExpired removes entries unused for longer than ttl.
+func (p *JWTCredentialPool) evictExpired(now time.Time) {
+	if p.ttl <= 0 {
+		return
+	}
+	for el := p.lru.Back(); el != nil; el = p.lru.Back() {
+		e := el.Value.(*poolEntry)
+		if now.Sub(e.lastUsed) <= p.ttl {
+			return
+		}
+		p.remove(e.key)
+	}
+}
+
+func (p *JWTCredentialPool) remove(key poolKey) {
+	if el, ok := p.entries[key]; ok {
+		p.lru.Remove(el)
+		delete(p.entries, key)
+	}
+}
+
+// Remove evicts the credential for userID and accountID.
+func (p *JWTCredentialPool) Remove(userID, accountID string) {
+	p.mu.Lock()
+	p.remove(poolKey{userID: userID, accountID: accountID})
+	p.mu.Unlock()
+}
+
+// Len returns the number of cached credentials.
+func (p *JWTCredentialPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.evictExpired(p.now())
+	return p.lru.Len()
+}
@@ -0,0 +1,207 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jfcote87/esign"
+)
+
+// roundTripFunc allows a func to act as an http.RoundTripper
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestJWTCredentialPool(t *testing.T) {
+	ctx := context.Background()
+	var tokenCalls, userInfoCalls int32
+	var failToken int32
+	clx := &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		body, status := userInfoSuccessResponse, 200
+		switch r.URL.Path {
+		case "/oauth/token":
+			atomic.AddInt32(&tokenCalls, 1)
+			// slow token call to allow concurrent requests to queue
+			time.Sleep(50 * time.Millisecond)
+			body = tokenSuccessResponse
+			if atomic.LoadInt32(&failToken) > 0 {
+				body, status = `{"error":"consent_required"}`, 400
+			}
+		case "/oauth/userinfo":
+			atomic.AddInt32(&userInfoCalls, 1)
+		}
+		return &http.Response{
+			StatusCode: status,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+			Request:    r,
+		}, nil
+	})}
+	cfg := &esign.JWTConfig{
+		IntegratorKey: "KEY",
+		PrivateKey:    testPrivateKey(t),
+		KeyPairID:     "1234567890123",
+		IsDemo:        true,
+		HTTPClientFunc: func(ctx context.Context) (*http.Client, error) {
+			return clx, nil
+		},
+	}
+	if _, err := (&esign.JWTConfig{PrivateKey: "invalid"}).NewPool(2, 0); err == nil {
+		t.Errorf("expected invalid private key error")
+	}
+	pool, err := cfg.NewPool(2, 10*time.Minute)
+	if err != nil {
+		t.Fatalf("expected pool; got %v", err)
+	}
+	var clockMu sync.Mutex
+	now := time.Now()
+	esign.SetPoolClock(pool, func() time.Time {
+		clockMu.Lock()
+		defer clockMu.Unlock()
+		return now
+	})
+
+	// concurrent requests for the same user share a token call
+	var wg sync.WaitGroup
+	creds := make([]esign.Credential, 5)
+	for i := range creds {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			cred, err := pool.For(ctx, "USER1", "")
+			if err != nil {
+				t.Errorf("expected credential; got %v", err)
+			}
+			creds[idx] = cred
+		}(i)
+	}
+	wg.Wait()
+	if tokenCalls != 1 || userInfoCalls != 1 {
+		t.Errorf("expected 1 token and 1 userinfo call; got %d and %d", tokenCalls, userInfoCalls)
+	}
+	for _, cred := range creds[1:] {
+		if cred != creds[0] {
+			t.Errorf("expected the same credential for each request")
+		}
+	}
+
+	// accounts are pooled separately and oldest is evicted
+	if _, err := pool.For(ctx, "USER1", "abcd61a3-3b9b-cafe-b7be-4592af32aa9b"); err != nil {
+		t.Fatalf("expected credential for second account; got %v", err)
+	}
+	if _, err := pool.For(ctx, "USER2", ""); err != nil {
+		t.Fatalf("expected credential for USER2; got %v", err)
+	}
+	if pool.Len() != 2 {
+		t.Errorf("expected 2 pooled credentials; got %d", pool.Len())
+	}
+	calls := tokenCalls
+	if cred, _ := pool.For(ctx, "USER1", ""); cred == creds[0] || tokenCalls != calls+1 {
+		t.Errorf("expected new credential for evicted user")
+	}
+
+	// ttl
+	clockMu.Lock()
+	now = now.Add(9 * time.Minute)
+	clockMu.Unlock()
+	if _, err := pool.For(ctx, "USER2", ""); err != nil || pool.Len() != 2 {
+		t.Errorf("expected USER2 to remain pooled before ttl; got %v %d", err, pool.Len())
+	}
+	clockMu.Lock()
+	now = now.Add(9 * time.Minute)
+	clockMu.Unlock()
+	if pool.Len() != 1 {
+		t.Errorf("expected USER1 evicted after ttl; got %d", pool.Len())
+	}
+	clockMu.Lock()
+	now = now.Add(2 * time.Minute)
+	clockMu.Unlock()
+	if pool.Len() != 0 {
+		t.Errorf("expected all credentials evicted after ttl; got %d", pool.Len())
+	}
+
+	// failed credentials are not cached
+	atomic.StoreInt32(&failToken, 1)
+	if _, err := pool.For(ctx, "USER3", ""); err == nil {
+		t.Errorf("expected token error")
+	}
+	if pool.Len() != 0 {
+		t.Errorf("expected failed credential removed; got %d", pool.Len())
+	}
+}
+
+func TestJWTCredentialPool_CanceledLeader(t *testing.T) {
+	var tokenCalls int32
+	started := make(chan struct{}, 2)
+	clx := &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		body := userInfoSuccessResponse
+		if r.URL.Path == "/oauth/token" {
+			atomic.AddInt32(&tokenCalls, 1)
+			started <- struct{}{}
+			select {
+			case <-r.Context().Done():
+				return nil, r.Context().Err()
+			case <-time.After(50 * time.Millisecond):
+			}
+			body = tokenSuccessResponse
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+			Request:    r,
+		}, nil
+	})}
+	cfg := &esign.JWTConfig{
+		IntegratorKey: "KEY",
+		PrivateKey:    testPrivateKey(t),
+		KeyPairID:     "1234567890123",
+		IsDemo:        true,
+		HTTPClientFunc: func(ctx context.Context) (*http.Client, error) {
+			return clx, nil
+		},
+	}
+	pool, err := cfg.NewPool(0, 0)
+	if err != nil {
+		t.Fatalf("expected pool; got %v", err)
+	}
+
+	// the waiting call makes a new token request when the
+	// leader's ctx is canceled
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := pool.For(leaderCtx, "USER1", "")
+		leaderErr <- err
+	}()
+	<-started
+	followerErr := make(chan error, 1)
+	go func() {
+		_, err := pool.For(context.Background(), "USER1", "")
+		followerErr <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	if err := <-leaderErr; err == nil {
+		t.Errorf("expected canceled leader error")
+	}
+	if err := <-followerErr; err != nil {
+		t.Errorf("expected follower credential; got %v", err)
+	}
+	if tokenCalls != 2 || pool.Len() != 1 {
+		t.Errorf("expected 2 token calls and 1 pooled credential; got %d and %d", tokenCalls, pool.Len())
+	}
+}
@@ -78,10 +78,7 @@ func (o OauthCredential) AuthDo(ctx context.Context, op *esign.Op) (*http.Respon
 		req.Header.Set("X-DocuSign-Act-As-User", o.OnBehalfOf)
 	}
 	res, err := o.Func.Do(ctx, req)
-	if nsErr, ok := err.(*ctxclient.NotSuccess); ok {
-		return nil, esign.NewResponseError(nsErr.Body, nsErr.StatusCode)
-	}
-	return res, err
+	return res, esign.ToResponseError(req, err)
 }
 
 // Revoke invalidates the token ensuring that an error will occur on an subsequent uses.
@@ -196,10 +193,7 @@ func (c Config) AuthDo(ctx context.Context, op *esign.Op) (*http.Response, error
 		c.IntegratorKey + "</IntegratorKey></DocuSignCredentials>"
 	req.Header.Set("X-DocuSign-Authentication", authString)
 	res, err := c.Func.Do(ctx, req)
-	if nsErr, ok := err.(*ctxclient.NotSuccess); ok {
-		return nil, esign.NewResponseError(nsErr.Body, nsErr.StatusCode)
-	}
-	return res, err
+	return res, esign.ToResponseError(req, err)
 }
 
 func getHost(isDemo bool, host string) string {
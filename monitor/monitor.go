@@ -13,6 +13,10 @@
 // **Note**: Your accounts must exist inside an organization to access this data.
 //
 //
+// The specification lists no field constraints for this API's models,
+// so they have no Validate methods and ops have no ValidateFirst option.
+// Constraints may be added with a gen-esign overrides file.
+//
 // Service Api documentation may be found at:
 // https://developers.docusign.com/docs/monitor-api/reference/monitor
 // Usage example:
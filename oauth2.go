@@ -18,6 +18,7 @@ import (
 	"regexp"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/jfcote87/ctxclient"
 	"github.com/jfcote87/oauth2"
@@ -83,6 +84,10 @@ type OAuth2Config struct {
 	// if not nil, CacheFunc is called after a new token is created passing
 	// the newly created Token and UserInfo.
 	CacheFunc func(context.Context, oauth2.Token, UserInfo) `json:"cache_func,omitempty"`
+	// if not nil, TokenStore saves new and refreshed tokens.  Use
+	// StoredCredential to create a credential from a saved token.
+	// Save errors are ignored so that a new token is never discarded.
+	TokenStore TokenStore `json:"-"`
 	// Prompt indicates whether the authentication server will prompt
 	// the user for re-authentication, even if they have an active login session.
 	Prompt bool `json:"prompt,omitempty"`
@@ -170,11 +175,46 @@ func (c *OAuth2Config) Exchange(ctx context.Context, code string) (*OAuth2Creden
 	if c.CacheFunc != nil {
 		c.CacheFunc(ctx, *tk, *u)
 	}
+	if c.TokenStore != nil {
+		// a failed save does not invalidate the new token, and the
+		// single use code may not be exchanged again
+		_ = c.TokenStore.Save(ctx, c.tokenKey(u.APIUsername), tk, u)
+	}
 	// create credential
 	return c.Credential(tk, u)
 
 }
 
+func (c *OAuth2Config) tokenKey(userID string) TokenKey {
+	return TokenKey{IntegratorKey: c.IntegratorKey, UserID: userID, AccountID: c.AccountID}
+}
+
+// StoredCredential returns an *OAuth2Credential using the token saved in
+// c.TokenStore for the user.  userID is the user's api username
+// (UserInfo.APIUsername).  ErrTokenNotFound is returned if no usable
+// token is saved.
+func (c *OAuth2Config) StoredCredential(ctx context.Context, userID string) (*OAuth2Credential, error) {
+	if c == nil {
+		return nil, errors.New("nil configuration")
+	}
+	if c.TokenStore == nil {
+		return nil, errors.New("no TokenStore in configuration")
+	}
+	key := c.tokenKey(userID)
+	tk, u, err := c.TokenStore.Load(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if !tk.Valid() && tk.RefreshToken == "" {
+		// expired token may not be refreshed
+		if err = c.TokenStore.Delete(ctx, key); err != nil {
+			return nil, err
+		}
+		return nil, ErrTokenNotFound
+	}
+	return c.Credential(tk, u)
+}
+
 func (c *OAuth2Config) refresher() func(context.Context, *oauth2.Token) (*oauth2.Token, error) {
 	cfg := c.codeGrantConfig() // scopes are not passed in this step
 	return func(ctx context.Context, tk *oauth2.Token) (*oauth2.Token, error) {
@@ -212,6 +252,8 @@ func (c *OAuth2Config) Credential(tk *oauth2.Token, u *UserInfo) (*OAuth2Credent
 		cachedToken: tk,
 		refresher:   c.refresher(),
 		cacheFunc:   c.CacheFunc,
+		store:       c.TokenStore,
+		storeKey:    c.tokenKey(""),
 		isDemo:      demoFlag(c.IsDemo),
 		userInfo:    u,
 		Func:        c.HTTPClientFunc,
@@ -242,6 +284,10 @@ type JWTConfig struct {
 	// if not nil, CacheFunc is called after a new token is created passing
 	// the newly created Token and UserInfo.
 	CacheFunc func(context.Context, oauth2.Token, UserInfo) `json:"-"`
+	// if not nil, TokenStore saves new tokens and Credential loads
+	// a saved token when no token is passed.  Save errors are ignored
+	// so that a new token is never discarded.
+	TokenStore TokenStore `json:"-"`
 	// HTTPClientFunc determines client used for oauth2 token calls.  If
 	// nil, ctxclient.DefaultClient will be used.
 	HTTPClientFunc ctxclient.Func `json:"-"`
@@ -341,23 +387,75 @@ func (c *JWTConfig) jwtRefresher(apiUserName string, signer jws.Signer, scopes .
 }
 
 // Credential returns an *OAuth2Credential.  The passed token will be refreshed
-// as needed.  If no scopes listed, signature is assumed.
+// as needed.  If token is nil and c.TokenStore is set, the user's saved token
+// is loaded using a background context; use StoredCredential to pass a
+// context.  If no scopes listed, signature is assumed.
 func (c *JWTConfig) Credential(apiUserName string, token *oauth2.Token, u *UserInfo, scopes ...string) (*OAuth2Credential, error) {
 	signer, err := jws.RS256FromPEM([]byte(c.PrivateKey), c.KeyPairID)
 	if err != nil {
 		return nil, err
 	}
+	return c.credential(context.Background(), signer, apiUserName, token, u, scopes...)
+}
+
+// StoredCredential returns an *OAuth2Credential using the token saved in
+// c.TokenStore for apiUserName, loaded using ctx.  Unlike
+// OAuth2Config.StoredCredential, a missing or expired token is not an
+// error as the credential requests a new token when needed.  If no
+// scopes listed, signature is assumed.
+func (c *JWTConfig) StoredCredential(ctx context.Context, apiUserName string, scopes ...string) (*OAuth2Credential, error) {
+	if c == nil {
+		return nil, errors.New("nil configuration")
+	}
+	if c.TokenStore == nil {
+		return nil, errors.New("no TokenStore in configuration")
+	}
+	signer, err := jws.RS256FromPEM([]byte(c.PrivateKey), c.KeyPairID)
+	if err != nil {
+		return nil, err
+	}
+	return c.credential(ctx, signer, apiUserName, nil, nil, scopes...)
+}
+
+// credential creates an *OAuth2Credential using a parsed private key.
+// ctx is used to load a saved token when token is nil.
+func (c *JWTConfig) credential(ctx context.Context, signer jws.Signer, apiUserName string, token *oauth2.Token, u *UserInfo, scopes ...string) (*OAuth2Credential, error) {
+	key := TokenKey{IntegratorKey: c.IntegratorKey, UserID: apiUserName, AccountID: c.AccountID}
+	if token == nil && c.TokenStore != nil {
+		var err error
+		if token, u, err = c.loadToken(ctx, key); err != nil {
+			return nil, err
+		}
+	}
 	return &OAuth2Credential{
 		accountID:   c.AccountID,
 		cachedToken: token,
 		refresher:   c.jwtRefresher(apiUserName, signer, scopes...),
 		cacheFunc:   c.CacheFunc,
+		store:       c.TokenStore,
+		storeKey:    key,
 		isDemo:      demoFlag(c.IsDemo),
 		userInfo:    u,
 		Func:        c.HTTPClientFunc,
 	}, nil
 }
 
+// loadToken returns the saved token for key.  A missing or
+// expired token returns nils so that a new token is created.
+func (c *JWTConfig) loadToken(ctx context.Context, key TokenKey) (*oauth2.Token, *UserInfo, error) {
+	tk, u, err := c.TokenStore.Load(ctx, key)
+	if err == ErrTokenNotFound {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if !tk.Valid() {
+		return nil, u, c.TokenStore.Delete(ctx, key)
+	}
+	return tk, u, nil
+}
+
 // OAuth2Credential authorizes op requests via DocuSign's oauth2 protocol.
 type OAuth2Credential struct {
 	accountID   string
@@ -365,10 +463,18 @@ type OAuth2Credential struct {
 	cachedToken *oauth2.Token
 	refresher   func(context.Context, *oauth2.Token) (*oauth2.Token, error)
 	cacheFunc   func(context.Context, oauth2.Token, UserInfo)
+	store       TokenStore
+	storeKey    TokenKey // UserID may be blank until userInfo is set
 	userInfo    *UserInfo
 	isDemo      demoFlag
 	mu          sync.Mutex
 	ctxclient.Func
+
+	// background refresh settings, see SetBackgroundRefresh
+	refreshWindow  time.Duration
+	refreshErrFunc func(error)
+	refreshing     bool
+	refreshRetryAt time.Time
 }
 
 // AuthDo set the authorization header and completes request's url
@@ -390,7 +496,7 @@ func (cred *OAuth2Credential) AuthDo(ctx context.Context, op *Op) (*http.Respons
 	// finalize url
 	req.URL = op.Version.ResolveDSURL(req.URL, cred.baseURI.Host, cred.accountID, bool(cred.isDemo))
 	res, err := cred.Func.Do(ctx, req)
-	return res, toResponseError(err)
+	return res, ToResponseError(req, err)
 }
 
 // WithAccountID creates a copy the current credential with a new accountID.  An empty
@@ -403,15 +509,22 @@ func (cred *OAuth2Credential) WithAccountID(accountID string) *OAuth2Credential
 	}
 	cred.mu.Lock()
 	defer cred.mu.Unlock()
+	storeKey := cred.storeKey
+	storeKey.AccountID = accountID
 	return &OAuth2Credential{
 		accountID:   accountID,
 		baseURI:     nil,
 		cachedToken: cred.cachedToken,
 		refresher:   cred.refresher,
 		cacheFunc:   cred.cacheFunc,
+		store:       cred.store,
+		storeKey:    storeKey,
 		isDemo:      cred.isDemo,
 		userInfo:    cred.userInfo,
 		Func:        cred.Func,
+
+		refreshWindow:  cred.refreshWindow,
+		refreshErrFunc: cred.refreshErrFunc,
 	}
 }
 
@@ -447,7 +560,22 @@ func (cred *OAuth2Credential) Token(ctx context.Context) (*oauth2.Token, error)
 	if cred == nil {
 		return nil, errNilCredential
 	}
-	var updateCache bool
+	tk, update, err := cred.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// run the cache func and store without the lock
+	if update != nil {
+		update.save(ctx)
+	}
+	return tk, nil
+}
+
+// token validates and, if needed, refreshes the cached token while
+// the credential is locked.  A non-nil tokenUpdate is returned when the
+// token or user info changed.
+func (cred *OAuth2Credential) token(ctx context.Context) (*oauth2.Token, *tokenUpdate, error) {
+	var updateCache, updateStore bool
 	var err error
 	// lock credential during validation and possible update
 	cred.mu.Lock()
@@ -455,30 +583,145 @@ func (cred *OAuth2Credential) Token(ctx context.Context) (*oauth2.Token, error)
 
 	if !cred.cachedToken.Valid() {
 		if cred.refresher == nil {
-			return nil, errors.New("no refresher function for invalid/expired token")
+			return nil, nil, errors.New("no refresher function for invalid/expired token")
 		}
 		if cred.cachedToken, err = cred.refresher(ctx, cred.cachedToken); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		updateCache = (cred.cacheFunc != nil)
+		updateStore = (cred.store != nil)
+	} else if cred.needsBackgroundRefresh() {
+		cred.refreshing = true
+		go cred.backgroundRefresh(cred.cachedToken)
 	}
 	// check for userInfo and set AccountID and BaseURI to resolve op urls
 	if cred.userInfo == nil {
 		cred.userInfo, err = cred.isDemo.getUserInfoForToken(ctx, cred.Func, cred.cachedToken)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		updateCache = (cred.cacheFunc != nil)
 	}
 	if cred.baseURI == nil || cred.accountID == "" { // values may be blank if loading userinfo from cache
 		if cred.accountID, cred.baseURI, err = cred.userInfo.getAccountID(cred.accountID); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
+	if !updateCache && !updateStore {
+		return cred.cachedToken, nil, nil
+	}
+	return cred.cachedToken, cred.newTokenUpdate(updateCache, updateStore), nil
+}
+
+// tokenUpdate contains copies of a new token and user info so that the
+// cache func and TokenStore are called after the credential is unlocked.
+type tokenUpdate struct {
+	token     oauth2.Token
+	userInfo  UserInfo
+	cacheFunc func(context.Context, oauth2.Token, UserInfo)
+	store     TokenStore
+	key       TokenKey
+}
+
+// newTokenUpdate copies the cached token and user info for the cache
+// func and, if updateStore, the TokenStore.  The credential must be
+// locked and userInfo set.
+func (cred *OAuth2Credential) newTokenUpdate(updateCache, updateStore bool) *tokenUpdate {
+	u := &tokenUpdate{token: *cred.cachedToken, userInfo: *cred.userInfo, key: cred.tokenKey()}
 	if updateCache {
-		cred.cacheFunc(ctx, *cred.cachedToken, *cred.userInfo)
+		u.cacheFunc = cred.cacheFunc
+	}
+	if updateStore {
+		u.store = cred.store
+	}
+	return u
+}
+
+// save calls the cache func and saves the token to the TokenStore.  The
+// credential must not be locked.
+func (u *tokenUpdate) save(ctx context.Context) {
+	if u.cacheFunc != nil {
+		u.cacheFunc(ctx, u.token, u.userInfo)
+	}
+	if u.store != nil {
+		// a failed save does not invalidate the new token
+		_ = u.store.Save(ctx, u.key, &u.token, &u.userInfo)
+	}
+}
+
+// tokenKey returns the TokenStore key of the credential.  The
+// credential must be locked.
+func (cred *OAuth2Credential) tokenKey() TokenKey {
+	key := cred.storeKey
+	if key.UserID == "" && cred.userInfo != nil {
+		key.UserID = cred.userInfo.APIUsername
+	}
+	return key
+}
+
+// needsBackgroundRefresh returns true when the valid cached token
+// expires within the refresh window.  The credential must be locked.
+func (cred *OAuth2Credential) needsBackgroundRefresh() bool {
+	if cred.refreshWindow <= 0 || cred.refreshing || cred.refresher == nil || cred.cachedToken.Expiry.IsZero() {
+		return false
 	}
-	return cred.cachedToken, nil
+	now := time.Now()
+	return cred.cachedToken.Expiry.Sub(now) < cred.refreshWindow && !now.Before(cred.refreshRetryAt)
+}
+
+// backgroundRefresh obtains a new token while ops continue to use
+// the current token.  The new token replaces the cached token only if
+// it expires later, and the cache func and TokenStore are called after
+// the credential is unlocked.
+func (cred *OAuth2Credential) backgroundRefresh(tk *oauth2.Token) {
+	ctx := context.Background()
+	newToken, err := cred.refresher(ctx, tk)
+
+	cred.mu.Lock()
+	cred.refreshing = false
+	errFunc := cred.refreshErrFunc
+	if err == nil && newToken == nil {
+		err = errors.New("refresher returned nil token")
+	}
+	if err != nil {
+		// wait before retrying a failed refresh
+		cred.refreshRetryAt = time.Now().Add(cred.refreshWindow / 4)
+		cred.mu.Unlock()
+		if errFunc != nil {
+			errFunc(err)
+		}
+		return
+	}
+	// a foreground refresh may have replaced the token during the request
+	if !newToken.Expiry.IsZero() && !newToken.Expiry.After(cred.cachedToken.Expiry) {
+		cred.mu.Unlock()
+		return
+	}
+	cred.cachedToken = newToken
+	if cred.userInfo == nil {
+		cred.mu.Unlock()
+		return
+	}
+	// copy values so the cache func and store run without the lock
+	update := cred.newTokenUpdate(cred.cacheFunc != nil, cred.store != nil)
+	cred.mu.Unlock()
+	update.save(ctx)
+}
+
+// SetBackgroundRefresh enables refreshing the credential's token in the
+// background once the token expires within window.  Ops continue to use
+// the current token during the refresh rather than waiting.  Refresh errors
+// are passed to errFunc, which may be nil, and the refresh is retried after
+// window/4.  If the token expires before a refresh succeeds, the next op
+// refreshes the token as usual and receives any error.  A zero window
+// disables background refresh.
+func (cred *OAuth2Credential) SetBackgroundRefresh(window time.Duration, errFunc func(error)) *OAuth2Credential {
+	cred.mu.Lock()
+	cred.refreshWindow = window
+	cred.refreshErrFunc = errFunc
+	cred.refreshRetryAt = time.Time{}
+	cred.mu.Unlock()
+	return cred
 }
 
 // SetClientFunc safely replaces the ctxclient.Func for the credential
@@ -518,14 +761,7 @@ func (t *tokenCredential) AuthDo(ctx context.Context, op *Op) (*http.Response, e
 	req, _ := http.NewRequest(op.Method, op.Path, nil)
 	t.Token.SetAuthHeader(req)
 	res, err := t.Func.Do(ctx, req)
-	return res, toResponseError(err)
-}
-
-func toResponseError(err error) error {
-	if nsErr, ok := err.(*ctxclient.NotSuccess); ok {
-		return NewResponseError(nsErr.Body, nsErr.StatusCode)
-	}
-	return err
+	return res, ToResponseError(req, err)
 }
 
 // UserInfo provides all account info for a specific user.  Data from
@@ -6,10 +6,12 @@ package esign_test
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/jfcote87/esign"
 	"github.com/jfcote87/oauth2"
@@ -502,3 +504,193 @@ func (tv *testVersion) ResolveDSURL(u *url.URL, host string, accountID string, i
 	newURL.Path = tv.Prefix + u.Path
 	return &newURL
 }
+
+func TestOAuth2Credential_SetBackgroundRefresh(t *testing.T) {
+	ctx := context.Background()
+	cfg, testTransport := getOAuth2ConfigTransport()
+	var u *esign.UserInfo
+	if err := json.Unmarshal([]byte(userInfoSuccessResponse), &u); err != nil {
+		t.Fatalf("unmarshal userinfo: %v", err)
+	}
+	cached := make(chan oauth2.Token, 1)
+	cfg.CacheFunc = func(cx context.Context, tk oauth2.Token, ui esign.UserInfo) {
+		cached <- tk
+	}
+	errs := make(chan error, 1)
+	expiring := &oauth2.Token{AccessToken: "EXPIRING", RefreshToken: "refresh", Expiry: time.Now().Add(time.Minute)}
+	cred, err := cfg.Credential(expiring, u)
+	if err != nil {
+		t.Fatalf("expected credential; got %v", err)
+	}
+	cred.SetBackgroundRefresh(5*time.Minute, func(err error) {
+		errs <- err
+	})
+
+	// failed refresh reports error and current token is used
+	testTransport.Add(&testutils.RequestTester{
+		Path:     "/oauth/token",
+		Response: testutils.MakeResponse(400, []byte(`{"error":"invalid_grant"}`), nil),
+	})
+	if tk, err := cred.Token(ctx); err != nil || tk.AccessToken != "EXPIRING" {
+		t.Fatalf("expected EXPIRING token; got %v %v", tk, err)
+	}
+	select {
+	case err = <-errs:
+	case <-time.After(time.Second):
+		t.Fatalf("expected refresh error callback")
+	}
+	if tk, err := cred.Token(ctx); err != nil || tk.AccessToken != "EXPIRING" {
+		t.Fatalf("expected EXPIRING token during retry wait; got %v %v", tk, err)
+	}
+
+	// successful refresh replaces token
+	cred.SetBackgroundRefresh(5*time.Minute, nil)
+	testTransport.Add(refreshResponseTest)
+	if tk, err := cred.Token(ctx); err != nil || tk.AccessToken != "EXPIRING" {
+		t.Fatalf("expected EXPIRING token; got %v %v", tk, err)
+	}
+	select {
+	case tk := <-cached:
+		if tk.AccessToken != "ISSUED_ACCESS_TOKEN" {
+			t.Errorf("expected ISSUED_ACCESS_TOKEN cached; got %s", tk.AccessToken)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected background refresh")
+	}
+	if tk, err := cred.Token(ctx); err != nil || tk.AccessToken != "ISSUED_ACCESS_TOKEN" {
+		t.Errorf("expected ISSUED_ACCESS_TOKEN; got %v %v", tk, err)
+	}
+
+	// cache func runs without the credential locked
+	long := &oauth2.Token{AccessToken: "LONG", RefreshToken: "refresh", Expiry: time.Now().Add(9 * time.Hour)}
+	if cred, err = cfg.Credential(long, u); err != nil {
+		t.Fatalf("expected credential; got %v", err)
+	}
+	cred.SetCacheFunc(func(cx context.Context, tk oauth2.Token, ui esign.UserInfo) {
+		_, _ = cred.Token(cx)
+		cached <- tk
+	})
+	cred.SetBackgroundRefresh(10*time.Hour, func(err error) {
+		errs <- err
+	})
+	// refreshed token expires before LONG and is discarded
+	testTransport.Add(refreshResponseTest, &testutils.RequestTester{
+		Path:     "/oauth/token",
+		Response: testutils.MakeResponse(400, []byte(`{"error":"invalid_grant"}`), nil),
+	})
+	// the second refresh starts once the first completes, then fails
+	deadline := time.Now().Add(time.Second)
+	for done := false; !done; {
+		if tk, err := cred.Token(ctx); err != nil || tk.AccessToken != "LONG" {
+			t.Fatalf("expected LONG token; got %v %v", tk, err)
+		}
+		select {
+		case <-errs:
+			done = true
+		case <-cached:
+			t.Fatalf("expected earlier expiring token to be discarded")
+		default:
+			if time.Now().After(deadline) {
+				t.Fatalf("expected second refresh")
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	// refreshed token with a later expiry is cached without deadlock
+	short := &oauth2.Token{AccessToken: "SHORT", RefreshToken: "refresh", Expiry: time.Now().Add(time.Minute)}
+	if cred, err = cfg.Credential(short, u); err != nil {
+		t.Fatalf("expected credential; got %v", err)
+	}
+	cred.SetCacheFunc(func(cx context.Context, tk oauth2.Token, ui esign.UserInfo) {
+		_, _ = cred.Token(cx)
+		cached <- tk
+	})
+	cred.SetBackgroundRefresh(5*time.Minute, nil)
+	testTransport.Add(refreshResponseTest)
+	if _, err := cred.Token(ctx); err != nil {
+		t.Fatalf("expected token; got %v", err)
+	}
+	select {
+	case tk := <-cached:
+		if tk.AccessToken != "ISSUED_ACCESS_TOKEN" {
+			t.Errorf("expected ISSUED_ACCESS_TOKEN cached; got %s", tk.AccessToken)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected cache func to call Token without deadlock")
+	}
+}
+
+// blockingStore blocks Save until release is closed.
+type blockingStore struct {
+	esign.MemoryTokenStore
+	saving  chan struct{}
+	release chan struct{}
+}
+
+func (s *blockingStore) Save(ctx context.Context, key esign.TokenKey, tk *oauth2.Token, u *esign.UserInfo) error {
+	s.saving <- struct{}{}
+	<-s.release
+	return s.MemoryTokenStore.Save(ctx, key, tk, u)
+}
+
+func TestOAuth2Credential_TokenUnlockedUpdate(t *testing.T) {
+	ctx := context.Background()
+	cfg, testTransport := getOAuth2ConfigTransport()
+	var u *esign.UserInfo
+	if err := json.Unmarshal([]byte(userInfoSuccessResponse), &u); err != nil {
+		t.Fatalf("unmarshal userinfo: %v", err)
+	}
+	store := &blockingStore{saving: make(chan struct{}), release: make(chan struct{})}
+	cfg.TokenStore = store
+	expired := &oauth2.Token{AccessToken: "EXPIRED", RefreshToken: "refresh", Expiry: time.Now().Add(-time.Minute)}
+	cred, err := cfg.Credential(expired, u)
+	if err != nil {
+		t.Fatalf("expected credential; got %v", err)
+	}
+	cached := make(chan string, 1)
+	cred.SetCacheFunc(func(cx context.Context, tk oauth2.Token, ui esign.UserInfo) {
+		// calling back into the credential must not deadlock
+		if tk2, err := cred.Token(cx); err == nil {
+			cached <- tk2.AccessToken
+		}
+	})
+	testTransport.Add(refreshResponseTest)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := cred.Token(ctx)
+		done <- err
+	}()
+	select {
+	case tk := <-cached:
+		if tk != "ISSUED_ACCESS_TOKEN" {
+			t.Errorf("expected ISSUED_ACCESS_TOKEN from cache func; got %s", tk)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected cache func to call Token without deadlock")
+	}
+	select {
+	case <-store.saving:
+	case <-time.After(time.Second):
+		t.Fatalf("expected token saved")
+	}
+	// a blocked store does not stall other callers
+	other := make(chan error, 1)
+	go func() {
+		_, err := cred.Token(ctx)
+		other <- err
+	}()
+	select {
+	case err = <-other:
+		if err != nil {
+			t.Errorf("expected token; got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("expected Token to return while the store is blocked")
+	}
+	close(store.release)
+	if err = <-done; err != nil {
+		t.Errorf("expected refreshed token; got %v", err)
+	}
+}
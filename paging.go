@@ -0,0 +1,126 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+// paging.go contains the helpers used by the generated
+// Pages funcs to iterate through list results.
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// ErrStopPaging may be returned by a Pages callback to end
+// paging without Pages returning an error.
+var ErrStopPaging = errors.New("stop paging")
+
+// ErrPageRepeated is returned by Pages when a result's paging values
+// would request the same page again.
+var ErrPageRepeated = errors.New("next page repeats the current page")
+
+// PageInfo contains the paging values of a list result.  The
+// generated Pages funcs fill a PageInfo from each result to
+// determine the query parameters for the next call.  Empty
+// values indicate that the result does not contain the field.
+type PageInfo struct {
+	StartPosition     string
+	EndPosition       string
+	ResultSetSize     string
+	TotalSetSize      string
+	ContinuationToken string
+	// NextURI is nil if the result does not have a nextUri field
+	NextURI *string
+	// Page based results
+	Page           string
+	PagesRemaining string
+	PageSize       string
+	TotalCount     string
+}
+
+// SetNext updates q with the parameters needed to retrieve the
+// following page and returns false when no pages remain. startParam,
+// tokenParam and pageParam are the names of the op's start position,
+// continuation token and page number query parameters and may be
+// blank if the op does not have the parameter.  ErrPageRepeated is
+// returned if the next page's parameter equals the current value.
+func (p PageInfo) SetNext(q url.Values, startParam, tokenParam, pageParam string) (bool, error) {
+	resultSetSize, hasResultSize := pageInt(p.ResultSetSize)
+	if hasResultSize && resultSetSize == 0 {
+		return false, nil
+	}
+	switch {
+	case tokenParam > "" && p.ContinuationToken > "":
+		if startParam > "" {
+			q.Del(startParam)
+		}
+		return setNextParam(q, tokenParam, p.ContinuationToken)
+	case pageParam > "":
+		return p.setNextPage(q, pageParam)
+	case startParam > "":
+		return p.setNextStart(q, startParam, resultSetSize, hasResultSize)
+	}
+	return false, nil
+}
+
+func (p PageInfo) setNextPage(q url.Values, pageParam string) (bool, error) {
+	page, ok := pageInt(p.Page)
+	if !ok {
+		return false, nil
+	}
+	if remaining, ok := pageInt(p.PagesRemaining); ok {
+		if remaining < 1 {
+			return false, nil
+		}
+	} else {
+		pageSize, hasSize := pageInt(p.PageSize)
+		total, hasTotal := pageInt(p.TotalCount)
+		if !hasSize || !hasTotal || pageSize < 1 || page*pageSize >= total {
+			return false, nil
+		}
+	}
+	return setNextParam(q, pageParam, strconv.FormatInt(page+1, 10))
+}
+
+func (p PageInfo) setNextStart(q url.Values, startParam string, resultSetSize int64, hasResultSize bool) (bool, error) {
+	if p.NextURI != nil && *p.NextURI == "" {
+		return false, nil
+	}
+	var next int64
+	if end, ok := pageInt(p.EndPosition); ok {
+		next = end + 1
+	} else if start, ok := pageInt(p.StartPosition); ok && hasResultSize {
+		next = start + resultSetSize
+	} else {
+		return false, nil
+	}
+	// a zero total indicates an unpopulated numeric field
+	if total, ok := pageInt(p.TotalSetSize); ok && total > 0 && next >= total {
+		return false, nil
+	}
+	return setNextParam(q, startParam, strconv.FormatInt(next, 10))
+}
+
+// setNextParam sets the paging parameter returning ErrPageRepeated
+// if the value is unchanged to prevent an endless loop.
+func setNextParam(q url.Values, param, val string) (bool, error) {
+	if q.Get(param) == val {
+		return false, fmt.Errorf("%w: %s=%s", ErrPageRepeated, param, val)
+	}
+	q.Set(param, val)
+	return true, nil
+}
+
+// pageInt converts a paging value returning false if blank
+// or invalid.
+func pageInt(s string) (int64, bool) {
+	if s == "" {
+		return 0, false
+	}
+	i, err := strconv.ParseInt(s, 10, 64)
+	return i, err == nil
+}
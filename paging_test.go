@@ -0,0 +1,159 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/v2.1/envelopes"
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+func TestPageInfo_SetNext(t *testing.T) {
+	blank := ""
+	next := "/next"
+	tests := []struct {
+		name      string
+		pg        esign.PageInfo
+		start     string
+		token     string
+		page      string
+		q         url.Values
+		wantNext  bool
+		wantErr   bool
+		wantParam string
+		wantValue string
+	}{
+		{name: "end position", pg: esign.PageInfo{StartPosition: "0", EndPosition: "99", ResultSetSize: "100", TotalSetSize: "250"},
+			start: "start_position", wantNext: true, wantParam: "start_position", wantValue: "100"},
+		{name: "last page", pg: esign.PageInfo{StartPosition: "200", EndPosition: "249", ResultSetSize: "50", TotalSetSize: "250"},
+			start: "start_position"},
+		{name: "empty result", pg: esign.PageInfo{StartPosition: "0", EndPosition: "0", ResultSetSize: "0"},
+			start: "start_position"},
+		{name: "start and size", pg: esign.PageInfo{StartPosition: "10", ResultSetSize: "10"},
+			start: "startPosition", wantNext: true, wantParam: "startPosition", wantValue: "20"},
+		{name: "blank next uri", pg: esign.PageInfo{StartPosition: "0", EndPosition: "9", ResultSetSize: "10", NextURI: &blank},
+			start: "start"},
+		{name: "next uri", pg: esign.PageInfo{StartPosition: "0", EndPosition: "9", ResultSetSize: "10", TotalSetSize: "0", NextURI: &next},
+			start: "start", wantNext: true, wantParam: "start", wantValue: "10"},
+		{name: "continuation token", pg: esign.PageInfo{StartPosition: "0", EndPosition: "9", ResultSetSize: "10", ContinuationToken: "ABC"},
+			start: "start_position", token: "continuation_token", wantNext: true, wantParam: "continuation_token", wantValue: "ABC"},
+		{name: "token clears start", pg: esign.PageInfo{StartPosition: "10", EndPosition: "19", ResultSetSize: "10", ContinuationToken: "DEF"},
+			start: "start_position", token: "continuation_token", q: url.Values{"start_position": {"10"}, "continuation_token": {"ABC"}},
+			wantNext: true, wantParam: "start_position", wantValue: ""},
+		{name: "repeated token", pg: esign.PageInfo{ContinuationToken: "ABC"},
+			token: "continuation_token", q: url.Values{"continuation_token": {"ABC"}}, wantErr: true},
+		{name: "repeated start", pg: esign.PageInfo{StartPosition: "0", EndPosition: "9", ResultSetSize: "10", TotalSetSize: "50"},
+			start: "start_position", q: url.Values{"start_position": {"10"}}, wantErr: true},
+		{name: "repeated page", pg: esign.PageInfo{Page: "1", PagesRemaining: "2"},
+			page: "page", q: url.Values{"page": {"2"}}, wantErr: true},
+		{name: "pages remaining", pg: esign.PageInfo{Page: "1", PagesRemaining: "2"},
+			page: "page", wantNext: true, wantParam: "page", wantValue: "2"},
+		{name: "no pages remaining", pg: esign.PageInfo{Page: "3", PagesRemaining: "0"},
+			page: "page"},
+		{name: "page size", pg: esign.PageInfo{Page: "1", PageSize: "20", TotalCount: "30"},
+			page: "page", wantNext: true, wantParam: "page", wantValue: "2"},
+		{name: "page size last", pg: esign.PageInfo{Page: "2", PageSize: "20", TotalCount: "30"},
+			page: "page"},
+		{name: "no params", pg: esign.PageInfo{StartPosition: "0", EndPosition: "9"}},
+	}
+	for _, tt := range tests {
+		q := tt.q
+		if q == nil {
+			q = make(url.Values)
+		}
+		got, err := tt.pg.SetNext(q, tt.start, tt.token, tt.page)
+		if tt.wantErr != errors.Is(err, esign.ErrPageRepeated) {
+			t.Errorf("%s: expected ErrPageRepeated %v; got %v", tt.name, tt.wantErr, err)
+			continue
+		}
+		if got != tt.wantNext {
+			t.Errorf("%s: expected %v; got %v", tt.name, tt.wantNext, got)
+			continue
+		}
+		if tt.wantParam > "" && q.Get(tt.wantParam) != tt.wantValue {
+			t.Errorf("%s: expected %s=%s; got %v", tt.name, tt.wantParam, tt.wantValue, q)
+		}
+	}
+}
+
+// pageCred returns envelope status pages of 2 envelopes each
+type pageCred struct {
+	total  int
+	starts []string
+	// repeat returns the first page for every request
+	repeat bool
+}
+
+func (pc *pageCred) AuthDo(ctx context.Context, op *esign.Op) (*http.Response, error) {
+	start := op.QueryOpts.Get("start_position")
+	pc.starts = append(pc.starts, start)
+	var pos int
+	if !pc.repeat {
+		fmt.Sscan(start, &pos)
+	}
+	end := pos + 1
+	if end >= pc.total {
+		end = pc.total - 1
+	}
+	body := fmt.Sprintf(`{"startPosition":"%d","endPosition":"%d","resultSetSize":"%d","totalSetSize":"%d"}`,
+		pos, end, end-pos+1, pc.total)
+	return &http.Response{
+		StatusCode: 200,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}, nil
+}
+
+func TestOp_Pages(t *testing.T) {
+	ctx := context.Background()
+	pc := &pageCred{total: 5}
+	op := envelopes.New(pc).ListStatusChanges().FromDate(time.Now().AddDate(0, 0, -7))
+	var cnt int
+	if err := op.Pages(ctx, func(res *model.EnvelopesInformation) error {
+		cnt++
+		return nil
+	}); err != nil {
+		t.Fatalf("expected success; got %v", err)
+	}
+	if cnt != 3 || strings.Join(pc.starts, ",") != ",2,4" {
+		t.Errorf("expected 3 pages starting at ,2,4; got %d %v", cnt, pc.starts)
+	}
+	if op.QueryOpts.Get("start_position") != "" {
+		t.Errorf("expected original op to be unchanged; got %v", op.QueryOpts)
+	}
+
+	pc.starts = nil
+	if err := op.Pages(ctx, func(res *model.EnvelopesInformation) error {
+		return esign.ErrStopPaging
+	}); err != nil || len(pc.starts) != 1 {
+		t.Errorf("expected stop after 1 page; got %v %v", err, pc.starts)
+	}
+
+	errTest := errors.New("test error")
+	if err := op.Pages(ctx, func(res *model.EnvelopesInformation) error {
+		return errTest
+	}); err != errTest {
+		t.Errorf("expected test error; got %v", err)
+	}
+
+	// a server ignoring the start position must not loop forever
+	pc.starts, pc.repeat = nil, true
+	if err := op.Pages(ctx, func(res *model.EnvelopesInformation) error {
+		return nil
+	}); !errors.Is(err, esign.ErrPageRepeated) || len(pc.starts) != 2 {
+		t.Errorf("expected ErrPageRepeated after 2 pages; got %v %v", err, pc.starts)
+	}
+}
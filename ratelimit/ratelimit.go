@@ -3,8 +3,9 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// Package ratelimit provides tools for reporting on DocuSign's rate limits.  Documentation
-// may be found at https://developers.docusign.com/docs/esign-soap-api/esign101/security/call-limits/
+// Package ratelimit provides tools for reporting on and throttling calls to stay within
+// DocuSign's rate limits.  Documentation may be found at
+// https://developers.docusign.com/docs/esign-soap-api/esign101/security/call-limits/
 package ratelimit
 
 import (
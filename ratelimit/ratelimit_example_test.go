@@ -7,6 +7,7 @@ package ratelimit_test
 
 import (
 	"context"
+	"errors"
 	"log"
 	"net/http"
 	"sync"
@@ -65,6 +66,28 @@ func ExampleCredential_handler() {
 	}
 }
 
+// share a single Throttle across all goroutines calling the account
+var globalThrottle = &ratelimit.Throttle{
+	RateThreshold:  500,
+	BurstThreshold: 10,
+	MaxWait:        15 * time.Minute,
+}
+
+func ExampleThrottle() {
+	ctx := context.TODO()
+	apiUserID := "78e5a047-f767-41f8-8dbd-10e3eed65c55"
+	cred, err := getCredential(ctx, apiUserID)
+	if err != nil {
+		log.Fatalf("credential error: %v", err)
+	}
+	sv := envelopes.New(globalThrottle.Credential(cred, "c23357a7-4f00-47f5-8802-94d2b1fb9a29"))
+	_, err = sv.ListStatusChanges().EnvelopeIds("F1", "F2").Do(ctx)
+	var throttleErr *ratelimit.ThrottleError
+	if errors.As(err, &throttleErr) {
+		log.Printf("calls unavailable until %s", throttleErr.Until.Format("15:04:05"))
+	}
+}
+
 var globalRateLimitHandler = &RLHandler{}
 
 type RLHandler struct {
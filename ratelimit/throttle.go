@@ -0,0 +1,246 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/jfcote87/esign"
+)
+
+// DefaultBurstWindow is the period DocuSign uses to count burst calls.
+const DefaultBurstWindow = 30 * time.Second
+
+// DefaultThreshold is used for a Throttle's RateThreshold and
+// BurstThreshold when zero, delaying calls only after the remaining
+// calls are exhausted.
+const DefaultThreshold = 1
+
+// probeInterval is the time calls wait for the report of a call
+// released after a reset before another call is released.
+const probeInterval = time.Second
+
+// ThrottleError is returned by a ThrottleCredential when the wait for
+// available calls exceeds the context deadline or the Throttle's MaxWait.
+type ThrottleError struct {
+	AccountID string
+	// Report is the most recent report for the account
+	Report Report
+	// Until is the time when the call could have been sent
+	Until time.Time
+	// Err is the context error or nil if MaxWait was exceeded
+	Err error
+}
+
+// Error fulfills error interface
+func (e *ThrottleError) Error() string {
+	msg := fmt.Sprintf("ratelimit: account %q throttled until %s", e.AccountID, e.Until.Format(time.RFC3339))
+	if e.Err != nil {
+		return msg + ": " + e.Err.Error()
+	}
+	return msg
+}
+
+// Unwrap returns the context error
+func (e *ThrottleError) Unwrap() error {
+	return e.Err
+}
+
+// Throttle keeps the latest rate limit report for each account and delays
+// calls once the remaining hourly or burst calls drop below the thresholds.
+// Once the reset time or burst window passes, a single call is released
+// and other calls wait until its response updates the report.  A Throttle
+// is safe for concurrent use and should be shared by all credentials
+// calling the same account.
+type Throttle struct {
+	// RateThreshold delays calls until the hourly reset time when
+	// RateRemaining falls below this value.  Zero uses DefaultThreshold.
+	RateThreshold int64
+	// BurstThreshold delays calls for the BurstWindow when BurstRemaining
+	// falls below this value.  Zero uses DefaultThreshold.
+	BurstThreshold int64
+	// BurstWindow is the period, starting when the latest report was
+	// received, that calls are delayed once BurstRemaining falls below
+	// BurstThreshold.  Zero uses DefaultBurstWindow.
+	BurstWindow time.Duration
+	// MaxWait, if positive, returns a *ThrottleError immediately rather than
+	// waiting longer than MaxWait.
+	MaxWait time.Duration
+
+	mu       sync.Mutex
+	accounts map[string]*accountState
+}
+
+type accountState struct {
+	rpt      Report
+	received time.Time
+	// hasBurst is true when the report contained burst limit values
+	hasBurst bool
+	// probeUntil is the time until which calls wait for the report
+	// of the call released after a reset
+	probeUntil time.Time
+	// updated is closed when a new report replaces the state
+	updated chan struct{}
+}
+
+// Credential returns a ThrottleCredential that uses t to delay calls
+// for accountID.
+func (t *Throttle) Credential(cred esign.Credential, accountID string) *ThrottleCredential {
+	return &ThrottleCredential{
+		Credential: cred,
+		Throttle:   t,
+		AccountID:  accountID,
+	}
+}
+
+// Report returns a copy of the latest report for the account.  Remaining
+// values are decremented for each call sent since the report was received.
+func (t *Throttle) Report(accountID string) *Report {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if st, ok := t.accounts[accountID]; ok {
+		rpt := st.rpt
+		return &rpt
+	}
+	return nil
+}
+
+// Update stores rpt as the latest report for accountID.  Empty reports
+// are ignored.
+func (t *Throttle) Update(accountID string, rpt *Report) {
+	if rpt == nil || rpt.IsEmpty() {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.accounts == nil {
+		t.accounts = make(map[string]*accountState)
+	}
+	if st, ok := t.accounts[accountID]; ok {
+		// wake calls waiting for a refreshed report
+		close(st.updated)
+	}
+	t.accounts[accountID] = &accountState{
+		rpt:      *rpt,
+		received: time.Now(),
+		hasBurst: rpt.BurstLimit > 0 || rpt.BurstRemaining > 0,
+		updated:  make(chan struct{}),
+	}
+}
+
+// Wait blocks until a call to accountID is allowed.  A *ThrottleError is
+// returned if ctx is done before the wait completes or if the wait exceeds MaxWait.
+func (t *Throttle) Wait(ctx context.Context, accountID string) error {
+	for {
+		until, rpt, updated := t.reserve(accountID)
+		if until.IsZero() {
+			return nil
+		}
+		wait := time.Until(until)
+		if t.MaxWait > 0 && wait > t.MaxWait {
+			return &ThrottleError{AccountID: accountID, Report: rpt, Until: until}
+		}
+		if dl, ok := ctx.Deadline(); ok && dl.Before(until) {
+			return &ThrottleError{AccountID: accountID, Report: rpt, Until: until, Err: context.DeadlineExceeded}
+		}
+		tm := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			tm.Stop()
+			return &ThrottleError{AccountID: accountID, Report: rpt, Until: until, Err: ctx.Err()}
+		case <-tm.C:
+		case <-updated:
+			tm.Stop()
+		}
+	}
+}
+
+// reserve returns the time to wait until or, if no wait is needed,
+// a zero time after decrementing the remaining counts.  The returned
+// channel is closed when the account's report is updated.
+func (t *Throttle) reserve(accountID string) (time.Time, Report, <-chan struct{}) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	st, ok := t.accounts[accountID]
+	if !ok {
+		return time.Time{}, Report{}, nil
+	}
+	now := time.Now()
+	rateLow := st.rpt.RateRemaining < threshold(t.RateThreshold)
+	if resetAt := st.rpt.ResetAt(); rateLow && now.Before(resetAt) {
+		return resetAt, st.rpt, st.updated
+	}
+	burstLow := st.hasBurst && st.rpt.BurstRemaining < threshold(t.BurstThreshold)
+	if burstEnd := st.received.Add(t.burstWindow()); burstLow && now.Before(burstEnd) {
+		return burstEnd, st.rpt, st.updated
+	}
+	if rateLow || burstLow {
+		// the report's remaining counts are stale after a reset, so
+		// release one call and wait for its report
+		if now.Before(st.probeUntil) {
+			return st.probeUntil, st.rpt, st.updated
+		}
+		st.probeUntil = now.Add(probeInterval)
+		return time.Time{}, st.rpt, nil
+	}
+	if st.rpt.RateRemaining > 0 {
+		st.rpt.RateRemaining--
+	}
+	if st.rpt.BurstRemaining > 0 {
+		st.rpt.BurstRemaining--
+	}
+	return time.Time{}, st.rpt, nil
+}
+
+// burstWindow returns BurstWindow or DefaultBurstWindow when not set.
+func (t *Throttle) burstWindow() time.Duration {
+	if t.BurstWindow <= 0 {
+		return DefaultBurstWindow
+	}
+	return t.BurstWindow
+}
+
+// threshold returns v or DefaultThreshold when v is not positive.
+func threshold(v int64) int64 {
+	if v <= 0 {
+		return DefaultThreshold
+	}
+	return v
+}
+
+// ThrottleCredential delays calls using the Throttle's shared state for
+// AccountID and updates the state from each response's rate limit headers.
+type ThrottleCredential struct {
+	esign.Credential
+	Throttle  *Throttle
+	AccountID string
+}
+
+// AuthDo waits for the Throttle to allow the call, sends the request via the
+// child credential and records the response's rate limit report.
+func (tc *ThrottleCredential) AuthDo(ctx context.Context, op *esign.Op) (*http.Response, error) {
+	if tc == nil || tc.Credential == nil || tc.Throttle == nil {
+		return nil, fmt.Errorf("throttlecredential no child credential or throttle specified")
+	}
+	if err := tc.Throttle.Wait(ctx, tc.AccountID); err != nil {
+		return nil, err
+	}
+	res, err := tc.Credential.AuthDo(ctx, op)
+	if err != nil {
+		var re *esign.ResponseError
+		if errors.As(err, &re) && re.Header != nil {
+			tc.Throttle.Update(tc.AccountID, New(re.Header))
+		}
+		return nil, err
+	}
+	tc.Throttle.Update(tc.AccountID, New(res.Header))
+	return res, nil
+}
@@ -0,0 +1,239 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ratelimit_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/ratelimit"
+)
+
+// headerCred returns responses containing the rate limit headers
+// set by remaining and burst.
+type headerCred struct {
+	m         sync.Mutex
+	calls     int
+	remaining int64
+	burst     int64
+	reset     time.Time
+	status    int
+}
+
+func (hc *headerCred) AuthDo(ctx context.Context, op *esign.Op) (*http.Response, error) {
+	hc.m.Lock()
+	defer hc.m.Unlock()
+	hc.calls++
+	hdr := http.Header{
+		"X-Ratelimit-Limit":      {"1000"},
+		"X-Ratelimit-Remaining":  {strconv.FormatInt(hc.remaining, 10)},
+		"X-Ratelimit-Reset":      {strconv.FormatInt(hc.reset.Unix(), 10)},
+		"X-Burstlimit-Limit":     {"100"},
+		"X-Burstlimit-Remaining": {strconv.FormatInt(hc.burst, 10)},
+	}
+	if hc.status > 299 {
+		re := esign.NewResponseError([]byte(`{"errorCode":"HOURLY_APIINVOCATION_LIMIT_EXCEEDED"}`), hc.status)
+		re.Header = hdr
+		return nil, re
+	}
+	return &http.Response{
+		StatusCode: 200,
+		Header:     hdr,
+		Body:       ioutil.NopCloser(strings.NewReader("{}")),
+	}, nil
+}
+
+func TestThrottleCredential_AuthDo(t *testing.T) {
+	ctx := context.Background()
+	hc := &headerCred{remaining: 100, burst: 50, reset: time.Now().Add(time.Hour)}
+	throttle := &ratelimit.Throttle{RateThreshold: 10, BurstThreshold: 5}
+	cred := throttle.Credential(hc, "ACCT")
+	op := &esign.Op{Method: "GET", Path: "a"}
+
+	res, err := cred.AuthDo(ctx, op)
+	if err != nil {
+		t.Fatalf("expected success; got %v", err)
+	}
+	res.Body.Close()
+	if rpt := throttle.Report("ACCT"); rpt == nil || rpt.RateRemaining != 100 || rpt.BurstRemaining != 50 {
+		t.Fatalf("expected report with 100 remaining and 50 burst; got %#v", rpt)
+	}
+	if throttle.Report("OTHER") != nil {
+		t.Errorf("expected nil report for OTHER account")
+	}
+
+	// hourly limit reached returns ThrottleError when past context deadline
+	hc.remaining = 5
+	if res, err = cred.AuthDo(ctx, op); err != nil {
+		t.Fatalf("expected success; got %v", err)
+	}
+	res.Body.Close()
+	tctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = cred.AuthDo(tctx, op)
+	var throttleErr *ratelimit.ThrottleError
+	if !errors.As(err, &throttleErr) {
+		t.Fatalf("expected *ThrottleError; got %v", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) || throttleErr.AccountID != "ACCT" || throttleErr.Until.Unix() != hc.reset.Unix() {
+		t.Errorf("expected deadline exceeded for ACCT until %v; got %v", hc.reset, throttleErr)
+	}
+	if hc.calls != 2 {
+		t.Errorf("expected 2 calls; got %d", hc.calls)
+	}
+
+	// other accounts are unaffected
+	if res, err = throttle.Credential(hc, "ACCT2").AuthDo(ctx, op); err != nil {
+		t.Fatalf("expected success for ACCT2; got %v", err)
+	}
+	res.Body.Close()
+
+	// MaxWait
+	throttle.MaxWait = time.Minute
+	if _, err = cred.AuthDo(ctx, op); !errors.As(err, &throttleErr) || throttleErr.Err != nil {
+		t.Errorf("expected MaxWait *ThrottleError; got %v", err)
+	}
+
+	// error responses update report
+	hc.status = 429
+	hc.remaining = 0
+	throttle.Update("ACCT3", &ratelimit.Report{RateRemaining: 1000, BurstRemaining: 100})
+	cred3 := throttle.Credential(hc, "ACCT3")
+	if _, err = cred3.AuthDo(ctx, op); err == nil {
+		t.Fatalf("expected 429 error; got success")
+	}
+	if rpt := throttle.Report("ACCT3"); rpt == nil || rpt.RateRemaining != 0 {
+		t.Errorf("expected report with 0 remaining; got %#v", rpt)
+	}
+
+	if _, err = (&ratelimit.ThrottleCredential{}).AuthDo(ctx, op); err == nil {
+		t.Errorf("expected no child credential error; got success")
+	}
+}
+
+func TestThrottle_Wait(t *testing.T) {
+	ctx := context.Background()
+	throttle := &ratelimit.Throttle{BurstThreshold: 5, BurstWindow: 100 * time.Millisecond}
+	throttle.Update("A", ratelimit.New(http.Header{
+		"X-Ratelimit-Remaining":  {"1000"},
+		"X-Burstlimit-Remaining": {"8"},
+	}))
+	// concurrent waits decrement shared burst count
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := throttle.Wait(ctx, "A"); err != nil {
+				t.Errorf("expected no wait; got %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if rpt := throttle.Report("A"); rpt.BurstRemaining != 5 || rpt.RateRemaining != 997 {
+		t.Errorf("expected burst remaining 5 and rate remaining 997; got %d %d", rpt.BurstRemaining, rpt.RateRemaining)
+	}
+
+	throttle.Update("A", ratelimit.New(http.Header{
+		"X-Ratelimit-Remaining":  {"1000"},
+		"X-Burstlimit-Remaining": {"4"},
+	}))
+	start := time.Now()
+	if err := throttle.Wait(ctx, "A"); err != nil {
+		t.Fatalf("expected successful wait; got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("expected wait for burst window; waited %v", elapsed)
+	}
+}
+
+func TestThrottle_DefaultThresholds(t *testing.T) {
+	ctx := context.Background()
+	throttle := &ratelimit.Throttle{BurstWindow: 100 * time.Millisecond, MaxWait: time.Minute}
+	throttle.Update("A", ratelimit.New(http.Header{
+		"X-Ratelimit-Remaining":  {"1000"},
+		"X-Burstlimit-Limit":     {"100"},
+		"X-Burstlimit-Remaining": {"1"},
+	}))
+	if err := throttle.Wait(ctx, "A"); err != nil {
+		t.Fatalf("expected no wait for last burst call; got %v", err)
+	}
+	start := time.Now()
+	if err := throttle.Wait(ctx, "A"); err != nil {
+		t.Fatalf("expected successful wait; got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond || elapsed > time.Second {
+		t.Errorf("expected wait for 100ms burst window; waited %v", elapsed)
+	}
+
+	// exhausted hourly calls wait for the reset time
+	reset := time.Now().Add(time.Hour)
+	throttle.Update("B", ratelimit.New(http.Header{
+		"X-Ratelimit-Remaining": {"0"},
+		"X-Ratelimit-Reset":     {strconv.FormatInt(reset.Unix(), 10)},
+	}))
+	var throttleErr *ratelimit.ThrottleError
+	if err := throttle.Wait(ctx, "B"); !errors.As(err, &throttleErr) || throttleErr.Until.Unix() != reset.Unix() {
+		t.Errorf("expected MaxWait *ThrottleError until %v; got %v", reset, err)
+	}
+
+	// reports without burst values do not wait
+	throttle.Update("C", ratelimit.New(http.Header{"X-Ratelimit-Remaining": {"1000"}}))
+	start = time.Now()
+	if err := throttle.Wait(ctx, "C"); err != nil || time.Since(start) > 50*time.Millisecond {
+		t.Errorf("expected no wait; got %v after %v", err, time.Since(start))
+	}
+}
+
+func TestThrottle_WaitAfterReset(t *testing.T) {
+	ctx := context.Background()
+	throttle := &ratelimit.Throttle{}
+	throttle.Update("A", ratelimit.New(http.Header{
+		"X-Ratelimit-Limit":     {"1000"},
+		"X-Ratelimit-Remaining": {"0"},
+		"X-Ratelimit-Reset":     {strconv.FormatInt(time.Now().Add(-time.Second).Unix(), 10)},
+	}))
+	// once the reset time passes, a single call proceeds and the
+	// others wait for its report
+	var proceeded int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := throttle.Wait(ctx, "A"); err != nil {
+				t.Errorf("expected successful wait; got %v", err)
+			}
+			atomic.AddInt32(&proceeded, 1)
+		}()
+	}
+	time.Sleep(100 * time.Millisecond)
+	if n := atomic.LoadInt32(&proceeded); n != ratelimit.DefaultThreshold {
+		t.Errorf("expected %d call after reset; got %d", ratelimit.DefaultThreshold, n)
+	}
+	start := time.Now()
+	throttle.Update("A", ratelimit.New(http.Header{
+		"X-Ratelimit-Limit":     {"1000"},
+		"X-Ratelimit-Remaining": {"999"},
+		"X-Ratelimit-Reset":     {strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10)},
+	}))
+	wg.Wait()
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expected waiting calls to proceed on update; waited %v", elapsed)
+	}
+	if rpt := throttle.Report("A"); rpt.RateRemaining != 990 {
+		t.Errorf("expected rate remaining 990; got %d", rpt.RateRemaining)
+	}
+}
@@ -0,0 +1,293 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package retry provides an esign.Credential that resends operations
+// failing with transient errors (429 and 5xx responses) using
+// exponential backoff with jitter.  Documentation on DocuSign's
+// rate limits may be found at
+// https://developers.docusign.com/docs/esign-rest-api/esign101/rules-and-limits/
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/ratelimit"
+)
+
+// default values used when Credential fields are zero
+const (
+	DefaultMaxAttempts = 3
+	DefaultMinBackoff  = 500 * time.Millisecond
+	DefaultMaxBackoff  = 30 * time.Second
+)
+
+// ErrStaleAttempt is returned when an upload file is read by an
+// attempt that has already been abandoned.
+var ErrStaleAttempt = errors.New("retry: upload file read by stale attempt")
+
+// Credential wraps an existing credential and retries an op's AuthDo
+// call when ShouldRetry reports the error as transient.
+//
+// Ops whose UploadFiles are not io.Seekers are sent only once as their
+// contents may not be replayed.  Seekable files are rewound to their
+// starting offset before each attempt and are closed after the final
+// attempt.
+type Credential struct {
+	esign.Credential
+	// MaxAttempts is the total number of tries including the first call.
+	// DefaultMaxAttempts is used when zero.
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry.  The delay doubles for
+	// each subsequent retry.  DefaultMinBackoff is used when zero.
+	MinBackoff time.Duration
+	// MaxBackoff caps the computed delay.  If the server asks for a longer wait
+	// via Retry-After or X-RateLimit-Reset, the error is returned without retrying.
+	// DefaultMaxBackoff is used when zero.
+	MaxBackoff time.Duration
+	// ShouldRetry determines whether an error is transient.  IsTransient is
+	// used when nil.
+	ShouldRetry func(error) bool
+}
+
+// AuthDo sends the op via the child credential, waiting and resending
+// the request on transient failures.
+func (c *Credential) AuthDo(ctx context.Context, op *esign.Op) (*http.Response, error) {
+	if c == nil || c.Credential == nil {
+		return nil, fmt.Errorf("retrycredential no child credential specified")
+	}
+	attemptOp, replayFiles, ok := newReplayOp(op)
+	if !ok {
+		return c.Credential.AuthDo(ctx, op)
+	}
+	defer replayFiles.close()
+
+	maxAttempts := c.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = DefaultMaxAttempts
+	}
+	for attempt := 1; ; attempt++ {
+		if err := replayFiles.rewind(attemptOp); err != nil {
+			return nil, err
+		}
+		res, err := c.Credential.AuthDo(ctx, attemptOp)
+		if err == nil || attempt >= maxAttempts || !c.shouldRetry(err) {
+			return res, err
+		}
+		delay, ok := c.delay(attempt, err)
+		if !ok {
+			return nil, err
+		}
+		tm := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			tm.Stop()
+			return nil, ctx.Err()
+		case <-tm.C:
+		}
+	}
+}
+
+func (c *Credential) shouldRetry(err error) bool {
+	if c.ShouldRetry != nil {
+		return c.ShouldRetry(err)
+	}
+	return IsTransient(err)
+}
+
+// delay calculates the wait before the next attempt.  A false return
+// indicates the server requested a wait longer than MaxBackoff.
+func (c *Credential) delay(attempt int, err error) (time.Duration, bool) {
+	minBackoff, maxBackoff := c.MinBackoff, c.MaxBackoff
+	if minBackoff <= 0 {
+		minBackoff = DefaultMinBackoff
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultMaxBackoff
+	}
+	if wait, ok := serverDelay(err); ok {
+		return wait, wait <= maxBackoff
+	}
+	backoff := minBackoff
+	for i := 1; i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	// jitter between half and full backoff
+	half := int64(backoff / 2)
+	return time.Duration(half + rand.Int63n(half+1)), true
+}
+
+// serverDelay returns the wait requested by DocuSign via the
+// Retry-After header or, when the hourly limit is exhausted,
+// the X-RateLimit-Reset header.
+func serverDelay(err error) (time.Duration, bool) {
+	var re *esign.ResponseError
+	if !errors.As(err, &re) || re.Header == nil {
+		return 0, false
+	}
+	if ra := re.Header.Get("Retry-After"); ra > "" {
+		if secs, err := strconv.ParseInt(ra, 10, 64); err == nil {
+			return time.Duration(secs) * time.Second, true
+		}
+		if tm, err := http.ParseTime(ra); err == nil {
+			return nonNegative(time.Until(tm)), true
+		}
+	}
+	if re.Status == http.StatusTooManyRequests && re.Header.Get("X-RateLimit-Reset") > "" {
+		if rpt := ratelimit.New(re.Header); rpt.RateRemaining == 0 {
+			return nonNegative(time.Until(rpt.ResetAt())), true
+		}
+	}
+	return 0, false
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// IsTransient reports whether err is a rate limit, server unavailable
+// or network timeout error.
+func IsTransient(err error) bool {
+	return esign.IsRetryable(err)
+}
+
+// replayFile allows an UploadFile's reader to be read by multiple
+// attempts.  Each attempt receives a new reader; readers from earlier
+// attempts fail so that an abandoned multipart pipe may not advance
+// the underlying file.
+type replayFile struct {
+	mu     sync.Mutex
+	orig   *esign.UploadFile
+	rs     io.ReadSeeker
+	offset int64
+	gen    int
+}
+
+type attemptReader struct {
+	f   *replayFile
+	gen int
+}
+
+func (a *attemptReader) Read(b []byte) (int, error) {
+	a.f.mu.Lock()
+	defer a.f.mu.Unlock()
+	if a.gen != a.f.gen {
+		return 0, ErrStaleAttempt
+	}
+	return a.f.rs.Read(b)
+}
+
+// next seeks to the starting offset and returns a reader
+// for the new attempt.
+func (f *replayFile) next() (*esign.UploadFile, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.gen++
+	if f.gen > 1 {
+		if _, err := f.rs.Seek(f.offset, io.SeekStart); err != nil {
+			return nil, err
+		}
+	}
+	// attemptReader has no Close method so that the
+	// multipart writer may not close the original
+	return &esign.UploadFile{
+		ContentType: f.orig.ContentType,
+		FileName:    f.orig.FileName,
+		ID:          f.orig.ID,
+		Reader:      &attemptReader{f: f, gen: f.gen},
+	}, nil
+}
+
+type replayList struct {
+	payload *replayFile
+	files   []*replayFile
+}
+
+// newReplayOp returns a copy of op whose files may be rewound.  A false
+// return indicates that a file is not an io.Seeker.
+func newReplayOp(op *esign.Op) (*esign.Op, *replayList, bool) {
+	if op == nil {
+		return nil, nil, false
+	}
+	newOp := *op
+	rl := &replayList{}
+	var ok bool
+	if uf, isFile := op.Payload.(*esign.UploadFile); isFile {
+		if rl.payload, ok = newReplayFile(uf); !ok {
+			return nil, nil, false
+		}
+	}
+	for _, uf := range op.Files {
+		rf, ok := newReplayFile(uf)
+		if !ok {
+			return nil, nil, false
+		}
+		rl.files = append(rl.files, rf)
+	}
+	if len(rl.files) > 0 {
+		newOp.Files = make([]*esign.UploadFile, len(rl.files))
+	}
+	return &newOp, rl, true
+}
+
+func newReplayFile(uf *esign.UploadFile) (*replayFile, bool) {
+	if !uf.Valid() {
+		return nil, false
+	}
+	rs, ok := uf.Reader.(io.ReadSeeker)
+	if !ok {
+		return nil, false
+	}
+	offset, err := rs.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, false
+	}
+	return &replayFile{orig: uf, rs: rs, offset: offset}, true
+}
+
+// rewind sets op's Payload and Files to new readers
+// positioned at each file's starting offset.
+func (rl *replayList) rewind(op *esign.Op) error {
+	var err error
+	if rl.payload != nil {
+		if op.Payload, err = rl.payload.next(); err != nil {
+			return err
+		}
+	}
+	for i, f := range rl.files {
+		if op.Files[i], err = f.next(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// close invalidates outstanding readers and closes the original files.
+func (rl *replayList) close() {
+	all := rl.files
+	if rl.payload != nil {
+		all = append(all, rl.payload)
+	}
+	for _, f := range all {
+		f.mu.Lock()
+		f.gen++
+		f.mu.Unlock()
+		f.orig.Close()
+	}
+}
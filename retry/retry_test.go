@@ -0,0 +1,207 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package retry_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/retry"
+)
+
+// scriptCred returns the errors in order and records each request body.
+type scriptCred struct {
+	m      sync.Mutex
+	errs   []error
+	bodies []string
+}
+
+func (sc *scriptCred) AuthDo(ctx context.Context, op *esign.Op) (*http.Response, error) {
+	req, err := op.CreateRequest()
+	if err != nil {
+		return nil, err
+	}
+	var body []byte
+	if req.Body != nil {
+		body, _ = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+	}
+	sc.m.Lock()
+	defer sc.m.Unlock()
+	sc.bodies = append(sc.bodies, string(body))
+	if len(sc.errs) > 0 {
+		err, sc.errs = sc.errs[0], sc.errs[1:]
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader("{}"))}, nil
+}
+
+type closeTracker struct {
+	io.ReadSeeker
+	closed bool
+}
+
+func (ct *closeTracker) Close() error {
+	ct.closed = true
+	return nil
+}
+
+type noSeek struct {
+	io.Reader
+}
+
+func respErr(status int, hdr http.Header) error {
+	re := esign.NewResponseError([]byte(`{"errorCode":"E","message":"M"}`), status)
+	re.Header = hdr
+	return re
+}
+
+func TestCredential_AuthDo(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name     string
+		errs     []error
+		attempts int
+		wantErr  bool
+	}{
+		{name: "success", attempts: 1},
+		{name: "429", errs: []error{respErr(429, nil), respErr(503, nil)}, attempts: 3},
+		{name: "exhausted", errs: []error{respErr(500, nil), respErr(502, nil), respErr(504, nil)}, attempts: 3, wantErr: true},
+		{name: "notransient", errs: []error{respErr(400, nil)}, attempts: 1, wantErr: true},
+		{name: "retryafter", errs: []error{respErr(429, http.Header{"Retry-After": {"0"}})}, attempts: 2},
+		{name: "retryaftertoolong", errs: []error{respErr(503, http.Header{"Retry-After": {"3600"}})}, attempts: 1, wantErr: true},
+		{name: "ratelimitreset", errs: []error{respErr(429, http.Header{
+			"X-Ratelimit-Remaining": {"0"},
+			"X-Ratelimit-Reset":     {strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10)},
+		})}, attempts: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc := &scriptCred{errs: tt.errs}
+			cred := &retry.Credential{
+				Credential: sc,
+				MinBackoff: time.Millisecond,
+				MaxBackoff: 10 * time.Millisecond,
+			}
+			op := &esign.Op{Method: "POST", Path: "a", Payload: map[string]string{"a": "b"}}
+			res, err := cred.AuthDo(ctx, op)
+			if err == nil {
+				res.Body.Close()
+			}
+			if tt.wantErr != (err != nil) {
+				t.Errorf("expected error %v; got %v", tt.wantErr, err)
+			}
+			if len(sc.bodies) != tt.attempts {
+				t.Errorf("expected %d attempts; got %d", tt.attempts, len(sc.bodies))
+			}
+		})
+	}
+}
+
+func TestCredential_AuthDo_files(t *testing.T) {
+	ctx := context.Background()
+	sc := &scriptCred{errs: []error{respErr(503, nil), respErr(503, nil)}}
+	cred := &retry.Credential{Credential: sc, MinBackoff: time.Millisecond}
+	f1 := &closeTracker{ReadSeeker: bytes.NewReader([]byte("0123456789"))}
+	f2 := &closeTracker{ReadSeeker: bytes.NewReader([]byte("abcdefghij"))}
+	op := &esign.Op{
+		Method:  "POST",
+		Path:    "a",
+		Payload: map[string]string{"a": "b"},
+		Files: []*esign.UploadFile{
+			{ContentType: "text/plain", FileName: "f1.txt", ID: "1", Reader: f1},
+			{ContentType: "text/plain", FileName: "f2.txt", ID: "2", Reader: f2},
+		},
+	}
+	res, err := cred.AuthDo(ctx, op)
+	if err != nil {
+		t.Fatalf("expected success; got %v", err)
+	}
+	res.Body.Close()
+	if len(sc.bodies) != 3 {
+		t.Fatalf("expected 3 attempts; got %d", len(sc.bodies))
+	}
+	for i, b := range sc.bodies {
+		if !strings.Contains(b, "0123456789") || !strings.Contains(b, "abcdefghij") {
+			t.Errorf("attempt %d expected complete file contents; got %s", i, b)
+		}
+	}
+	if !f1.closed || !f2.closed {
+		t.Errorf("expected files to be closed")
+	}
+
+	// media upload with payload
+	sc = &scriptCred{errs: []error{respErr(503, nil)}}
+	cred.Credential = sc
+	op = &esign.Op{
+		Method:  "PUT",
+		Path:    "a",
+		Payload: &esign.UploadFile{ContentType: "text/plain", Reader: strings.NewReader("media")},
+	}
+	if res, err = cred.AuthDo(ctx, op); err != nil {
+		t.Fatalf("expected success; got %v", err)
+	}
+	res.Body.Close()
+	if len(sc.bodies) != 2 || sc.bodies[1] != "media" {
+		t.Errorf("expected 2 attempts with media payload; got %q", sc.bodies)
+	}
+
+	// non-seekable files may not be retried
+	sc = &scriptCred{errs: []error{respErr(503, nil)}}
+	cred.Credential = sc
+	op = &esign.Op{
+		Method: "POST",
+		Path:   "a",
+		Files: []*esign.UploadFile{
+			{ContentType: "text/plain", FileName: "f1.txt", ID: "1", Reader: noSeek{strings.NewReader("ABC")}},
+		},
+	}
+	if _, err = cred.AuthDo(ctx, op); err == nil {
+		t.Fatalf("expected 503 error; got success")
+	}
+	if len(sc.bodies) != 1 {
+		t.Errorf("expected 1 attempt for non-seekable file; got %d", len(sc.bodies))
+	}
+}
+
+func TestCredential_AuthDo_context(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	sc := &scriptCred{errs: []error{respErr(503, nil), respErr(503, nil)}}
+	cred := &retry.Credential{Credential: sc, MinBackoff: time.Second, MaxBackoff: 5 * time.Second}
+	_, err := cred.AuthDo(ctx, &esign.Op{Method: "GET", Path: "a"})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected %v; got %v", context.DeadlineExceeded, err)
+	}
+	if _, err := (&retry.Credential{}).AuthDo(ctx, &esign.Op{}); err == nil {
+		t.Errorf("expected no child credential error; got success")
+	}
+}
+
+func TestIsTransient(t *testing.T) {
+	if !retry.IsTransient(respErr(429, nil)) || retry.IsTransient(respErr(404, nil)) {
+		t.Errorf("expected 429 transient and 404 not transient")
+	}
+	re := esign.NewResponseError([]byte(`{"errorCode":"HOURLY_APIINVOCATION_LIMIT_EXCEEDED"}`), 400)
+	if !retry.IsTransient(re) {
+		t.Errorf("expected HOURLY_APIINVOCATION_LIMIT_EXCEEDED to be transient")
+	}
+	if retry.IsTransient(errors.New("other")) {
+		t.Errorf("expected generic error to not be transient")
+	}
+}
@@ -78,6 +78,15 @@ func (op *CreateExternalFormFillSessionOp) Do(ctx context.Context) (*rooms.Exter
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *CreateExternalFormFillSessionOp) ValidateFirst() *CreateExternalFormFillSessionOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // GetFormDetails gets form details.
 //
 // https://developers.docusign.com/docs/rooms-api/reference/forms/formdetails/getformdetails
@@ -128,6 +137,15 @@ func (op *AssignFormGroupFormOp) Do(ctx context.Context) error {
 	return ((*esign.Op)(op)).Do(ctx, nil)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *AssignFormGroupFormOp) ValidateFirst() *AssignFormGroupFormOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // CreateFormGroup creates a form group.
 //
 // https://developers.docusign.com/docs/rooms-api/reference/forms/formgroups/createformgroup
@@ -154,6 +172,15 @@ func (op *CreateFormGroupOp) Do(ctx context.Context) (*rooms.FormGroup, error) {
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *CreateFormGroupOp) ValidateFirst() *CreateFormGroupOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // DeleteFormGroup deletes a form group.
 //
 // https://developers.docusign.com/docs/rooms-api/reference/forms/formgroups/deleteformgroup
@@ -227,6 +254,45 @@ func (op *GetFormGroupsOp) Do(ctx context.Context) (*rooms.FormGroupSummaryList,
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *GetFormGroupsOp) Pages(ctx context.Context, f func(*rooms.FormGroupSummaryList) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   fmt.Sprint(res.EndPosition),
+			NextURI:       &res.NextURI,
+			ResultSetSize: fmt.Sprint(res.ResultSetSize),
+			StartPosition: fmt.Sprint(res.StartPosition),
+			TotalSetSize:  fmt.Sprint(res.TotalRowCount),
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "startPosition", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // Count (Optional) The number of results to return. This value must be a number between `1` and `100` (default).
 func (op *GetFormGroupsOp) Count(val int) *GetFormGroupsOp {
 	if op != nil {
@@ -319,6 +385,15 @@ func (op *RenameFormGroupOp) Do(ctx context.Context) (*rooms.FormGroup, error) {
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *RenameFormGroupOp) ValidateFirst() *RenameFormGroupOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // RevokeOfficeAccessFromFormGroup revoke an office's access to a form group.
 // If media is an io.ReadCloser, Do() will close media.
 //
@@ -369,6 +444,45 @@ func (op *GetFormLibrariesOp) Do(ctx context.Context) (*rooms.FormLibrarySummary
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *GetFormLibrariesOp) Pages(ctx context.Context, f func(*rooms.FormLibrarySummaryList) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   fmt.Sprint(res.EndPosition),
+			NextURI:       &res.NextURI,
+			ResultSetSize: fmt.Sprint(res.ResultSetSize),
+			StartPosition: fmt.Sprint(res.StartPosition),
+			TotalSetSize:  fmt.Sprint(res.TotalRowCount),
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "startPosition", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // Count (Optional) The number of results to return. This value must be a number between `1` and `100` (default).
 func (op *GetFormLibrariesOp) Count(val int) *GetFormLibrariesOp {
 	if op != nil {
@@ -410,6 +524,45 @@ func (op *GetFormLibraryFormsOp) Do(ctx context.Context) (*rooms.FormSummaryList
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *GetFormLibraryFormsOp) Pages(ctx context.Context, f func(*rooms.FormSummaryList) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   fmt.Sprint(res.EndPosition),
+			NextURI:       &res.NextURI,
+			ResultSetSize: fmt.Sprint(res.ResultSetSize),
+			StartPosition: fmt.Sprint(res.StartPosition),
+			TotalSetSize:  fmt.Sprint(res.TotalRowCount),
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "startPosition", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // Count (Optional) The number of results to return. This value must be a number between `1` and `100` (default).
 func (op *GetFormLibraryFormsOp) Count(val int) *GetFormLibraryFormsOp {
 	if op != nil {
@@ -69,6 +69,15 @@ func (op *CreateOfficeOp) Do(ctx context.Context) (*rooms.Office, error) {
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *CreateOfficeOp) ValidateFirst() *CreateOfficeOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // DeleteOffice deletes an office.
 //
 // https://developers.docusign.com/docs/rooms-api/reference/offices/offices/deleteoffice
@@ -142,6 +151,45 @@ func (op *GetOfficesOp) Do(ctx context.Context) (*rooms.OfficeSummaryList, error
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *GetOfficesOp) Pages(ctx context.Context, f func(*rooms.OfficeSummaryList) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   fmt.Sprint(res.EndPosition),
+			NextURI:       &res.NextURI,
+			ResultSetSize: fmt.Sprint(res.ResultSetSize),
+			StartPosition: fmt.Sprint(res.StartPosition),
+			TotalSetSize:  fmt.Sprint(res.TotalRowCount),
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "startPosition", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // Count (Optional) The number of results to return. This value must be a number between `1` and `100` (default).
 func (op *GetOfficesOp) Count(val int) *GetOfficesOp {
 	if op != nil {
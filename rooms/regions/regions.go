@@ -69,6 +69,15 @@ func (op *CreateRegionOp) Do(ctx context.Context) (*rooms.Region, error) {
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *CreateRegionOp) ValidateFirst() *CreateRegionOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // DeleteRegion deletes a region.
 //
 // https://developers.docusign.com/docs/rooms-api/reference/regions/regions/deleteregion
@@ -167,6 +176,45 @@ func (op *GetRegionsOp) Do(ctx context.Context) (*rooms.RegionSummaryList, error
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *GetRegionsOp) Pages(ctx context.Context, f func(*rooms.RegionSummaryList) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   fmt.Sprint(res.EndPosition),
+			NextURI:       &res.NextURI,
+			ResultSetSize: fmt.Sprint(res.ResultSetSize),
+			StartPosition: fmt.Sprint(res.StartPosition),
+			TotalSetSize:  fmt.Sprint(res.TotalRowCount),
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "startPosition", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // Count (Optional) The number of results to return. This value must be a number between `1` and `100` (default).
 func (op *GetRegionsOp) Count(val int) *GetRegionsOp {
 	if op != nil {
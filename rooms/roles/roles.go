@@ -184,6 +184,45 @@ func (op *GetRolesOp) Do(ctx context.Context) (*rooms.RoleSummaryList, error) {
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *GetRolesOp) Pages(ctx context.Context, f func(*rooms.RoleSummaryList) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   fmt.Sprint(res.EndPosition),
+			NextURI:       &res.NextURI,
+			ResultSetSize: fmt.Sprint(res.ResultSetSize),
+			StartPosition: fmt.Sprint(res.StartPosition),
+			TotalSetSize:  fmt.Sprint(res.TotalRowCount),
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "startPosition", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // OnlyAssignable (Optional) When set to **true**, returns only the roles that the current user can assign to someone else. The default value is **false**.
 func (op *GetRolesOp) OnlyAssignable() *GetRolesOp {
 	if op != nil {
@@ -13,6 +13,10 @@
 // https://developers.docusign.com/docs/rooms-api/reference
 package rooms // import "github.com/jfcote87/esign/rooms
 
+import (
+	"github.com/jfcote87/esign"
+)
+
 // For more infomation on how to use scopes, see https://developers.docusign.com/docs/rooms-api/rooms101/auth/
 const (
 	// OAuthScopeRead authorizes reading DocuSign Rooms data
@@ -62,6 +66,14 @@ type AccountSummary struct {
 	RequireOfficeLibraryAssignments bool `json:"requireOfficeLibraryAssignments,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r AccountSummary) Validate() error {
+	var v esign.Validator
+	v.Format("defaultFieldSetId", r.DefaultFieldSetID, "uuid")
+	return v.Err("AccountSummary")
+}
+
 // ActivityType details about an activity type.
 type ActivityType struct {
 	// The id of the activity type.
@@ -102,6 +114,16 @@ type AssignableRoles struct {
 	TotalRowCount int32 `json:"totalRowCount,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r AssignableRoles) Validate() error {
+	var v esign.Validator
+	for i := range r.Roles {
+		v.NestedIndex("roles", i, r.Roles[i].Validate())
+	}
+	return v.Err("AssignableRoles")
+}
+
 // ClassicAdminToInvite this request object contains details about the person who you want to invite.
 type ClassicAdminToInvite struct {
 	// (Required) The user's email address.
@@ -112,6 +134,16 @@ type ClassicAdminToInvite struct {
 	LastName string `json:"lastName,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r ClassicAdminToInvite) Validate() error {
+	var v esign.Validator
+	v.Required("email", r.Email)
+	v.Required("firstName", r.FirstName)
+	v.Required("lastName", r.LastName)
+	return v.Err("ClassicAdminToInvite")
+}
+
 // ClassicAgentToInvite this request object contains details about the person who you want to invite.
 type ClassicAgentToInvite struct {
 	//
@@ -128,6 +160,16 @@ type ClassicAgentToInvite struct {
 	OfficeID int32 `json:"officeId,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r ClassicAgentToInvite) Validate() error {
+	var v esign.Validator
+	v.Required("email", r.Email)
+	v.Required("firstName", r.FirstName)
+	v.Required("lastName", r.LastName)
+	return v.Err("ClassicAgentToInvite")
+}
+
 // ClassicManagerPermissions this object contains details about user permissions. These permissions are associated only with Rooms v5.
 type ClassicManagerPermissions struct {
 	// When set to **true**, the user is automatically added to new company rooms and is visible in those rooms.
@@ -202,6 +244,18 @@ type ClassicManagerToInvite struct {
 	TitleID int32 `json:"titleId,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r ClassicManagerToInvite) Validate() error {
+	var v esign.Validator
+	v.Required("accessLevel", r.AccessLevel)
+	v.Required("email", r.Email)
+	v.Required("firstName", r.FirstName)
+	v.Required("lastName", r.LastName)
+	v.Required("permissions", r.Permissions)
+	return v.Err("ClassicManagerToInvite")
+}
+
 // ClosingStatus contains information about a closing status, or reason for closing a room.
 type ClosingStatus struct {
 	// The id of the closing status.
@@ -312,6 +366,16 @@ type Document struct {
 	Size int64 `json:"size,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r Document) Validate() error {
+	var v esign.Validator
+	v.Required("base64Contents", r.Base64Contents)
+	v.Format("createdDate", r.CreatedDate, "date-time")
+	v.Required("name", r.Name)
+	return v.Err("Document")
+}
+
 // DocumentUser not described in definition file
 type DocumentUser struct {
 	// **True** if the user `userId` has can approve a task for this document.
@@ -385,6 +449,14 @@ type ExternalFormFillSession struct {
 	URL string `json:"url,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r ExternalFormFillSession) Validate() error {
+	var v esign.Validator
+	v.Format("url", r.URL, "uri")
+	return v.Err("ExternalFormFillSession")
+}
+
 // ExternalFormFillSessionForCreate contains the details required to create a form fill session.
 type ExternalFormFillSessionForCreate struct {
 	// (Required) The id of the form.
@@ -398,6 +470,14 @@ type ExternalFormFillSessionForCreate struct {
 	XFrameAllowedURL string `json:"xFrameAllowedUrl,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r ExternalFormFillSessionForCreate) Validate() error {
+	var v esign.Validator
+	v.Required("formId", r.FormID)
+	return v.Err("ExternalFormFillSessionForCreate")
+}
+
 // Field is the fields resource provides a method that enables you to retrieve a specific field set. This is a set of fields that can appear on a room's **Details** tab.
 type Field struct {
 	// The name that the Rooms API uses for the field.
@@ -425,6 +505,18 @@ type Field struct {
 	Type string `json:"type,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r Field) Validate() error {
+	var v esign.Validator
+	v.Format("fieldDefinitionId", r.FieldDefinitionID, "uuid")
+	v.Format("fieldId", r.FieldID, "uuid")
+	for i := range r.Fields {
+		v.NestedIndex("fields", i, r.Fields[i].Validate())
+	}
+	return v.Err("Field")
+}
+
 // FieldConfiguration contains details about how a field is configured.
 type FieldConfiguration struct {
 	// This property applies to child fields. It contains information about the parent field that must have a value set in order for this field to have a value. For example, you must specify a country before you can select a state.
@@ -559,6 +651,17 @@ type FieldSet struct {
 	Title string `json:"title,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r FieldSet) Validate() error {
+	var v esign.Validator
+	v.Format("fieldSetId", r.FieldSetID, "uuid")
+	for i := range r.Fields {
+		v.NestedIndex("fields", i, r.Fields[i].Validate())
+	}
+	return v.Err("FieldSet")
+}
+
 // FieldsCustomDataFilterType not described in definition file
 type FieldsCustomDataFilterType struct {
 }
@@ -618,12 +721,32 @@ type FormDetails struct {
 	Version string `json:"version,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r FormDetails) Validate() error {
+	var v esign.Validator
+	v.Format("availableOnDate", r.AvailableOnDate, "date-time")
+	v.Format("createdDate", r.CreatedDate, "date-time")
+	v.Format("formId", r.FormID, "uuid")
+	v.Format("lastUpdatedDate", r.LastUpdatedDate, "date-time")
+	return v.Err("FormDetails")
+}
+
 // FormForAdd contains details about the form that you want to add.
 type FormForAdd struct {
 	// (Required) The id of the form.
 	FormID string `json:"formId,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r FormForAdd) Validate() error {
+	var v esign.Validator
+	v.Required("formId", r.FormID)
+	v.Format("formId", r.FormID, "uuid")
+	return v.Err("FormForAdd")
+}
+
 // FormGroup result from getting a form group.
 type FormGroup struct {
 	// The ID of the form group.
@@ -639,18 +762,45 @@ type FormGroup struct {
 	OfficeIds []int `json:"officeIds,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r FormGroup) Validate() error {
+	var v esign.Validator
+	v.Format("formGroupId", r.FormGroupID, "uuid")
+	for i := range r.Forms {
+		v.NestedIndex("forms", i, r.Forms[i].Validate())
+	}
+	return v.Err("FormGroup")
+}
+
 // FormGroupForCreate request object for FormGroup::CreateFormGroup.
 type FormGroupForCreate struct {
 	// The name of the group.
 	Name string `json:"name,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r FormGroupForCreate) Validate() error {
+	var v esign.Validator
+	v.Required("name", r.Name)
+	return v.Err("FormGroupForCreate")
+}
+
 // FormGroupForUpdate not described in definition file
 type FormGroupForUpdate struct {
 	// The name of the office.
 	Name string `json:"name,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r FormGroupForUpdate) Validate() error {
+	var v esign.Validator
+	v.Required("name", r.Name)
+	return v.Err("FormGroupForUpdate")
+}
+
 // FormGroupFormToAssign not described in definition file
 type FormGroupFormToAssign struct {
 	// The id of the form.
@@ -662,6 +812,15 @@ type FormGroupFormToAssign struct {
 	IsRequired bool `json:"isRequired,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r FormGroupFormToAssign) Validate() error {
+	var v esign.Validator
+	v.Required("formId", r.FormID)
+	v.Format("formId", r.FormID, "uuid")
+	return v.Err("FormGroupFormToAssign")
+}
+
 // FormGroupSummary is the `FormGroups` resource enables you to create and manage custom groups of association forms.
 type FormGroupSummary struct {
 	// The number of forms in the form library.
@@ -712,6 +871,14 @@ type FormLibrarySummary struct {
 	Name string `json:"name,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r FormLibrarySummary) Validate() error {
+	var v esign.Validator
+	v.Format("formsLibraryId", r.FormsLibraryID, "uuid")
+	return v.Err("FormLibrarySummary")
+}
+
 // FormLibrarySummaryList contains a list of forms libraries.
 type FormLibrarySummaryList struct {
 	// The last zero-based index position in the result set.
@@ -730,6 +897,16 @@ type FormLibrarySummaryList struct {
 	TotalRowCount int32 `json:"totalRowCount,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r FormLibrarySummaryList) Validate() error {
+	var v esign.Validator
+	for i := range r.FormsLibrarySummaries {
+		v.NestedIndex("formsLibrarySummaries", i, r.FormsLibrarySummaries[i].Validate())
+	}
+	return v.Err("FormLibrarySummaryList")
+}
+
 // FormSummary contains details about a form in a form library.
 type FormSummary struct {
 	// The date and time when the form was last updated.
@@ -747,6 +924,15 @@ type FormSummary struct {
 	Name string `json:"name,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r FormSummary) Validate() error {
+	var v esign.Validator
+	v.Format("lastUpdatedDate", r.LastUpdatedDate, "date-time")
+	v.Format("libraryFormId", r.LibraryFormID, "uuid")
+	return v.Err("FormSummary")
+}
+
 // FormSummaryList contains a list of forms in a form library.
 type FormSummaryList struct {
 	// The last zero-based index position in the result set.
@@ -765,6 +951,16 @@ type FormSummaryList struct {
 	TotalRowCount int32 `json:"totalRowCount,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r FormSummaryList) Validate() error {
+	var v esign.Validator
+	for i := range r.Forms {
+		v.NestedIndex("forms", i, r.Forms[i].Validate())
+	}
+	return v.Err("FormSummaryList")
+}
+
 // GlobalActivityTypes contains a list of activity types.
 type GlobalActivityTypes struct {
 	// A list of activity types.
@@ -884,12 +1080,29 @@ type GroupForm struct {
 	Name string `json:"name,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r GroupForm) Validate() error {
+	var v esign.Validator
+	v.Format("formId", r.FormID, "uuid")
+	v.Format("lastUpdatedDate", r.LastUpdatedDate, "date-time")
+	return v.Err("GroupForm")
+}
+
 // LockedOutDetails details about a locked account.
 type LockedOutDetails struct {
 	// The reason the account was locked.
 	Reason string `json:"reason,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r LockedOutDetails) Validate() error {
+	var v esign.Validator
+	v.Required("reason", r.Reason)
+	return v.Err("LockedOutDetails")
+}
+
 // MemberSortingOption not described in definition file
 type MemberSortingOption struct {
 }
@@ -930,6 +1143,15 @@ type Office struct {
 	TimeZoneID string `json:"timeZoneId,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r Office) Validate() error {
+	var v esign.Validator
+	v.Format("createdDate", r.CreatedDate, "date-time")
+	v.Required("name", r.Name)
+	return v.Err("Office")
+}
+
 // OfficeForCreate contains details about the office that you want to create.
 type OfficeForCreate struct {
 	// First line of the office street address.
@@ -958,6 +1180,14 @@ type OfficeForCreate struct {
 	TimeZoneID string `json:"timeZoneId,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OfficeForCreate) Validate() error {
+	var v esign.Validator
+	v.Required("name", r.Name)
+	return v.Err("OfficeForCreate")
+}
+
 // OfficeReferenceCount is a complex element containing the number and type of each object referencing the office.
 type OfficeReferenceCount struct {
 	// The type of object referencing the office.
@@ -1004,6 +1234,14 @@ type OfficeSummary struct {
 	TimeZoneID string `json:"timeZoneId,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OfficeSummary) Validate() error {
+	var v esign.Validator
+	v.Format("createdDate", r.CreatedDate, "date-time")
+	return v.Err("OfficeSummary")
+}
+
 // OfficeSummaryList object that contains a summary of information about a requested group of offices in the Rooms account.
 type OfficeSummaryList struct {
 	// The index position within the total result set at which returned values end.
@@ -1022,6 +1260,16 @@ type OfficeSummaryList struct {
 	TotalRowCount int32 `json:"totalRowCount,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r OfficeSummaryList) Validate() error {
+	var v esign.Validator
+	for i := range r.OfficeSummaries {
+		v.NestedIndex("officeSummaries", i, r.OfficeSummaries[i].Validate())
+	}
+	return v.Err("OfficeSummaryList")
+}
+
 // OriginOfLead contains information about an origin of lead.
 type OriginOfLead struct {
 	// The name of the origin of lead. Possible values are:
@@ -1201,6 +1449,15 @@ type Region struct {
 	RegionID int32 `json:"regionId,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r Region) Validate() error {
+	var v esign.Validator
+	v.Format("createdDate", r.CreatedDate, "date-time")
+	v.Required("name", r.Name)
+	return v.Err("Region")
+}
+
 // RegionReferenceCount is a complex element containing the number and type of each object referencing the region.
 type RegionReferenceCount struct {
 	// The number of objects of this type referencing the region.
@@ -1225,6 +1482,14 @@ type RegionSummary struct {
 	RegionID int32 `json:"regionId,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r RegionSummary) Validate() error {
+	var v esign.Validator
+	v.Format("createdDate", r.CreatedDate, "date-time")
+	return v.Err("RegionSummary")
+}
+
 // RegionSummaryList object that contains a summary of information about a requested group of regions in the Rooms account.
 type RegionSummaryList struct {
 	// The last zero-based index position in the result set.
@@ -1243,6 +1508,16 @@ type RegionSummaryList struct {
 	TotalRowCount int32 `json:"totalRowCount,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r RegionSummaryList) Validate() error {
+	var v esign.Validator
+	for i := range r.RegionSummaries {
+		v.NestedIndex("regionSummaries", i, r.RegionSummaries[i].Validate())
+	}
+	return v.Err("RegionSummaryList")
+}
+
 // Role this object contains information about a role.
 type Role struct {
 	// The UTC DateTime when the role was created.
@@ -1274,6 +1549,14 @@ type Role struct {
 	RoleID int32 `json:"roleId,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r Role) Validate() error {
+	var v esign.Validator
+	v.Format("createdDate", r.CreatedDate, "date-time")
+	return v.Err("Role")
+}
+
 // RoleForCreate contains details about the role that you want to create.
 type RoleForCreate struct {
 	// When set to **true**, the role is an external role. You assign external roles to people from outside your company when you invite them into a room.
@@ -1314,6 +1597,14 @@ type RoleSummary struct {
 	RoleID int32 `json:"roleId,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r RoleSummary) Validate() error {
+	var v esign.Validator
+	v.Format("createdDate", r.CreatedDate, "date-time")
+	return v.Err("RoleSummary")
+}
+
 // RoleSummaryList this complex type contains details about the roles that are associated with an account.
 type RoleSummaryList struct {
 	// The last zero-based index position in the result set.
@@ -1332,6 +1623,16 @@ type RoleSummaryList struct {
 	TotalRowCount int32 `json:"totalRowCount,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r RoleSummaryList) Validate() error {
+	var v esign.Validator
+	for i := range r.Roles {
+		v.NestedIndex("roles", i, r.Roles[i].Validate())
+	}
+	return v.Err("RoleSummaryList")
+}
+
 // Room is the Rooms resource provides methods that enable you to create and manage rooms. In Rooms for Real Estate, a room is a collaborative digital space corresponding to a specific property. In Rooms for Mortgages, a room corresponds to a specific loan.
 type Room struct {
 	// The UTC date and time when the room was closed.
@@ -1390,6 +1691,17 @@ type Room struct {
 	SubmittedForReviewDate string `json:"submittedForReviewDate,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r Room) Validate() error {
+	var v esign.Validator
+	v.Format("closedDate", r.ClosedDate, "date-time")
+	v.Format("createdDate", r.CreatedDate, "date-time")
+	v.Format("rejectedDate", r.RejectedDate, "date-time")
+	v.Format("submittedForReviewDate", r.SubmittedForReviewDate, "date-time")
+	return v.Err("Room")
+}
+
 // RoomContactType contains information about a room contact type.
 type RoomContactType struct {
 	// The id of the room contact type.
@@ -1446,6 +1758,14 @@ type RoomDocument struct {
 	Size int64 `json:"size,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r RoomDocument) Validate() error {
+	var v esign.Validator
+	v.Format("createdDate", r.CreatedDate, "date-time")
+	return v.Err("RoomDocument")
+}
+
 // RoomDocumentList is a list of documents in a room.
 type RoomDocumentList struct {
 	// An array of room documents.
@@ -1464,6 +1784,16 @@ type RoomDocumentList struct {
 	TotalRowCount int32 `json:"totalRowCount,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r RoomDocumentList) Validate() error {
+	var v esign.Validator
+	for i := range r.Documents {
+		v.NestedIndex("documents", i, r.Documents[i].Validate())
+	}
+	return v.Err("RoomDocumentList")
+}
+
 // RoomDocumentOwner not described in definition file
 type RoomDocumentOwner struct {
 	// The company name.
@@ -1533,6 +1863,14 @@ type RoomForCreate struct {
 	TransactionSideID string `json:"transactionSideId,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r RoomForCreate) Validate() error {
+	var v esign.Validator
+	v.Required("name", r.Name)
+	return v.Err("RoomForCreate")
+}
+
 // RoomInvite is the information to use for the invitation.
 type RoomInvite struct {
 	// The user's email address.
@@ -1551,6 +1889,16 @@ type RoomInvite struct {
 	TransactionSideID string `json:"transactionSideId,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r RoomInvite) Validate() error {
+	var v esign.Validator
+	v.Required("email", r.Email)
+	v.Required("firstName", r.FirstName)
+	v.Required("lastName", r.LastName)
+	return v.Err("RoomInvite")
+}
+
 // RoomInviteResponse information about the sent invitation.
 type RoomInviteResponse struct {
 	// The user's email address.
@@ -1642,6 +1990,18 @@ type RoomSummary struct {
 	SubmittedForReviewDate string `json:"submittedForReviewDate,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r RoomSummary) Validate() error {
+	var v esign.Validator
+	v.Format("closedDate", r.ClosedDate, "date-time")
+	v.Format("createdDate", r.CreatedDate, "date-time")
+	v.Format("fieldDataLastUpdatedDate", r.FieldDataLastUpdatedDate, "date-time")
+	v.Format("rejectedDate", r.RejectedDate, "date-time")
+	v.Format("submittedForReviewDate", r.SubmittedForReviewDate, "date-time")
+	return v.Err("RoomSummary")
+}
+
 // RoomSummaryList this complex type contains details about rooms.
 type RoomSummaryList struct {
 	// The last zero-based index position in the result set.
@@ -1660,6 +2020,16 @@ type RoomSummaryList struct {
 	TotalRowCount int32 `json:"totalRowCount,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r RoomSummaryList) Validate() error {
+	var v esign.Validator
+	for i := range r.Rooms {
+		v.NestedIndex("rooms", i, r.Rooms[i].Validate())
+	}
+	return v.Err("RoomSummaryList")
+}
+
 // RoomTemplate contains details about a room template.
 type RoomTemplate struct {
 	// The name of the office.
@@ -1737,6 +2107,14 @@ type RoomUserRemovalDetail struct {
 	RevocationDate string `json:"revocationDate,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r RoomUserRemovalDetail) Validate() error {
+	var v esign.Validator
+	v.Format("revocationDate", r.RevocationDate, "date-time")
+	return v.Err("RoomUserRemovalDetail")
+}
+
 // RoomUserSortingOption not described in definition file
 type RoomUserSortingOption struct {
 }
@@ -1988,6 +2366,20 @@ type TaskList struct {
 	Tasks []TaskSummary `json:"tasks,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r TaskList) Validate() error {
+	var v esign.Validator
+	v.Format("approvalDate", r.ApprovalDate, "date-time")
+	v.Format("createdDate", r.CreatedDate, "date-time")
+	v.Format("rejectedDate", r.RejectedDate, "date-time")
+	v.Format("submittedForReviewDate", r.SubmittedForReviewDate, "date-time")
+	for i := range r.Tasks {
+		v.NestedIndex("tasks", i, r.Tasks[i].Validate())
+	}
+	return v.Err("TaskList")
+}
+
 // TaskListForCreate contains information about the task list template to use to create the new task list.
 type TaskListForCreate struct {
 	// (Required) The id of the task list template.
@@ -2020,12 +2412,33 @@ type TaskListSummary struct {
 	TaskListTemplateID int32 `json:"taskListTemplateId,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r TaskListSummary) Validate() error {
+	var v esign.Validator
+	v.Format("approvalDate", r.ApprovalDate, "date-time")
+	v.Format("createdDate", r.CreatedDate, "date-time")
+	v.Format("rejectedDate", r.RejectedDate, "date-time")
+	v.Format("submittedForReviewDate", r.SubmittedForReviewDate, "date-time")
+	return v.Err("TaskListSummary")
+}
+
 // TaskListSummaryList contains a list of task list summaries.
 type TaskListSummaryList struct {
 	// A list of task list summaries.
 	TaskListSummaries []TaskListSummary `json:"taskListSummaries,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r TaskListSummaryList) Validate() error {
+	var v esign.Validator
+	for i := range r.TaskListSummaries {
+		v.NestedIndex("taskListSummaries", i, r.TaskListSummaries[i].Validate())
+	}
+	return v.Err("TaskListSummaryList")
+}
+
 // TaskListTemplate contains details about a task list template.
 type TaskListTemplate struct {
 	// The name of the task list template.
@@ -2117,6 +2530,18 @@ type TaskSummary struct {
 	TaskID int32 `json:"taskId,omitempty"`
 }
 
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r TaskSummary) Validate() error {
+	var v esign.Validator
+	v.Format("approvalDate", r.ApprovalDate, "date-time")
+	v.Format("completionDate", r.CompletionDate, "date-time")
+	v.Format("createdDate", r.CreatedDate, "date-time")
+	v.Format("fixedDueDate", r.FixedDueDate, "date-time")
+	v.Format("rejectedDate", r.RejectedDate, "date-time")
+	return v.Err("TaskSummary")
+}
+
 // TimeZone is the `TimeZones` resource enables you to list the time zones that you can assign to an office.
 type TimeZone struct {
 	// The name of the office.
@@ -2307,3 +2732,16 @@ type UserToInvite struct {
 	//
 	RoleID int32 `json:"roleId,omitempty"`
 }
+
+// Validate checks r against the required fields and value
+// constraints of the specification.
+func (r UserToInvite) Validate() error {
+	var v esign.Validator
+	v.Required("accessLevel", r.AccessLevel)
+	v.Required("eSignPermissionProfileId", r.ESignPermissionProfileID)
+	v.Required("email", r.Email)
+	v.Required("firstName", r.FirstName)
+	v.Required("lastName", r.LastName)
+	v.Format("redirectUrl", r.RedirectURL, "uri")
+	return v.Err("UserToInvite")
+}
@@ -70,6 +70,15 @@ func (op *AddDocumentToRoomOp) Do(ctx context.Context) (*rooms.RoomDocument, err
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *AddDocumentToRoomOp) ValidateFirst() *AddDocumentToRoomOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // AddDocumentToRoomViaFileUpload uploads the contents of a file as a document to a room.
 // If media is an io.ReadCloser, Do() will close media.
 //
@@ -123,6 +132,15 @@ func (op *AddFormToRoomOp) Do(ctx context.Context) (*rooms.RoomDocument, error)
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *AddFormToRoomOp) ValidateFirst() *AddFormToRoomOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // CreateRoom creates a room.
 //
 // https://developers.docusign.com/docs/rooms-api/reference/rooms/rooms/createroom
@@ -149,6 +167,15 @@ func (op *CreateRoomOp) Do(ctx context.Context) (*rooms.Room, error) {
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *CreateRoomOp) ValidateFirst() *CreateRoomOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // DeleteRoom deletes a room.
 //
 // https://developers.docusign.com/docs/rooms-api/reference/rooms/rooms/deleteroom
@@ -197,6 +224,45 @@ func (op *GetAssignableRolesOp) Do(ctx context.Context) (*rooms.AssignableRoles,
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *GetAssignableRolesOp) Pages(ctx context.Context, f func(*rooms.AssignableRoles) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   fmt.Sprint(res.EndPosition),
+			NextURI:       &res.NextURI,
+			ResultSetSize: fmt.Sprint(res.ResultSetSize),
+			StartPosition: fmt.Sprint(res.StartPosition),
+			TotalSetSize:  fmt.Sprint(res.TotalRowCount),
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "startPosition", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // AssigneeEmail (Optional) The email address of a specific member. Using this parameter returns only the roles that the current user can assign to the member with that email address.
 func (op *GetAssignableRolesOp) AssigneeEmail(val string) *GetAssignableRolesOp {
 	if op != nil {
@@ -256,6 +322,45 @@ func (op *GetDocumentsOp) Do(ctx context.Context) (*rooms.RoomDocumentList, erro
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *GetDocumentsOp) Pages(ctx context.Context, f func(*rooms.RoomDocumentList) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   fmt.Sprint(res.EndPosition),
+			NextURI:       &res.NextURI,
+			ResultSetSize: fmt.Sprint(res.ResultSetSize),
+			StartPosition: fmt.Sprint(res.StartPosition),
+			TotalSetSize:  fmt.Sprint(res.TotalRowCount),
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "startPosition", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // Count (Optional) The number of results to return. This value must be a number between `1` and `100` (default).
 func (op *GetDocumentsOp) Count(val int) *GetDocumentsOp {
 	if op != nil {
@@ -380,6 +485,45 @@ func (op *GetRoomUsersOp) Do(ctx context.Context) (*rooms.RoomUsersResult, error
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *GetRoomUsersOp) Pages(ctx context.Context, f func(*rooms.RoomUsersResult) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   fmt.Sprint(res.EndPosition),
+			NextURI:       &res.NextURI,
+			ResultSetSize: fmt.Sprint(res.ResultSetSize),
+			StartPosition: fmt.Sprint(res.StartPosition),
+			TotalSetSize:  fmt.Sprint(res.TotalRowCount),
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "startPosition", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // Count (Optional) The number of results to return. This value must be a number between `1` and `100` (default).
 func (op *GetRoomUsersOp) Count(val int) *GetRoomUsersOp {
 	if op != nil {
@@ -412,9 +556,9 @@ func (op *GetRoomUsersOp) Filter(val string) *GetRoomUsersOp {
 // - `firstNameDesc`:  Sort on first name in descending order.
 // - `lastNameAsc`: Sort on last name in ascending order.
 // - `lastNameDesc`: Sort on last name in descending order. This is the default value.
-func (op *GetRoomUsersOp) Sort(val string) *GetRoomUsersOp {
+func (op *GetRoomUsersOp) Sort(val Sort) *GetRoomUsersOp {
 	if op != nil {
-		op.QueryOpts.Set("sort", val)
+		op.QueryOpts.Set("sort", string(val))
 	}
 	return op
 }
@@ -444,6 +588,45 @@ func (op *GetRoomsOp) Do(ctx context.Context) (*rooms.RoomSummaryList, error) {
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *GetRoomsOp) Pages(ctx context.Context, f func(*rooms.RoomSummaryList) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   fmt.Sprint(res.EndPosition),
+			NextURI:       &res.NextURI,
+			ResultSetSize: fmt.Sprint(res.ResultSetSize),
+			StartPosition: fmt.Sprint(res.StartPosition),
+			TotalSetSize:  fmt.Sprint(res.TotalRowCount),
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "startPosition", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // Count (Optional) The number of results. When this property is used as a request parameter specifying the number of results to return, the value must be a number between 1 and 100 (default).
 func (op *GetRoomsOp) Count(val int) *GetRoomsOp {
 	if op != nil {
@@ -472,9 +655,13 @@ func (op *GetRoomsOp) StartPosition(val int) *GetRoomsOp {
 // Example:
 //
 // `closed,open`
-func (op *GetRoomsOp) RoomStatus(val string) *GetRoomsOp {
+func (op *GetRoomsOp) RoomStatus(val ...RoomStatus) *GetRoomsOp {
 	if op != nil {
-		op.QueryOpts.Set("roomStatus", val)
+		vals := make([]string, len(val))
+		for i, v := range val {
+			vals[i] = string(v)
+		}
+		op.QueryOpts.Set("roomStatus", strings.Join(vals, ","))
 	}
 	return op
 }
@@ -574,6 +761,15 @@ func (op *InviteUserOp) Do(ctx context.Context) (*rooms.RoomInviteResponse, erro
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *InviteUserOp) ValidateFirst() *InviteUserOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // PutRoomUser updates a room user.
 //
 // https://developers.docusign.com/docs/rooms-api/reference/rooms/rooms/putroomuser
@@ -650,6 +846,15 @@ func (op *RevokeRoomUserAccessOp) Do(ctx context.Context) error {
 	return ((*esign.Op)(op)).Do(ctx, nil)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *RevokeRoomUserAccessOp) ValidateFirst() *RevokeRoomUserAccessOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // UpdatePicture updates the picture for a room.
 // If media is an io.ReadCloser, Do() will close media.
 //
@@ -702,3 +907,27 @@ func (op *UpdateRoomFieldDataOp) Do(ctx context.Context) (*rooms.FieldData, erro
 	var res *rooms.FieldData
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
+
+// RoomStatus is a value of the roomStatus query option.
+type RoomStatus string
+
+// RoomStatus values
+const (
+	RoomStatusActive  RoomStatus = "Active"
+	RoomStatusPending RoomStatus = "Pending"
+	RoomStatusClosed  RoomStatus = "Closed"
+	RoomStatusOpen    RoomStatus = "Open"
+)
+
+// Sort is a value of the sort query option.
+type Sort string
+
+// Sort values
+const (
+	SortFirstNameAsc  Sort = "FirstNameAsc"
+	SortLastNameAsc   Sort = "LastNameAsc"
+	SortEmailAsc      Sort = "EmailAsc"
+	SortFirstNameDesc Sort = "FirstNameDesc"
+	SortLastNameDesc  Sort = "LastNameDesc"
+	SortEmailDesc     Sort = "EmailDesc"
+)
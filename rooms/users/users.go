@@ -144,6 +144,45 @@ func (op *GetUsersOp) Do(ctx context.Context) (*rooms.UserSummaryList, error) {
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *GetUsersOp) Pages(ctx context.Context, f func(*rooms.UserSummaryList) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   fmt.Sprint(res.EndPosition),
+			NextURI:       &res.NextURI,
+			ResultSetSize: fmt.Sprint(res.ResultSetSize),
+			StartPosition: fmt.Sprint(res.StartPosition),
+			TotalSetSize:  fmt.Sprint(res.TotalRowCount),
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "startPosition", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // Filter (Optional) Filters results by name and email address. This is a  "starts with" filter, which means that you can enter only the beginning of a name or email address.
 //
 // **Note**: You do not use a wildcard with this filter.
@@ -162,9 +201,9 @@ func (op *GetUsersOp) Filter(val string) *GetUsersOp {
 // - `FirstNameDesc`
 // - `LastNameDesc`
 // - `EmailDesc`
-func (op *GetUsersOp) Sort(val string) *GetUsersOp {
+func (op *GetUsersOp) Sort(val Sort) *GetUsersOp {
 	if op != nil {
-		op.QueryOpts.Set("sort", val)
+		op.QueryOpts.Set("sort", string(val))
 	}
 	return op
 }
@@ -186,9 +225,9 @@ func (op *GetUsersOp) DefaultOfficeID(val int) *GetUsersOp {
 // - Contributor: Users with this access level can only administer their own resources.
 //
 // **Note**: In requests, the values that you may use for this property depend on your permissions and whether you can add users at your access level or lower. This property applies only to Rooms Version 6.
-func (op *GetUsersOp) AccessLevel(val string) *GetUsersOp {
+func (op *GetUsersOp) AccessLevel(val AccessLevel) *GetUsersOp {
 	if op != nil {
-		op.QueryOpts.Set("accessLevel", val)
+		op.QueryOpts.Set("accessLevel", string(val))
 	}
 	return op
 }
@@ -215,9 +254,9 @@ func (op *GetUsersOp) RoleID(val int) *GetUsersOp {
 //
 // - `Active`: The user is active.
 // - `Pending`: The user has been invited but has not yet accepted the invitation.
-func (op *GetUsersOp) Status(val string) *GetUsersOp {
+func (op *GetUsersOp) Status(val Status) *GetUsersOp {
 	if op != nil {
-		op.QueryOpts.Set("status", val)
+		op.QueryOpts.Set("status", string(val))
 	}
 	return op
 }
@@ -272,6 +311,15 @@ func (op *InviteClassicAdminOp) Do(ctx context.Context) (*rooms.User, error) {
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *InviteClassicAdminOp) ValidateFirst() *InviteClassicAdminOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // InviteClassicAgent invites a user to a v5 company account as an Agent.
 //
 // https://developers.docusign.com/docs/rooms-api/reference/users/users/inviteclassicagent
@@ -298,6 +346,15 @@ func (op *InviteClassicAgentOp) Do(ctx context.Context) (*rooms.User, error) {
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *InviteClassicAgentOp) ValidateFirst() *InviteClassicAgentOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // InviteClassicManager invites a user to a v5 company account as a Manager.
 //
 // https://developers.docusign.com/docs/rooms-api/reference/users/users/inviteclassicmanager
@@ -324,6 +381,15 @@ func (op *InviteClassicManagerOp) Do(ctx context.Context) (*rooms.User, error) {
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *InviteClassicManagerOp) ValidateFirst() *InviteClassicManagerOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // InviteUser invites a user to a v6 company account.
 //
 // https://developers.docusign.com/docs/rooms-api/reference/users/users/inviteuser
@@ -350,6 +416,15 @@ func (op *InviteUserOp) Do(ctx context.Context) (*rooms.User, error) {
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *InviteUserOp) ValidateFirst() *InviteUserOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // LockUser locks a user's account.
 //
 // https://developers.docusign.com/docs/rooms-api/reference/users/users/lockuser
@@ -375,6 +450,15 @@ func (op *LockUserOp) Do(ctx context.Context) error {
 	return ((*esign.Op)(op)).Do(ctx, nil)
 }
 
+// ValidateFirst causes Do to return the error of the payload's Validate
+// method without sending the request.
+func (op *LockUserOp) ValidateFirst() *LockUserOp {
+	if op != nil {
+		op.ValidatePayload = true
+	}
+	return op
+}
+
 // ReinviteUser reinvites a user to join a company account.
 // If media is an io.ReadCloser, Do() will close media.
 //
@@ -523,3 +607,37 @@ func (op *UpdateUserOp) Do(ctx context.Context) (*rooms.User, error) {
 	var res *rooms.User
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
+
+// AccessLevel is a value of the accessLevel query option.
+type AccessLevel string
+
+// AccessLevel values
+const (
+	AccessLevelContributor AccessLevel = "Contributor"
+	AccessLevelOffice      AccessLevel = "Office"
+	AccessLevelRegion      AccessLevel = "Region"
+	AccessLevelCompany     AccessLevel = "Company"
+	AccessLevelAdmin       AccessLevel = "Admin"
+)
+
+// Sort is a value of the sort query option.
+type Sort string
+
+// Sort values
+const (
+	SortFirstNameAsc  Sort = "FirstNameAsc"
+	SortLastNameAsc   Sort = "LastNameAsc"
+	SortEmailAsc      Sort = "EmailAsc"
+	SortFirstNameDesc Sort = "FirstNameDesc"
+	SortLastNameDesc  Sort = "LastNameDesc"
+	SortEmailDesc     Sort = "EmailDesc"
+)
+
+// Status is a value of the status query option.
+type Status string
+
+// Status values
+const (
+	StatusActive  Status = "Active"
+	StatusPending Status = "Pending"
+)
@@ -0,0 +1,53 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+// stub.go contains the call recording used by the generated Stub
+// of each service package.
+
+import "sync"
+
+// StubCall is a call recorded by a generated Stub.  Args contains the
+// call's arguments excluding the context.
+type StubCall struct {
+	Method string
+	Args   []interface{}
+}
+
+// StubRecorder records the calls made to a generated Stub.  It is
+// safe for concurrent use.
+type StubRecorder struct {
+	mu    sync.Mutex
+	calls []StubCall
+}
+
+// Record appends a call of method with args.
+func (r *StubRecorder) Record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, StubCall{Method: method, Args: args})
+}
+
+// Calls returns the recorded calls of method in call order.  An empty
+// method returns all calls.
+func (r *StubRecorder) Calls(method string) []StubCall {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var res []StubCall
+	for _, c := range r.calls {
+		if method == "" || c.Method == method {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+// Reset removes all recorded calls.
+func (r *StubRecorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
@@ -0,0 +1,223 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+// tokenstore.go contains the TokenStore interface used by
+// OAuth2Config and JWTConfig to persist tokens along with
+// in-memory and file implementations.
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/jfcote87/oauth2"
+)
+
+// ErrTokenNotFound is returned by a TokenStore's Load func when
+// no token is saved for the key.
+var ErrTokenNotFound = errors.New("token not found")
+
+// TokenKey identifies a saved token.
+type TokenKey struct {
+	IntegratorKey string `json:"integrator_key"`
+	// UserID is the user's api username (UserInfo.APIUsername)
+	UserID string `json:"user_id"`
+	// AccountID is the account id of the configuration.  A blank
+	// value indicates the user's default account.
+	AccountID string `json:"account_id"`
+}
+
+// String returns a hash of the key values suitable for use
+// as a file name or database key.
+func (k TokenKey) String() string {
+	h := sha256.Sum256([]byte(k.IntegratorKey + "\x00" + k.UserID + "\x00" + k.AccountID))
+	return hex.EncodeToString(h[:])
+}
+
+// TokenStore saves and retrieves tokens for OAuth2Credentials allowing
+// tokens to be reused across restarts and processes.  Implementations
+// must be safe for concurrent use.
+type TokenStore interface {
+	// Load returns the saved token and user info for key.  If no
+	// token exists, Load must return ErrTokenNotFound.
+	Load(ctx context.Context, key TokenKey) (*oauth2.Token, *UserInfo, error)
+	// Save stores the token and user info for key.
+	Save(ctx context.Context, key TokenKey, tk *oauth2.Token, u *UserInfo) error
+	// Delete removes the saved token for key.
+	Delete(ctx context.Context, key TokenKey) error
+}
+
+// storedToken is the saved form of a token
+type storedToken struct {
+	Token    *oauth2.Token `json:"token"`
+	UserInfo *UserInfo     `json:"user_info,omitempty"`
+}
+
+// MemoryTokenStore is a TokenStore that keeps tokens in memory.
+// The zero value is ready to use.
+type MemoryTokenStore struct {
+	mu     sync.Mutex
+	tokens map[TokenKey]storedToken
+}
+
+// Load returns copies of the saved token and user info.
+func (m *MemoryTokenStore) Load(ctx context.Context, key TokenKey) (*oauth2.Token, *UserInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	st, ok := m.tokens[key]
+	if !ok {
+		return nil, nil, ErrTokenNotFound
+	}
+	tk := *st.Token
+	var u *UserInfo
+	if st.UserInfo != nil {
+		ux := *st.UserInfo
+		u = &ux
+	}
+	return &tk, u, nil
+}
+
+// Save stores copies of tk and u.
+func (m *MemoryTokenStore) Save(ctx context.Context, key TokenKey, tk *oauth2.Token, u *UserInfo) error {
+	if tk == nil {
+		return errors.New("token may not be nil")
+	}
+	st := storedToken{Token: &oauth2.Token{}}
+	*st.Token = *tk
+	if u != nil {
+		ux := *u
+		st.UserInfo = &ux
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.tokens == nil {
+		m.tokens = make(map[TokenKey]storedToken)
+	}
+	m.tokens[key] = st
+	return nil
+}
+
+// Delete removes the token for key.
+func (m *MemoryTokenStore) Delete(ctx context.Context, key TokenKey) error {
+	m.mu.Lock()
+	delete(m.tokens, key)
+	m.mu.Unlock()
+	return nil
+}
+
+// FileTokenStore is a TokenStore that saves each token as an AES-GCM
+// encrypted file in a directory.  The directory may be shared by multiple
+// processes using the same encryption key.
+type FileTokenStore struct {
+	dir  string
+	aead cipher.AEAD
+}
+
+// NewFileTokenStore returns a FileTokenStore saving files to dir. The
+// encryptionKey must be 16, 24 or 32 bytes to select AES-128, AES-192
+// or AES-256.
+func NewFileTokenStore(dir string, encryptionKey []byte) (*FileTokenStore, error) {
+	block, err := aes.NewCipher(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &FileTokenStore{dir: dir, aead: aead}, nil
+}
+
+func (f *FileTokenStore) filename(key TokenKey) string {
+	return filepath.Join(f.dir, key.String()+".token")
+}
+
+// Load reads and decrypts the file for key.
+func (f *FileTokenStore) Load(ctx context.Context, key TokenKey) (*oauth2.Token, *UserInfo, error) {
+	b, err := ioutil.ReadFile(f.filename(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil, ErrTokenNotFound
+		}
+		return nil, nil, err
+	}
+	nonceSize := f.aead.NonceSize()
+	if len(b) < nonceSize {
+		return nil, nil, errors.New("token file is corrupt")
+	}
+	// key is used as additional data to prevent swapping files
+	plain, err := f.aead.Open(nil, b[:nonceSize], b[nonceSize:], []byte(key.String()))
+	if err != nil {
+		return nil, nil, err
+	}
+	var st storedToken
+	if err = json.Unmarshal(plain, &st); err != nil {
+		return nil, nil, err
+	}
+	if st.Token == nil {
+		return nil, nil, ErrTokenNotFound
+	}
+	return st.Token, st.UserInfo, nil
+}
+
+// Save encrypts the token and user info and writes them to the key's
+// file.  The file is replaced atomically so concurrent Loads never see
+// a partial file.
+func (f *FileTokenStore) Save(ctx context.Context, key TokenKey, tk *oauth2.Token, u *UserInfo) error {
+	if tk == nil {
+		return errors.New("token may not be nil")
+	}
+	plain, err := json.Marshal(storedToken{Token: tk, UserInfo: u})
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, f.aead.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+	b := f.aead.Seal(nonce, nonce, plain, []byte(key.String()))
+
+	tmp, err := ioutil.TempFile(f.dir, ".token-")
+	if err != nil {
+		return err
+	}
+	if _, err = tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err = os.Rename(tmp.Name(), f.filename(key)); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
+
+// Delete removes the key's file.
+func (f *FileTokenStore) Delete(ctx context.Context, key TokenKey) error {
+	if err := os.Remove(f.filename(key)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,273 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/oauth2"
+	"github.com/jfcote87/testutils"
+)
+
+var (
+	testKeyOnce sync.Once
+	testKeyPEM  string
+	testKeyErr  error
+)
+
+// testPrivateKey returns a PEM encoded RSA key for JWTConfig tests.
+func testPrivateKey(t *testing.T) string {
+	testKeyOnce.Do(func() {
+		var key *rsa.PrivateKey
+		if key, testKeyErr = rsa.GenerateKey(rand.Reader, 2048); testKeyErr == nil {
+			testKeyPEM = string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+		}
+	})
+	if testKeyErr != nil {
+		t.Fatalf("generate key: %v", testKeyErr)
+	}
+	return testKeyPEM
+}
+
+// ctxTokenStore returns the context's error from Load.
+type ctxTokenStore struct {
+	esign.MemoryTokenStore
+}
+
+func (s *ctxTokenStore) Load(ctx context.Context, key esign.TokenKey) (*oauth2.Token, *esign.UserInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	return s.MemoryTokenStore.Load(ctx, key)
+}
+
+func testTokenStore(t *testing.T, name string, store esign.TokenStore) {
+	ctx := context.Background()
+	key := esign.TokenKey{IntegratorKey: "KEY", UserID: "USER"}
+	if _, _, err := store.Load(ctx, key); err != esign.ErrTokenNotFound {
+		t.Fatalf("%s: expected ErrTokenNotFound; got %v", name, err)
+	}
+	var u *esign.UserInfo
+	if err := json.Unmarshal([]byte(userInfoSuccessResponse), &u); err != nil {
+		t.Fatalf("%s: unmarshal userinfo: %v", name, err)
+	}
+	tk := &oauth2.Token{AccessToken: "ACCESS", RefreshToken: "REFRESH", Expiry: time.Now().Add(time.Hour).Round(time.Second)}
+	if err := store.Save(ctx, key, tk, u); err != nil {
+		t.Fatalf("%s: expected successful save; got %v", name, err)
+	}
+	tk.AccessToken = "CHANGED"
+	ltk, lu, err := store.Load(ctx, key)
+	if err != nil {
+		t.Fatalf("%s: expected successful load; got %v", name, err)
+	}
+	if ltk.AccessToken != "ACCESS" || ltk.RefreshToken != "REFRESH" || !ltk.Expiry.Equal(tk.Expiry) || lu == nil || lu.Email != u.Email {
+		t.Errorf("%s: expected saved token and userinfo; got %#v %#v", name, ltk, lu)
+	}
+	// keys differing by account are separate
+	if _, _, err = store.Load(ctx, esign.TokenKey{IntegratorKey: "KEY", UserID: "USER", AccountID: "ACCT"}); err != esign.ErrTokenNotFound {
+		t.Errorf("%s: expected ErrTokenNotFound for account key; got %v", name, err)
+	}
+	if err = store.Delete(ctx, key); err != nil {
+		t.Errorf("%s: expected successful delete; got %v", name, err)
+	}
+	if _, _, err = store.Load(ctx, key); err != esign.ErrTokenNotFound {
+		t.Errorf("%s: expected ErrTokenNotFound after delete; got %v", name, err)
+	}
+	if err = store.Delete(ctx, key); err != nil {
+		t.Errorf("%s: expected delete of missing token to succeed; got %v", name, err)
+	}
+}
+
+func TestTokenStores(t *testing.T) {
+	testTokenStore(t, "memory", &esign.MemoryTokenStore{})
+
+	dir, err := ioutil.TempDir("", "esigntokens")
+	if err != nil {
+		t.Fatalf("tempdir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	encKey := []byte("0123456789abcdef0123456789abcdef")
+	fs, err := esign.NewFileTokenStore(dir, encKey)
+	if err != nil {
+		t.Fatalf("expected file store; got %v", err)
+	}
+	testTokenStore(t, "file", fs)
+
+	// wrong encryption key may not read token
+	ctx := context.Background()
+	key := esign.TokenKey{IntegratorKey: "KEY", UserID: "USER"}
+	if err = fs.Save(ctx, key, &oauth2.Token{AccessToken: "SECRET_ACCESS"}, nil); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	b, _ := ioutil.ReadFile(dir + "/" + key.String() + ".token")
+	if len(b) == 0 || json.Valid(b) {
+		t.Errorf("expected encrypted token file; got %q", b)
+	}
+	fs2, _ := esign.NewFileTokenStore(dir, []byte("fedcba9876543210"))
+	if _, _, err = fs2.Load(ctx, key); err == nil {
+		t.Errorf("expected decryption error with different key")
+	}
+	if _, err = esign.NewFileTokenStore(dir, []byte("short")); err == nil {
+		t.Errorf("expected invalid key size error")
+	}
+}
+
+// failingStore returns an error from Save.
+type failingStore struct {
+	esign.MemoryTokenStore
+}
+
+func (s *failingStore) Save(ctx context.Context, key esign.TokenKey, tk *oauth2.Token, u *esign.UserInfo) error {
+	return errors.New("disk full")
+}
+
+func TestOAuth2Config_ExchangeSaveError(t *testing.T) {
+	ctx := context.Background()
+	cfg, testTransport := getOAuth2ConfigTransport()
+	cfg.TokenStore = &failingStore{}
+	testTransport.Add(exchangeResponseTest, userinfoResponseDemoTest)
+	cred, err := cfg.Exchange(ctx, "CODE")
+	if err != nil || cred == nil {
+		t.Fatalf("expected credential despite failed save; got %v %v", cred, err)
+	}
+	if tk, err := cred.Token(ctx); err != nil || tk.AccessToken != "ISSUED_ACCESS_TOKEN" {
+		t.Errorf("expected ISSUED_ACCESS_TOKEN; got %v %v", tk, err)
+	}
+}
+
+func TestOAuth2Config_TokenStore(t *testing.T) {
+	ctx := context.Background()
+	cfg, testTransport := getOAuth2ConfigTransport()
+	store := &esign.MemoryTokenStore{}
+	cfg.TokenStore = store
+
+	if _, err := cfg.StoredCredential(ctx, "50d89ab1-dad5-d00d-b410-92ee3110b970"); err != esign.ErrTokenNotFound {
+		t.Fatalf("expected ErrTokenNotFound; got %v", err)
+	}
+	testTransport.Add(exchangeResponseTest, userinfoResponseDemoTest)
+	if _, err := cfg.Exchange(ctx, "CODE"); err != nil {
+		t.Fatalf("expected successful exchange; got %v", err)
+	}
+	cred, err := cfg.StoredCredential(ctx, "50d89ab1-dad5-d00d-b410-92ee3110b970")
+	if err != nil {
+		t.Fatalf("expected stored credential; got %v", err)
+	}
+	// no calls expected as token and userinfo are loaded from store
+	tk, err := cred.Token(ctx)
+	if err != nil || tk.AccessToken != "ISSUED_ACCESS_TOKEN" {
+		t.Fatalf("expected ISSUED_ACCESS_TOKEN; got %v %v", tk, err)
+	}
+
+	// refreshed token is saved
+	key := esign.TokenKey{IntegratorKey: "KEY", UserID: "50d89ab1-dad5-d00d-b410-92ee3110b970"}
+	store.Save(ctx, key, &oauth2.Token{AccessToken: "EXPIRED", RefreshToken: "refresh", Expiry: time.Now().Add(-time.Hour)}, nil)
+	testTransport.Add(refreshResponseTest, userinfoResponseDemoTest)
+	if cred, err = cfg.StoredCredential(ctx, key.UserID); err != nil {
+		t.Fatalf("expected stored credential; got %v", err)
+	}
+	if _, err = cred.Token(ctx); err != nil {
+		t.Fatalf("expected refresh; got %v", err)
+	}
+	if tk, _, err = store.Load(ctx, key); err != nil || tk.AccessToken != "ISSUED_ACCESS_TOKEN" {
+		t.Errorf("expected refreshed token saved; got %v %v", tk, err)
+	}
+
+	// expired token without refresh token is removed
+	store.Save(ctx, key, &oauth2.Token{AccessToken: "EXPIRED", Expiry: time.Now().Add(-time.Hour)}, nil)
+	if _, err = cfg.StoredCredential(ctx, key.UserID); err != esign.ErrTokenNotFound {
+		t.Errorf("expected ErrTokenNotFound for expired token; got %v", err)
+	}
+	if _, _, err = store.Load(ctx, key); err != esign.ErrTokenNotFound {
+		t.Errorf("expected expired token deleted; got %v", err)
+	}
+}
+
+func TestJWTConfig_TokenStore(t *testing.T) {
+	ctx := context.Background()
+	testTransport := &testutils.Transport{}
+	clx := &http.Client{Transport: testTransport}
+	store := &esign.MemoryTokenStore{}
+	cfg := &esign.JWTConfig{
+		IntegratorKey: "KEY",
+		PrivateKey:    testPrivateKey(t),
+		KeyPairID:     "1234567890123",
+		IsDemo:        true,
+		TokenStore:    store,
+		HTTPClientFunc: func(ctx context.Context) (*http.Client, error) {
+			return clx, nil
+		},
+	}
+	userID := "50d89ab1-dad5-d00d-b410-92ee3110b970"
+	testTransport.Add(&testutils.RequestTester{
+		Host:   "account-d.docusign.com",
+		Path:   "/oauth/token",
+		Method: "POST",
+		ResponseFunc: func(r *http.Request) (*http.Response, error) {
+			return testutils.MakeResponse(200, []byte(tokenSuccessResponse), nil), nil
+		},
+	}, userinfoResponseDemoTest)
+	cred, err := cfg.Credential(userID, nil, nil)
+	if err != nil {
+		t.Fatalf("expected credential; got %v", err)
+	}
+	if _, err = cred.Token(ctx); err != nil {
+		t.Fatalf("expected new token; got %v", err)
+	}
+	// second credential uses stored token without any calls
+	if cred, err = cfg.Credential(userID, nil, nil); err != nil {
+		t.Fatalf("expected credential; got %v", err)
+	}
+	if u, err := cred.UserInfo(ctx); err != nil || u.APIUsername != userID {
+		t.Errorf("expected stored userinfo; got %v %v", u, err)
+	}
+	if tk, err := cred.Token(ctx); err != nil || tk.AccessToken != "ISSUED_ACCESS_TOKEN" {
+		t.Errorf("expected stored token; got %v %v", tk, err)
+	}
+	if cred, err = cfg.StoredCredential(ctx, userID); err != nil {
+		t.Fatalf("expected stored credential; got %v", err)
+	}
+	if tk, err := cred.Token(ctx); err != nil || tk.AccessToken != "ISSUED_ACCESS_TOKEN" {
+		t.Errorf("expected stored token; got %v %v", tk, err)
+	}
+}
+
+func TestJWTConfig_StoredCredentialContext(t *testing.T) {
+	cfg := &esign.JWTConfig{
+		IntegratorKey: "KEY",
+		PrivateKey:    testPrivateKey(t),
+		KeyPairID:     "1234567890123",
+		IsDemo:        true,
+		TokenStore:    &ctxTokenStore{},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := cfg.StoredCredential(ctx, "USER"); err != context.Canceled {
+		t.Errorf("expected canceled load; got %v", err)
+	}
+	pool, err := cfg.NewPool(0, 0)
+	if err != nil {
+		t.Fatalf("expected pool; got %v", err)
+	}
+	if _, err = pool.For(ctx, "USER", "ACCOUNT"); err != context.Canceled {
+		t.Errorf("expected canceled pool load; got %v", err)
+	}
+	if _, err = (&esign.JWTConfig{PrivateKey: cfg.PrivateKey}).StoredCredential(context.Background(), "USER"); err == nil {
+		t.Errorf("expected no TokenStore error")
+	}
+}
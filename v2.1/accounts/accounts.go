@@ -18,6 +18,10 @@
 // The Accounts category also includes end points for listing the recipient names associated with an email address that was used by the account. For example, a single email address is often shared by multiple members of a family.
 //
 //
+// The specification lists no field constraints for this API's models,
+// so they have no Validate methods and ops have no ValidateFirst option.
+// Constraints may be added with a gen-esign overrides file.
+//
 // Service Api documentation may be found at:
 // https://developers.docusign.com/docs/esign-rest-api/reference/Accounts
 // Usage example:
@@ -894,9 +898,13 @@ func (op *PermissionProfilesListOp) Do(ctx context.Context) (*model.PermissionPr
 // - `metadata`: Metadata indicating whether the properties associated with the account permission profile are editable.
 //
 // Example: `user_count,closed_users`
-func (op *PermissionProfilesListOp) Include(val string) *PermissionProfilesListOp {
+func (op *PermissionProfilesListOp) Include(val ...Include) *PermissionProfilesListOp {
 	if op != nil {
-		op.QueryOpts.Set("include", val)
+		vals := make([]string, len(val))
+		for i, v := range val {
+			vals[i] = string(v)
+		}
+		op.QueryOpts.Set("include", strings.Join(vals, ","))
 	}
 	return op
 }
@@ -1226,9 +1234,9 @@ func (op *GetBillingChargesOp) Do(ctx context.Context) (*model.BillingChargeResp
 //
 // * envelopes
 // * seats
-func (op *GetBillingChargesOp) IncludeCharges(val string) *GetBillingChargesOp {
+func (op *GetBillingChargesOp) IncludeCharges(val IncludeCharges) *GetBillingChargesOp {
 	if op != nil {
-		op.QueryOpts.Set("include_charges", val)
+		op.QueryOpts.Set("include_charges", string(val))
 	}
 	return op
 }
@@ -1341,6 +1349,45 @@ func (op *ListSharedAccessOp) Do(ctx context.Context) (*model.AccountSharedAcces
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *ListSharedAccessOp) Pages(ctx context.Context, f func(*model.AccountSharedAccess) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   res.EndPosition,
+			NextURI:       &res.NextURI,
+			ResultSetSize: res.ResultSetSize,
+			StartPosition: res.StartPosition,
+			TotalSetSize:  res.TotalSetSize,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // Count is the maximum number of results to return.
 //
 // Use `start_position` to specify the number of results to skip.
@@ -1379,9 +1426,9 @@ func (op *ListSharedAccessOp) FolderIds(val ...string) *ListSharedAccessOp {
 // - `envelopes`: Get information about envelope sharing between users.
 // - `templates`: Get information about template sharing among users and groups.
 // - `folders`: Get information about folder sharing among users and groups.
-func (op *ListSharedAccessOp) ItemType(val string) *ListSharedAccessOp {
+func (op *ListSharedAccessOp) ItemType(val ItemType) *ListSharedAccessOp {
 	if op != nil {
-		op.QueryOpts.Set("item_type", val)
+		op.QueryOpts.Set("item_type", string(val))
 	}
 	return op
 }
@@ -1405,9 +1452,9 @@ func (op *ListSharedAccessOp) SearchText(val string) *ListSharedAccessOp {
 // - `shared_to_and_from`: The response lists users in `user_list` who are sharing items to and from the current user.
 //
 // If the current user does not have administrative privileges, only the `shared_to` option is valid.
-func (op *ListSharedAccessOp) Shared(val string) *ListSharedAccessOp {
+func (op *ListSharedAccessOp) Shared(val ...string) *ListSharedAccessOp {
 	if op != nil {
-		op.QueryOpts.Set("shared", val)
+		op.QueryOpts.Set("shared", strings.Join(val, ","))
 	}
 	return op
 }
@@ -2148,3 +2195,34 @@ func (op *IdentityVerificationsListOp) Do(ctx context.Context) (*model.AccountId
 	var res *model.AccountIdentityVerificationResponse
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
+
+
+// Include is a value of the include query option.
+type Include string
+
+// Include values
+const (
+	IncludeUserCount         Include = "user_count"
+	IncludeClosedUsers       Include = "closed_users"
+	IncludeAccountManagement Include = "account_management"
+	IncludeMetadata          Include = "metadata"
+)
+
+// IncludeCharges is a value of the include_charges query option.
+type IncludeCharges string
+
+// IncludeCharges values
+const (
+	IncludeChargesEnvelopes IncludeCharges = "envelopes"
+	IncludeChargesSeats     IncludeCharges = "seats"
+)
+
+// ItemType is a value of the item_type query option.
+type ItemType string
+
+// ItemType values
+const (
+	ItemTypeEnvelopes ItemType = "envelopes"
+	ItemTypeTemplates ItemType = "templates"
+	ItemTypeFolders   ItemType = "folders"
+)
@@ -0,0 +1,279 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package builder creates and validates envelope definitions.  A Builder
+// tracks documents, recipients and tabs as values rather than ids, so
+// Build assigns consistent DocumentID, RecipientID and tab values and
+// reports mistakes before the definition is sent to DocuSign.
+//
+//   b := builder.New("Please sign the agreement")
+//   doc := b.Document(&esign.UploadFile{
+//       FileName:    "agreement.pdf",
+//       ContentType: "application/pdf",
+//       Reader:      f,
+//   })
+//   b.Signer("Jane Doe", "jane@example.com").
+//       SignHere(builder.At(doc, 1, 100, 500)).
+//       DateSigned(builder.Anchor("/date1/", 0, 0))
+//   b.CarbonCopy("Legal", "legal@example.com").RoutingOrder(2)
+//   def, uploads, err := b.Send().Build()
+//   if err != nil {
+//       // err is a builder.ValidationErrors
+//   }
+//   summary, err := envelopes.New(cred).Create(def, uploads...).Do(ctx)
+package builder // import "github.com/jfcote87/esign/v2.1/builder"
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+// Builder accumulates the documents and recipients of an envelope.
+// Builder methods are not safe for concurrent use.
+type Builder struct {
+	emailSubject string
+	emailBlurb   string
+	send         bool
+	documents    []*Document
+	recipients   []*Recipient
+	modify       []func(*model.EnvelopeDefinition)
+}
+
+// New returns a Builder for an envelope with the passed email subject.
+func New(emailSubject string) *Builder {
+	return &Builder{emailSubject: emailSubject}
+}
+
+// EmailBlurb sets the body of the envelope's email.
+func (b *Builder) EmailBlurb(blurb string) *Builder {
+	b.emailBlurb = blurb
+	return b
+}
+
+// Send sets the built envelope's status to sent.  Without Send,
+// the envelope is created as a draft.
+func (b *Builder) Send() *Builder {
+	b.send = true
+	return b
+}
+
+// Modify adds a func that Build calls with the generated definition
+// to set fields not covered by the Builder.  Changes made by f are
+// not validated.
+func (b *Builder) Modify(f func(*model.EnvelopeDefinition)) *Builder {
+	b.modify = append(b.modify, f)
+	return b
+}
+
+// Document describes an envelope document.
+type Document struct {
+	id      string
+	name    string
+	ext     string
+	upload  *esign.UploadFile
+	content []byte
+}
+
+// Document adds a document whose content is sent as part of a multipart
+// request.  The document name is the file's FileName and the DocumentID
+// is the file's ID if set.
+func (b *Builder) Document(f *esign.UploadFile) *Document {
+	d := &Document{upload: f}
+	if f != nil {
+		d.id, d.name = f.ID, f.FileName
+	}
+	b.documents = append(b.documents, d)
+	return d
+}
+
+// DocumentBytes adds a document whose content is sent base64 encoded
+// in the envelope definition.
+func (b *Builder) DocumentBytes(name string, content []byte) *Document {
+	d := &Document{name: name, content: content}
+	b.documents = append(b.documents, d)
+	return d
+}
+
+// ID sets the document's DocumentID.  Build assigns ids to documents
+// without one.
+func (d *Document) ID(id string) *Document {
+	d.id = id
+	return d
+}
+
+// Name sets the document's display name.
+func (d *Document) Name(name string) *Document {
+	d.name = name
+	return d
+}
+
+// FileExtension sets the document's file extension when it may not be
+// determined by its name.
+func (d *Document) FileExtension(ext string) *Document {
+	d.ext = ext
+	return d
+}
+
+// ValidationError describes a problem with a definition.  Path locates
+// the problem in the definition using json names (for example
+// "recipients.signers[0].tabs.signHereTabs[1]").
+type ValidationError struct {
+	Path    string
+	Message string
+}
+
+// Error returns the path and message.
+func (v ValidationError) Error() string {
+	return v.Path + ": " + v.Message
+}
+
+// ValidationErrors are the errors returned by Build.
+type ValidationErrors []ValidationError
+
+// Error lists all errors.
+func (v ValidationErrors) Error() string {
+	msgs := make([]string, 0, len(v))
+	for _, e := range v {
+		msgs = append(msgs, e.Error())
+	}
+	return "invalid envelope definition: " + strings.Join(msgs, "; ")
+}
+
+type validator struct {
+	errs ValidationErrors
+}
+
+func (v *validator) add(path, format string, args ...interface{}) {
+	v.errs = append(v.errs, ValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// assignIDs sets blank ids to the lowest unused positive integers and
+// reports duplicate ids.
+func (v *validator) assignIDs(ids []*string, paths []string, name string) {
+	used := make(map[string]string)
+	for i, id := range ids {
+		if *id == "" {
+			continue
+		}
+		if p, ok := used[*id]; ok {
+			v.add(paths[i], "duplicate %s %s also used by %s", name, *id, p)
+			continue
+		}
+		used[*id] = paths[i]
+	}
+	next := 1
+	for _, id := range ids {
+		if *id != "" {
+			continue
+		}
+		for ; used[strconv.Itoa(next)] != ""; next++ {
+		}
+		*id = strconv.Itoa(next)
+		used[*id] = "assigned"
+	}
+}
+
+// Build assigns ids and returns the envelope definition along with the
+// document uploads to pass to envelopes.Service.Create.  If any problems
+// are found, Build returns a ValidationErrors.  Build sets the ID of each
+// upload to its document's id.
+func (b *Builder) Build() (*model.EnvelopeDefinition, []*esign.UploadFile, error) {
+	v := &validator{}
+	counts := make(map[recipientKind]int)
+	for _, r := range b.recipients {
+		r.path = fmt.Sprintf("recipients.%s[%d]", r.kind.jsonName(), counts[r.kind])
+		counts[r.kind]++
+	}
+	b.assignDocumentIDs(v)
+	b.assignRecipientIDs(v)
+
+	def := &model.EnvelopeDefinition{
+		EmailSubject: b.emailSubject,
+		EmailBlurb:   b.emailBlurb,
+		Status:       "created",
+	}
+	if b.send {
+		def.Status = "sent"
+		if def.EmailSubject == "" {
+			v.add("emailSubject", "an email subject is required to send an envelope")
+		}
+	}
+	var uploads []*esign.UploadFile
+	if len(b.documents) == 0 {
+		v.add("documents", "at least one document is required")
+	}
+	for i, d := range b.documents {
+		path := fmt.Sprintf("documents[%d]", i)
+		if d.name == "" {
+			v.add(path, "document name is required")
+		}
+		doc := model.Document{DocumentID: d.id, Name: d.name, FileExtension: d.ext}
+		switch {
+		case d.upload != nil && d.upload.Reader != nil:
+			d.upload.ID = d.id
+			uploads = append(uploads, d.upload)
+		case len(d.content) > 0:
+			doc.DocumentBase64 = d.content
+		default:
+			v.add(path, "document has no content")
+		}
+		def.Documents = append(def.Documents, doc)
+	}
+
+	def.Recipients = &model.Recipients{}
+	for _, r := range b.recipients {
+		r.build(def.Recipients, b, v)
+	}
+	if len(b.recipients) == 0 && b.send {
+		v.add("recipients", "at least one recipient is required to send an envelope")
+	}
+	if len(v.errs) > 0 {
+		return nil, nil, v.errs
+	}
+	for _, f := range b.modify {
+		f(def)
+	}
+	return def, uploads, nil
+}
+
+func (b *Builder) assignDocumentIDs(v *validator) {
+	ids := make([]*string, len(b.documents))
+	paths := make([]string, len(b.documents))
+	for i, d := range b.documents {
+		ids[i], paths[i] = &d.id, fmt.Sprintf("documents[%d]", i)
+	}
+	v.assignIDs(ids, paths, "documentId")
+}
+
+func (b *Builder) assignRecipientIDs(v *validator) {
+	ids := make([]*string, len(b.recipients))
+	paths := make([]string, len(b.recipients))
+	for i, r := range b.recipients {
+		ids[i], paths[i] = &r.id, r.path
+	}
+	v.assignIDs(ids, paths, "recipientId")
+}
+
+func (b *Builder) hasDocument(d *Document) bool {
+	for _, dx := range b.documents {
+		if dx == d {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *Builder) hasRecipient(r *Recipient) bool {
+	for _, rx := range b.recipients {
+		if rx == r {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,123 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package builder_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/v2.1/builder"
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+func TestBuilder_Build(t *testing.T) {
+	b := builder.New("Subject").EmailBlurb("Blurb").Send()
+	upload := &esign.UploadFile{FileName: "contract.pdf", ContentType: "application/pdf", Reader: strings.NewReader("PDF")}
+	doc1 := b.DocumentBytes("terms.pdf", []byte("TERMS")).ID("1")
+	doc2 := b.Document(upload)
+	signer := b.Signer("Signer", "signer@example.com").ClientUserID("C1").
+		SignHere(builder.At(doc2, 2, 100, 200)).
+		DateSigned(builder.Anchor("/ds/", 10, -5)).
+		Text("company", builder.At(doc1, 1, 5, 5), "ACME", true)
+	b.CarbonCopy("Copy", "copy@example.com").ID("1").RoutingOrder(2)
+	b.Witness("Witness", "witness@example.com", signer).SignHere(builder.At(doc1, 1, 50, 50))
+	b.Modify(func(def *model.EnvelopeDefinition) {
+		def.BrandID = "BRAND"
+	})
+
+	def, uploads, err := b.Build()
+	if err != nil {
+		t.Fatalf("expected successful build; got %v", err)
+	}
+	if def.Status != "sent" || def.EmailBlurb != "Blurb" || def.BrandID != "BRAND" {
+		t.Errorf("expected sent status, blurb and brand; got %s %s %s", def.Status, def.EmailBlurb, def.BrandID)
+	}
+	if len(def.Documents) != 2 || def.Documents[1].DocumentID != "2" || string(def.Documents[0].DocumentBase64) != "TERMS" {
+		t.Fatalf("expected 2 documents with assigned id; got %#v", def.Documents)
+	}
+	if len(uploads) != 1 || uploads[0] != upload || upload.ID != "2" {
+		t.Errorf("expected upload with document id 2; got %#v", uploads)
+	}
+	rcps := def.Recipients
+	if rcps.CarbonCopies[0].RecipientID != "1" || rcps.CarbonCopies[0].RoutingOrder != "2" {
+		t.Errorf("expected carbon copy id 1 routing order 2; got %#v", rcps.CarbonCopies[0])
+	}
+	sx := rcps.Signers[0]
+	if sx.RecipientID != "2" || sx.ClientUserID != "C1" || sx.RoutingOrder != "" {
+		t.Errorf("expected signer id 2; got %s %s %s", sx.RecipientID, sx.ClientUserID, sx.RoutingOrder)
+	}
+	sh := sx.Tabs.SignHereTabs[0]
+	if sh.DocumentID != "2" || sh.RecipientID != "2" || sh.PageNumber != "2" || sh.XPosition != "100" || sh.YPosition != "200" {
+		t.Errorf("expected sign here on document 2; got %#v %#v", sh.TabBase, sh.TabPosition)
+	}
+	ds := sx.Tabs.DateSignedTabs[0]
+	if ds.DocumentID != "" || ds.AnchorString != "/ds/" || ds.AnchorXOffset != "10" || ds.AnchorYOffset != "-5" || ds.AnchorUnits != "pixels" {
+		t.Errorf("expected anchored date signed; got %#v %#v", ds.TabBase, ds.TabPosition)
+	}
+	if tx := sx.Tabs.TextTabs[0]; tx.TabLabel != "company" || tx.Value != "ACME" || tx.Required != model.TRUE || tx.DocumentID != "1" {
+		t.Errorf("expected company text tab; got %#v", tx)
+	}
+	if wx := rcps.Witnesses[0]; wx.WitnessFor != "2" || wx.RecipientID != "3" {
+		t.Errorf("expected witness 3 for signer 2; got %s %s", wx.RecipientID, wx.WitnessFor)
+	}
+}
+
+func TestBuilder_BuildErrors(t *testing.T) {
+	other := builder.New("Other").DocumentBytes("other.pdf", []byte("OTHER"))
+	tests := []struct {
+		name  string
+		setup func(b *builder.Builder)
+		paths []string
+	}{
+		{"no documents", func(b *builder.Builder) {
+			b.Send()
+		}, []string{"documents", "recipients"}},
+		{"duplicate ids", func(b *builder.Builder) {
+			d := b.DocumentBytes("a.pdf", []byte("A")).ID("1")
+			b.DocumentBytes("b.pdf", []byte("B")).ID("1")
+			b.Signer("A", "a@example.com").ID("5").SignHere(builder.At(d, 1, 0, 0))
+			b.CarbonCopy("B", "b@example.com").ID("5")
+		}, []string{"documents[1]", "recipients.carbonCopies[0]"}},
+		{"missing document", func(b *builder.Builder) {
+			b.DocumentBytes("a.pdf", []byte("A"))
+			b.Signer("A", "a@example.com").SignHere(builder.At(other, 1, 0, 0))
+		}, []string{"recipients.signers[0].tabs.signHereTabs[0]"}},
+		{"no sign here", func(b *builder.Builder) {
+			d := b.DocumentBytes("a.pdf", []byte("A"))
+			b.Signer("A", "a@example.com").DateSigned(builder.At(d, 1, 0, 0))
+		}, []string{"recipients.signers[0]"}},
+		{"invalid tab positions", func(b *builder.Builder) {
+			d := b.DocumentBytes("a.pdf", []byte("A"))
+			b.Signer("A", "a@example.com").SignHere(builder.At(d, 0, 0, 0)).
+				InitialHere(builder.Position{}).InitialHere(builder.At(d, 1, -1, 0))
+		}, []string{"recipients.signers[0].tabs.signHereTabs[0]", "recipients.signers[0].tabs.initialHereTabs[0]", "recipients.signers[0].tabs.initialHereTabs[1]"}},
+		{"recipient errors", func(b *builder.Builder) {
+			d := b.DocumentBytes("a.pdf", nil)
+			b.CarbonCopy("", "").SignHere(builder.At(d, 1, 0, 0))
+			b.InPersonSigner("Host", "", "Signer").SignHere(builder.Anchor("x", 0, 0))
+			b.Witness("W", "w@example.com", nil)
+		}, []string{"documents[0]", "recipients.carbonCopies[0]", "recipients.carbonCopies[0]", "recipients.carbonCopies[0]",
+			"recipients.inPersonSigners[0]", "recipients.witnesses[0]"}},
+	}
+	for _, tt := range tests {
+		b := builder.New("Subject")
+		tt.setup(b)
+		_, _, err := b.Build()
+		verrs, ok := err.(builder.ValidationErrors)
+		if !ok {
+			t.Errorf("%s: expected ValidationErrors; got %v", tt.name, err)
+			continue
+		}
+		var paths []string
+		for _, e := range verrs {
+			paths = append(paths, e.Path)
+		}
+		if strings.Join(paths, ",") != strings.Join(tt.paths, ",") {
+			t.Errorf("%s: expected errors at %v; got %v", tt.name, tt.paths, verrs)
+		}
+	}
+}
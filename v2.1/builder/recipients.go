@@ -0,0 +1,255 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package builder
+
+// recipients.go contains the Recipient type and the conversion of
+// recipients to their model types.
+
+import (
+	"strconv"
+
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+type recipientKind string
+
+const (
+	kindAgent             recipientKind = "agent"
+	kindCarbonCopy        recipientKind = "carbonCopy"
+	kindCertifiedDelivery recipientKind = "certifiedDelivery"
+	kindEditor            recipientKind = "editor"
+	kindInPersonSigner    recipientKind = "inPersonSigner"
+	kindIntermediary      recipientKind = "intermediary"
+	kindNotary            recipientKind = "notary"
+	kindParticipant       recipientKind = "participant"
+	kindSeal              recipientKind = "seal"
+	kindSigner            recipientKind = "signer"
+	kindWitness           recipientKind = "witness"
+)
+
+// jsonName returns the name of the kind's list in model.Recipients
+func (k recipientKind) jsonName() string {
+	switch k {
+	case kindCarbonCopy:
+		return "carbonCopies"
+	case kindCertifiedDelivery:
+		return "certifiedDeliveries"
+	case kindIntermediary:
+		return "intermediaries"
+	case kindNotary:
+		return "notaries"
+	case kindWitness:
+		return "witnesses"
+	}
+	return string(k) + "s"
+}
+
+// hasTabs reports whether the kind of recipient may be assigned tabs.
+func (k recipientKind) hasTabs() bool {
+	switch k {
+	case kindSigner, kindInPersonSigner, kindNotary, kindSeal, kindWitness:
+		return true
+	}
+	return false
+}
+
+// Recipient describes an envelope recipient.  Create recipients using
+// the Builder's Signer, CarbonCopy, etc. methods.
+type Recipient struct {
+	kind         recipientKind
+	id           string
+	name         string
+	email        string
+	hostName     string
+	hostEmail    string
+	routingOrder int
+	clientUserID string
+	roleName     string
+	witnessFor   *Recipient
+	tabs         []*tab
+	path         string // set by Build
+}
+
+func (b *Builder) add(kind recipientKind, name, email string) *Recipient {
+	r := &Recipient{kind: kind, name: name, email: email}
+	b.recipients = append(b.recipients, r)
+	return r
+}
+
+// Agent adds a recipient who may add name and email information for
+// recipients in later routing orders.
+func (b *Builder) Agent(name, email string) *Recipient {
+	return b.add(kindAgent, name, email)
+}
+
+// CarbonCopy adds a recipient who receives a copy of the envelope.
+func (b *Builder) CarbonCopy(name, email string) *Recipient {
+	return b.add(kindCarbonCopy, name, email)
+}
+
+// CertifiedDelivery adds a recipient who must acknowledge receipt.
+func (b *Builder) CertifiedDelivery(name, email string) *Recipient {
+	return b.add(kindCertifiedDelivery, name, email)
+}
+
+// Editor adds a recipient who may change recipients and tabs of
+// later routing orders.
+func (b *Builder) Editor(name, email string) *Recipient {
+	return b.add(kindEditor, name, email)
+}
+
+// InPersonSigner adds a signer who signs in the presence of the host.
+func (b *Builder) InPersonSigner(hostName, hostEmail, signerName string) *Recipient {
+	r := b.add(kindInPersonSigner, signerName, "")
+	r.hostName, r.hostEmail = hostName, hostEmail
+	return r
+}
+
+// Intermediary adds a recipient who may add name and email information
+// for recipients in later routing orders and forward the envelope.
+func (b *Builder) Intermediary(name, email string) *Recipient {
+	return b.add(kindIntermediary, name, email)
+}
+
+// Notary adds a notary recipient.
+func (b *Builder) Notary(name, email string) *Recipient {
+	return b.add(kindNotary, name, email)
+}
+
+// Participant adds a recipient who receives a copy of the envelope
+// and acknowledges receipt.
+func (b *Builder) Participant(name, email string) *Recipient {
+	return b.add(kindParticipant, name, email)
+}
+
+// Seal adds an electronic seal recipient.  name is the seal's
+// recipient name.
+func (b *Builder) Seal(name string) *Recipient {
+	return b.add(kindSeal, name, "")
+}
+
+// Signer adds a signer.
+func (b *Builder) Signer(name, email string) *Recipient {
+	return b.add(kindSigner, name, email)
+}
+
+// Witness adds a witness for signer.
+func (b *Builder) Witness(name, email string, signer *Recipient) *Recipient {
+	r := b.add(kindWitness, name, email)
+	r.witnessFor = signer
+	return r
+}
+
+// ID sets the recipient's RecipientID.  Build assigns ids to recipients
+// without one.
+func (r *Recipient) ID(id string) *Recipient {
+	r.id = id
+	return r
+}
+
+// RoutingOrder sets the recipient's routing order.  Recipients without
+// a routing order use DocuSign's default of 1.
+func (r *Recipient) RoutingOrder(order int) *Recipient {
+	r.routingOrder = order
+	return r
+}
+
+// ClientUserID sets the recipient's clientUserId making the recipient
+// an embedded recipient.
+func (r *Recipient) ClientUserID(id string) *Recipient {
+	r.clientUserID = id
+	return r
+}
+
+// RoleName sets the recipient's role name.
+func (r *Recipient) RoleName(name string) *Recipient {
+	r.roleName = name
+	return r
+}
+
+func (r *Recipient) routingOrderValue() string {
+	if r.routingOrder == 0 {
+		return ""
+	}
+	return strconv.Itoa(r.routingOrder)
+}
+
+// build validates the recipient and adds it to rcps.
+func (r *Recipient) build(rcps *model.Recipients, b *Builder, v *validator) {
+	switch {
+	case r.kind == kindInPersonSigner:
+		if r.hostName == "" || r.hostEmail == "" {
+			v.add(r.path, "host name and email are required")
+		}
+	case r.kind != kindSeal && r.email == "":
+		v.add(r.path, "email is required")
+	}
+	if r.name == "" {
+		v.add(r.path, "name is required")
+	}
+	if r.routingOrder < 0 {
+		v.add(r.path, "invalid routing order %d", r.routingOrder)
+	}
+	if len(r.tabs) > 0 && !r.kind.hasTabs() {
+		v.add(r.path, "%s recipients may not have tabs", r.kind)
+	}
+	tabs := r.buildTabs(b, v)
+	if (r.kind == kindSigner || r.kind == kindInPersonSigner) && len(tabs.SignHereTabs) == 0 {
+		v.add(r.path, "signer has no signHere tabs")
+	}
+	var witnessFor string
+	if r.kind == kindWitness {
+		switch {
+		case r.witnessFor == nil || !b.hasRecipient(r.witnessFor):
+			v.add(r.path, "witness is for a signer not added to the envelope")
+		case r.witnessFor.kind != kindSigner && r.witnessFor.kind != kindInPersonSigner:
+			v.add(r.path, "witness is for %s, not a signer", r.witnessFor.path)
+		default:
+			witnessFor = r.witnessFor.id
+		}
+	}
+	if !r.kind.hasTabs() {
+		tabs = nil
+	}
+
+	id, order := r.id, r.routingOrderValue()
+	switch r.kind {
+	case kindAgent:
+		rcps.Agents = append(rcps.Agents, model.Agent{RecipientID: id, Name: r.name, Email: r.email,
+			RoutingOrder: order, ClientUserID: r.clientUserID, RoleName: r.roleName})
+	case kindCarbonCopy:
+		rcps.CarbonCopies = append(rcps.CarbonCopies, model.CarbonCopy{RecipientID: id, Name: r.name, Email: r.email,
+			RoutingOrder: order, ClientUserID: r.clientUserID, RoleName: r.roleName})
+	case kindCertifiedDelivery:
+		rcps.CertifiedDeliveries = append(rcps.CertifiedDeliveries, model.CertifiedDelivery{RecipientID: id, Name: r.name, Email: r.email,
+			RoutingOrder: order, ClientUserID: r.clientUserID, RoleName: r.roleName})
+	case kindEditor:
+		rcps.Editors = append(rcps.Editors, model.Editor{RecipientID: id, Name: r.name, Email: r.email,
+			RoutingOrder: order, ClientUserID: r.clientUserID, RoleName: r.roleName})
+	case kindInPersonSigner:
+		rcps.InPersonSigners = append(rcps.InPersonSigners, model.InPersonSigner{RecipientID: id, SignerName: r.name,
+			HostName: r.hostName, HostEmail: r.hostEmail, RoutingOrder: order, ClientUserID: r.clientUserID,
+			RoleName: r.roleName, Tabs: tabs})
+	case kindIntermediary:
+		rcps.Intermediaries = append(rcps.Intermediaries, model.Intermediary{RecipientID: id, Name: r.name, Email: r.email,
+			RoutingOrder: order, ClientUserID: r.clientUserID, RoleName: r.roleName})
+	case kindNotary:
+		rcps.Notaries = append(rcps.Notaries, model.NotaryRecipient{RecipientID: id, Name: r.name, Email: r.email,
+			RoutingOrder: order, ClientUserID: r.clientUserID, RoleName: r.roleName, Tabs: tabs})
+	case kindParticipant:
+		rcps.Participants = append(rcps.Participants, model.Participant{RecipientID: id, Name: r.name, Email: r.email,
+			RoutingOrder: order, ClientUserID: r.clientUserID, RoleName: r.roleName})
+	case kindSeal:
+		rcps.Seals = append(rcps.Seals, model.SealSign{RecipientID: id, Name: r.name,
+			RoutingOrder: order, ClientUserID: r.clientUserID, RoleName: r.roleName, Tabs: tabs})
+	case kindSigner:
+		rcps.Signers = append(rcps.Signers, model.Signer{RecipientID: id, Name: r.name, Email: r.email,
+			RoutingOrder: order, ClientUserID: r.clientUserID, RoleName: r.roleName, Tabs: tabs})
+	case kindWitness:
+		rcps.Witnesses = append(rcps.Witnesses, model.Witness{RecipientID: id, Name: r.name, Email: r.email,
+			RoutingOrder: order, ClientUserID: r.clientUserID, RoleName: r.roleName, Tabs: tabs, WitnessFor: witnessFor})
+	}
+}
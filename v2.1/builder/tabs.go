@@ -0,0 +1,189 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package builder
+
+// tabs.go contains tab positions and the Recipient methods
+// for adding tabs.
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+// Position locates a tab either on a page of a document or relative to
+// each occurrence of an anchor string.
+type Position struct {
+	// Document is required for page positions.  For anchor positions,
+	// a nil document searches all documents.
+	Document *Document
+	// Page, X and Y are the page number (starting at 1) and pixel
+	// coordinates of the tab.
+	Page int
+	X    int
+	Y    int
+	// AnchorString places the tab at each occurrence of the string
+	// offset by AnchorXOffset and AnchorYOffset AnchorUnits.
+	AnchorString  string
+	AnchorXOffset int
+	AnchorYOffset int
+	// AnchorUnits is pixels, mms, cms or inches.  Blank means pixels.
+	AnchorUnits string
+	// AnchorIgnoreIfNotPresent prevents an error when the anchor
+	// string is not found.
+	AnchorIgnoreIfNotPresent bool
+}
+
+// At returns the position x, y on page of doc.
+func At(doc *Document, page, x, y int) Position {
+	return Position{Document: doc, Page: page, X: x, Y: y}
+}
+
+// Anchor returns a position offset in pixels from each occurrence
+// of anchor.
+func Anchor(anchor string, xOffset, yOffset int) Position {
+	return Position{AnchorString: anchor, AnchorXOffset: xOffset, AnchorYOffset: yOffset}
+}
+
+// tab is a tab waiting for Build to assign ids.
+type tab struct {
+	kind  string // json name of the tab list in model.Tabs
+	label string
+	pos   Position
+	add   func(tabs *model.Tabs, base model.TabBase, pos model.TabPosition)
+}
+
+// Tab adds a tab of any kind.  kind is the json name of the tab's list
+// in model.Tabs (e.g. "numberTabs") and is used only in validation
+// messages.  Build calls add with the tab's completed TabBase and
+// TabPosition, and add must append the tab to tabs.
+//
+//   r.Tab("numberTabs", builder.At(doc, 1, 100, 200), func(t *model.Tabs, b model.TabBase, p model.TabPosition) {
+//       t.NumberTabs = append(t.NumberTabs, model.Number{TabBase: b, TabPosition: p})
+//   })
+func (r *Recipient) Tab(kind string, p Position, add func(tabs *model.Tabs, base model.TabBase, pos model.TabPosition)) *Recipient {
+	r.tabs = append(r.tabs, &tab{kind: kind, pos: p, add: add})
+	return r
+}
+
+func (r *Recipient) labeledTab(kind, label string, p Position, add func(*model.Tabs, model.TabBase, model.TabPosition)) *Recipient {
+	r.tabs = append(r.tabs, &tab{kind: kind, label: label, pos: p, add: add})
+	return r
+}
+
+// SignHere adds a signature tab.
+func (r *Recipient) SignHere(p Position) *Recipient {
+	return r.Tab("signHereTabs", p, func(t *model.Tabs, b model.TabBase, p model.TabPosition) {
+		t.SignHereTabs = append(t.SignHereTabs, model.SignHere{TabBase: b, TabPosition: p})
+	})
+}
+
+// OptionalSignHere adds a signature tab that the recipient may skip.
+func (r *Recipient) OptionalSignHere(p Position) *Recipient {
+	return r.Tab("signHereTabs", p, func(t *model.Tabs, b model.TabBase, p model.TabPosition) {
+		t.SignHereTabs = append(t.SignHereTabs, model.SignHere{TabBase: b, TabPosition: p, Optional: model.TRUE})
+	})
+}
+
+// InitialHere adds an initials tab.
+func (r *Recipient) InitialHere(p Position) *Recipient {
+	return r.Tab("initialHereTabs", p, func(t *model.Tabs, b model.TabBase, p model.TabPosition) {
+		t.InitialHereTabs = append(t.InitialHereTabs, model.InitialHere{TabBase: b, TabPosition: p})
+	})
+}
+
+// DateSigned adds a tab displaying the date the recipient signed.
+func (r *Recipient) DateSigned(p Position) *Recipient {
+	return r.Tab("dateSignedTabs", p, func(t *model.Tabs, b model.TabBase, p model.TabPosition) {
+		t.DateSignedTabs = append(t.DateSignedTabs, model.DateSigned{TabBase: b, TabPosition: p})
+	})
+}
+
+// FullName adds a tab displaying the recipient's name.
+func (r *Recipient) FullName(p Position) *Recipient {
+	return r.Tab("fullNameTabs", p, func(t *model.Tabs, b model.TabBase, p model.TabPosition) {
+		t.FullNameTabs = append(t.FullNameTabs, model.FullName{TabBase: b, TabPosition: p})
+	})
+}
+
+// EmailAddress adds a tab displaying the recipient's email address.
+func (r *Recipient) EmailAddress(p Position) *Recipient {
+	return r.Tab("emailAddressTabs", p, func(t *model.Tabs, b model.TabBase, p model.TabPosition) {
+		t.EmailAddressTabs = append(t.EmailAddressTabs, model.EmailAddress{TabBase: b, TabPosition: p})
+	})
+}
+
+// Text adds a text entry tab with an initial value.
+func (r *Recipient) Text(label string, p Position, value string, required bool) *Recipient {
+	req := model.FALSE
+	if required {
+		req = model.TRUE
+	}
+	return r.labeledTab("textTabs", label, p, func(t *model.Tabs, b model.TabBase, p model.TabPosition) {
+		tx := model.Text{TabBase: b, TabPosition: p, Required: req}
+		tx.Value = value
+		t.TextTabs = append(t.TextTabs, tx)
+	})
+}
+
+// Checkbox adds a checkbox tab.
+func (r *Recipient) Checkbox(label string, p Position, selected bool) *Recipient {
+	sel := model.FALSE
+	if selected {
+		sel = model.TRUE
+	}
+	return r.labeledTab("checkboxTabs", label, p, func(t *model.Tabs, b model.TabBase, p model.TabPosition) {
+		t.CheckboxTabs = append(t.CheckboxTabs, model.Checkbox{TabBase: b, TabPosition: p, Selected: sel})
+	})
+}
+
+// buildTabs validates the recipient's tabs and returns them with
+// recipient and document ids assigned.
+func (r *Recipient) buildTabs(b *Builder, v *validator) *model.Tabs {
+	tabs := &model.Tabs{}
+	counts := make(map[string]int)
+	for _, t := range r.tabs {
+		path := fmt.Sprintf("%s.tabs.%s[%d]", r.path, t.kind, counts[t.kind])
+		counts[t.kind]++
+
+		p := t.pos
+		base := model.TabBase{RecipientID: r.id}
+		pos := model.TabPosition{TabLabel: t.label}
+		if p.Document != nil {
+			if !b.hasDocument(p.Document) {
+				v.add(path, "tab references a document not added to the envelope")
+			}
+			base.DocumentID = p.Document.id
+		}
+		if p.AnchorString > "" {
+			pos.AnchorString = p.AnchorString
+			pos.AnchorXOffset = strconv.Itoa(p.AnchorXOffset)
+			pos.AnchorYOffset = strconv.Itoa(p.AnchorYOffset)
+			pos.AnchorUnits = p.AnchorUnits
+			if pos.AnchorUnits == "" {
+				pos.AnchorUnits = "pixels"
+			}
+			if p.AnchorIgnoreIfNotPresent {
+				pos.AnchorIgnoreIfNotPresent = model.TRUE
+			}
+		} else {
+			switch {
+			case p.Document == nil:
+				v.add(path, "tab requires a document or an anchor string")
+			case p.Page < 1:
+				v.add(path, "invalid page number %d", p.Page)
+			case p.X < 0 || p.Y < 0:
+				v.add(path, "invalid position %d, %d", p.X, p.Y)
+			}
+			pos.PageNumber = strconv.Itoa(p.Page)
+			pos.XPosition = strconv.Itoa(p.X)
+			pos.YPosition = strconv.Itoa(p.Y)
+		}
+		t.add(tabs, base, pos)
+	}
+	return tabs
+}
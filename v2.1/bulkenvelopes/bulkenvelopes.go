@@ -11,6 +11,10 @@
 // Use the BulkEnvelopes category to manage the sending of envelopes to multiple recipients.
 //
 //
+// The specification lists no field constraints for this API's models,
+// so they have no Validate methods and ops have no ValidateFirst option.
+// Constraints may be added with a gen-esign overrides file.
+//
 // Service Api documentation may be found at:
 // https://developers.docusign.com/docs/esign-rest-api/reference/BulkEnvelopes
 // Usage example:
@@ -27,6 +31,7 @@ import (
 	"context"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/jfcote87/esign"
 	"github.com/jfcote87/esign/v2.1/model"
@@ -207,9 +212,9 @@ func (op *BulkSendGetBulkSendBatchEnvelopesOp) Order(val string) *BulkSendGetBul
 // - `status`
 // - `subject`
 // - `status_changed`
-func (op *BulkSendGetBulkSendBatchEnvelopesOp) OrderBy(val string) *BulkSendGetBulkSendBatchEnvelopesOp {
+func (op *BulkSendGetBulkSendBatchEnvelopesOp) OrderBy(val OrderBy) *BulkSendGetBulkSendBatchEnvelopesOp {
 	if op != nil {
-		op.QueryOpts.Set("order_by", val)
+		op.QueryOpts.Set("order_by", string(val))
 	}
 	return op
 }
@@ -241,9 +246,9 @@ func (op *BulkSendGetBulkSendBatchEnvelopesOp) StartPosition(val string) *BulkSe
 // Note that `any` should not be included with other statuses. In other words, `any` is a valid parameter value, but `any,sent` is not.
 //
 // Use the value `deliveryfailure` to get all envelopes with `AuthFailed` and `AutoResponded` status. This value is specific to bulk sending.
-func (op *BulkSendGetBulkSendBatchEnvelopesOp) Status(val string) *BulkSendGetBulkSendBatchEnvelopesOp {
+func (op *BulkSendGetBulkSendBatchEnvelopesOp) Status(val ...string) *BulkSendGetBulkSendBatchEnvelopesOp {
 	if op != nil {
-		op.QueryOpts.Set("status", val)
+		op.QueryOpts.Set("status", strings.Join(val, ","))
 	}
 	return op
 }
@@ -299,9 +304,9 @@ func (op *BulkSendGetBulkSendBatchesOp) Do(ctx context.Context) (*model.BulkSend
 }
 
 // BatchIds is a comma-separated list of batch IDs to query.
-func (op *BulkSendGetBulkSendBatchesOp) BatchIds(val string) *BulkSendGetBulkSendBatchesOp {
+func (op *BulkSendGetBulkSendBatchesOp) BatchIds(val ...string) *BulkSendGetBulkSendBatchesOp {
 	if op != nil {
-		op.QueryOpts.Set("batch_ids", val)
+		op.QueryOpts.Set("batch_ids", strings.Join(val, ","))
 	}
 	return op
 }
@@ -322,9 +327,9 @@ func (op *BulkSendGetBulkSendBatchesOp) Count(val string) *BulkSendGetBulkSendBa
 // FromDate is the start date for a date range in UTC DateTime format.
 //
 // **Note:** If this property is null, no date filtering is applied.
-func (op *BulkSendGetBulkSendBatchesOp) FromDate(val string) *BulkSendGetBulkSendBatchesOp {
+func (op *BulkSendGetBulkSendBatchesOp) FromDate(val time.Time) *BulkSendGetBulkSendBatchesOp {
 	if op != nil {
-		op.QueryOpts.Set("from_date", val)
+		op.QueryOpts.Set("from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -367,9 +372,9 @@ func (op *BulkSendGetBulkSendBatchesOp) Status(val string) *BulkSendGetBulkSendB
 // ToDate is the end of a search date range in UTC DateTime format. When you use this parameter, only templates created up to this date and time are returned.
 //
 // **Note:** If this property is null, the value defaults to the current date.
-func (op *BulkSendGetBulkSendBatchesOp) ToDate(val string) *BulkSendGetBulkSendBatchesOp {
+func (op *BulkSendGetBulkSendBatchesOp) ToDate(val time.Time) *BulkSendGetBulkSendBatchesOp {
 	if op != nil {
-		op.QueryOpts.Set("to_date", val)
+		op.QueryOpts.Set("to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -509,3 +514,18 @@ func (op *BulkSendUpdateBulkSendListOp) Do(ctx context.Context) (*model.BulkSend
 	var res *model.BulkSendingList
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
+
+
+// OrderBy is a value of the order_by query option.
+type OrderBy string
+
+// OrderBy values
+const (
+	OrderByCreated       OrderBy = "created"
+	OrderByCompleted     OrderBy = "completed"
+	OrderByLastModified  OrderBy = "last_modified"
+	OrderBySent          OrderBy = "sent"
+	OrderByStatus        OrderBy = "status"
+	OrderBySubject       OrderBy = "subject"
+	OrderByStatusChanged OrderBy = "status_changed"
+)
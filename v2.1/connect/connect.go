@@ -63,6 +63,10 @@
 // To submit existing envelopes to an endpoint, use the [EnvelopePublish](/docs/esign-rest-api/reference/envelopes/envelopepublish/) resource.
 //
 //
+// The specification lists no field constraints for this API's models,
+// so they have no Validate methods and ops have no ValidateFirst option.
+// Constraints may be added with a gen-esign overrides file.
+//
 // Service Api documentation may be found at:
 // https://developers.docusign.com/docs/esign-rest-api/reference/Connect
 // Usage example:
@@ -220,6 +224,45 @@ func (op *ConfigurationsListUsersOp) Do(ctx context.Context) (*model.IntegratedU
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *ConfigurationsListUsersOp) Pages(ctx context.Context, f func(*model.IntegratedUserInfoList) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   res.EndPosition,
+			NextURI:       &res.NextURI,
+			ResultSetSize: res.ResultSetSize,
+			StartPosition: res.StartPosition,
+			TotalSetSize:  res.TotalSetSize,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // Count is the maximum number of results to return.
 //
 // Use `start_position` to specify the number of results to skip.
@@ -446,7 +489,7 @@ func (op *EventsListOp) Do(ctx context.Context) (*model.ConnectLogs, error) {
 // **Note:** If this property is null, no date filtering is applied.
 func (op *EventsListOp) FromDate(val time.Time) *EventsListOp {
 	if op != nil {
-		op.QueryOpts.Set("from_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -456,7 +499,7 @@ func (op *EventsListOp) FromDate(val time.Time) *EventsListOp {
 // **Note:** If this property is null, the value defaults to the current date.
 func (op *EventsListOp) ToDate(val time.Time) *EventsListOp {
 	if op != nil {
-		op.QueryOpts.Set("to_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -491,7 +534,7 @@ func (op *EventsListFailuresOp) Do(ctx context.Context) (*model.ConnectLogs, err
 // **Note:** If this property is null, no date filtering is applied.
 func (op *EventsListFailuresOp) FromDate(val time.Time) *EventsListFailuresOp {
 	if op != nil {
-		op.QueryOpts.Set("from_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -501,7 +544,7 @@ func (op *EventsListFailuresOp) FromDate(val time.Time) *EventsListFailuresOp {
 // **Note:** If this property is null, the value defaults to the current date.
 func (op *EventsListFailuresOp) ToDate(val time.Time) *EventsListFailuresOp {
 	if op != nil {
-		op.QueryOpts.Set("to_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
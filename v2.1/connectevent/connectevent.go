@@ -0,0 +1,166 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package connectevent decodes the JSON messages sent by Connect
+// configurations using the restv2.1 event format (model.ConnectEventData
+// Version "restv2.1").  Use esign.ConnectHandler to receive and verify
+// messages then pass the message to FromMessage.
+//
+//   handler := &esign.ConnectHandler{
+//       Secrets: []string{hmacKey},
+//       Handle: func(ctx context.Context, msg *esign.ConnectMessage) error {
+//           ev, err := connectevent.FromMessage(msg)
+//           if err != nil {
+//               return err
+//           }
+//           switch ev.Event {
+//           case connectevent.EnvelopeCompleted:
+//               return saveEnvelope(ctx, ev.Data.EnvelopeSummary)
+//           }
+//           return nil
+//       },
+//   }
+//
+// Connect documentation may be found at:
+// https://developers.docusign.com/platform/webhooks/connect/json-sim-event-model/
+package connectevent // import "github.com/jfcote87/esign/v2.1/connectevent"
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+// Type identifies the trigger of a Connect event.
+type Type string
+
+// Envelope events
+const (
+	EnvelopeCreated   Type = "envelope-created"
+	EnvelopeSent      Type = "envelope-sent"
+	EnvelopeResent    Type = "envelope-resent"
+	EnvelopeDelivered Type = "envelope-delivered"
+	EnvelopeCompleted Type = "envelope-completed"
+	EnvelopeDeclined  Type = "envelope-declined"
+	EnvelopeVoided    Type = "envelope-voided"
+	EnvelopeCorrected Type = "envelope-corrected"
+	EnvelopePurge     Type = "envelope-purge"
+	EnvelopeDeleted   Type = "envelope-deleted"
+	EnvelopeDiscard   Type = "envelope-discard"
+)
+
+// Recipient events
+const (
+	RecipientSent                 Type = "recipient-sent"
+	RecipientAutoResponded        Type = "recipient-autoresponded"
+	RecipientDelivered            Type = "recipient-delivered"
+	RecipientCompleted            Type = "recipient-completed"
+	RecipientDeclined             Type = "recipient-declined"
+	RecipientAuthenticationFailed Type = "recipient-authenticationfailed"
+	RecipientResent               Type = "recipient-resent"
+	RecipientDelegate             Type = "recipient-delegate"
+	RecipientReassign             Type = "recipient-reassign"
+	RecipientFinishLater          Type = "recipient-finish-later"
+)
+
+// Template events
+const (
+	TemplateCreated  Type = "template-created"
+	TemplateModified Type = "template-modified"
+	TemplateDeleted  Type = "template-deleted"
+)
+
+var knownTypes = map[Type]bool{
+	EnvelopeCreated: true, EnvelopeSent: true, EnvelopeResent: true, EnvelopeDelivered: true,
+	EnvelopeCompleted: true, EnvelopeDeclined: true, EnvelopeVoided: true, EnvelopeCorrected: true,
+	EnvelopePurge: true, EnvelopeDeleted: true, EnvelopeDiscard: true,
+	RecipientSent: true, RecipientAutoResponded: true, RecipientDelivered: true, RecipientCompleted: true,
+	RecipientDeclined: true, RecipientAuthenticationFailed: true, RecipientResent: true,
+	RecipientDelegate: true, RecipientReassign: true, RecipientFinishLater: true,
+	TemplateCreated: true, TemplateModified: true, TemplateDeleted: true,
+}
+
+// Known returns true if t is one of the defined event types.
+func (t Type) Known() bool {
+	return knownTypes[t]
+}
+
+// IsEnvelope returns true for envelope events.
+func (t Type) IsEnvelope() bool {
+	return strings.HasPrefix(string(t), "envelope-")
+}
+
+// IsRecipient returns true for recipient events.
+func (t Type) IsRecipient() bool {
+	return strings.HasPrefix(string(t), "recipient-")
+}
+
+// IsTemplate returns true for template events.
+func (t Type) IsTemplate() bool {
+	return strings.HasPrefix(string(t), "template-")
+}
+
+// Event is a JSON Connect message.
+type Event struct {
+	// Event is the trigger of the message
+	Event Type `json:"event"`
+	// APIVersion is the version of the api used to create the data
+	APIVersion string `json:"apiVersion,omitempty"`
+	// URI is the api path of the envelope or template
+	URI string `json:"uri,omitempty"`
+	// RetryCount is the number of times the message has been resent
+	RetryCount int `json:"retryCount,omitempty"`
+	// ConfigurationID identifies the Connect configuration
+	ConfigurationID int64 `json:"configurationId,omitempty"`
+	// GeneratedDateTime is when the message was created
+	GeneratedDateTime esign.DSTime `json:"generatedDateTime,omitempty"`
+	// Data contains the event's ids and envelope
+	Data Data `json:"data"`
+}
+
+// Data contains the ids and objects associated with the event.
+type Data struct {
+	AccountID   string `json:"accountId,omitempty"`
+	UserID      string `json:"userId,omitempty"`
+	EnvelopeID  string `json:"envelopeId,omitempty"`
+	TemplateID  string `json:"templateId,omitempty"`
+	RecipientID string `json:"recipientId,omitempty"`
+	// EnvelopeSummary is included when the Connect configuration's
+	// IncludeData or the envelope's EventNotification request it.
+	EnvelopeSummary *model.Envelope `json:"envelopeSummary,omitempty"`
+	// Created is the creation time of a template
+	Created esign.DSTime `json:"created,omitempty"`
+	// Name is the name of a template
+	Name string `json:"name,omitempty"`
+}
+
+// ErrNotJSON is returned by FromMessage when the message is XML.
+var ErrNotJSON = errors.New("connect message is not json")
+
+// Decode reads a JSON Connect message from r.
+func Decode(r io.Reader) (*Event, error) {
+	var ev *Event
+	if err := json.NewDecoder(r).Decode(&ev); err != nil {
+		return nil, err
+	}
+	if ev == nil || ev.Event == "" {
+		return nil, errors.New("connect message has no event")
+	}
+	return ev, nil
+}
+
+// FromMessage decodes the JSON body of a message received by an
+// esign.ConnectHandler.
+func FromMessage(msg *esign.ConnectMessage) (*Event, error) {
+	if msg == nil || msg.JSON == nil {
+		return nil, ErrNotJSON
+	}
+	return Decode(bytes.NewReader(msg.JSON))
+}
@@ -0,0 +1,75 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package connectevent_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/v2.1/connectevent"
+)
+
+const completedEvent = `{
+	"event": "envelope-completed",
+	"apiVersion": "v2.1",
+	"uri": "/restapi/v2.1/accounts/ACCT/envelopes/ENV",
+	"retryCount": 2,
+	"configurationId": 10418,
+	"generatedDateTime": "2021-05-05T18:11:06.9873779Z",
+	"data": {
+		"accountId": "ACCT",
+		"userId": "USER",
+		"envelopeId": "ENV",
+		"envelopeSummary": {
+			"status": "completed",
+			"emailSubject": "Please sign",
+			"recipients": {
+				"signers": [{"email": "a@example.com", "name": "A", "recipientId": "1", "status": "completed"}]
+			}
+		}
+	}
+}`
+
+func TestFromMessage(t *testing.T) {
+	ev, err := connectevent.FromMessage(&esign.ConnectMessage{JSON: []byte(completedEvent)})
+	if err != nil {
+		t.Fatalf("expected success; got %v", err)
+	}
+	if ev.Event != connectevent.EnvelopeCompleted || !ev.Event.Known() || !ev.Event.IsEnvelope() || ev.Event.IsRecipient() {
+		t.Errorf("expected known envelope event %s; got %s", connectevent.EnvelopeCompleted, ev.Event)
+	}
+	if ev.RetryCount != 2 || ev.ConfigurationID != 10418 || ev.Data.EnvelopeID != "ENV" {
+		t.Errorf("expected retryCount 2, configurationId 10418, envelopeId ENV; got %d, %d, %s", ev.RetryCount, ev.ConfigurationID, ev.Data.EnvelopeID)
+	}
+	if tm := ev.GeneratedDateTime.Time(); !tm.Equal(time.Date(2021, 5, 5, 18, 11, 6, 987377900, time.UTC)) {
+		t.Errorf("expected generatedDateTime 2021-05-05T18:11:06.9873779Z; got %v", tm)
+	}
+	env := ev.Data.EnvelopeSummary
+	if env == nil || env.Status != "completed" || env.Recipients == nil || len(env.Recipients.Signers) != 1 {
+		t.Fatalf("expected envelope summary with 1 signer; got %#v", env)
+	}
+
+	if _, err = connectevent.FromMessage(&esign.ConnectMessage{XML: &esign.ConnectData{}}); err != connectevent.ErrNotJSON {
+		t.Errorf("expected ErrNotJSON; got %v", err)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	ev, err := connectevent.Decode(strings.NewReader(`{"event":"recipient-finish-later","data":{"recipientId":"2"}}`))
+	if err != nil || ev.Event != connectevent.RecipientFinishLater || !ev.Event.IsRecipient() || ev.Data.RecipientID != "2" {
+		t.Errorf("expected recipient-finish-later event for recipient 2; got %#v %v", ev, err)
+	}
+	if ev, err = connectevent.Decode(strings.NewReader(`{"event":"new-event"}`)); err != nil || ev.Event.Known() {
+		t.Errorf("expected unknown event type; got %#v %v", ev, err)
+	}
+	for _, s := range []string{`{"data":{}}`, `null`, `{bad`} {
+		if _, err = connectevent.Decode(strings.NewReader(s)); err == nil {
+			t.Errorf("expected error decoding %s", s)
+		}
+	}
+}
@@ -13,6 +13,10 @@
 // This category enables custom tabs to be managed programmatically, including creation, deletion, etc.
 //
 //
+// The specification lists no field constraints for this API's models,
+// so they have no Validate methods and ops have no ValidateFirst option.
+// Constraints may be added with a gen-esign overrides file.
+//
 // Service Api documentation may be found at:
 // https://developers.docusign.com/docs/esign-rest-api/reference/CustomTabs
 // Usage example:
@@ -15,6 +15,10 @@
 // **Note:** This feature is only available for certain account plans and must be enabled by DocuSign.
 //
 //
+// The specification lists no field constraints for this API's models,
+// so they have no Validate methods and ops have no ValidateFirst option.
+// Constraints may be added with a gen-esign overrides file.
+//
 // Service Api documentation may be found at:
 // https://developers.docusign.com/docs/esign-rest-api/reference/EmailArchive
 // Usage example:
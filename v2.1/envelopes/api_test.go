@@ -0,0 +1,96 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package envelopes_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/esigntest"
+	"github.com/jfcote87/esign/v2.1/envelopes"
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+// envelopeStatus is business logic depending upon the API interface
+func envelopeStatus(ctx context.Context, api envelopes.API, envelopeID string) (string, error) {
+	env, err := api.Get(ctx, envelopeID)
+	if err != nil {
+		return "", err
+	}
+	return env.Status, nil
+}
+
+func TestService_API(t *testing.T) {
+	ctx := context.Background()
+	srv := esigntest.NewServer()
+	defer srv.Close()
+	sv := envelopes.New(srv.Credential())
+	id := createSentEnvelope(t, sv)
+	if status, err := envelopeStatus(ctx, sv.API(), id); err != nil || status != "sent" {
+		t.Errorf("expected sent; got %q, %v", status, err)
+	}
+	if _, err := envelopeStatus(ctx, sv.API(), "missing"); !esign.IsNotFound(err) {
+		t.Errorf("expected not found error; got %v", err)
+	}
+}
+
+func TestStub(t *testing.T) {
+	ctx := context.Background()
+	stub := &envelopes.Stub{
+		GetFunc: func(ctx context.Context, envelopeID string) (*model.Envelope, error) {
+			if envelopeID == "missing" {
+				return nil, &esign.ResponseError{Status: 400, ErrorCode: "ENVELOPE_DOES_NOT_EXIST"}
+			}
+			return &model.Envelope{EnvelopeID: envelopeID, Status: "completed"}, nil
+		},
+	}
+	if status, err := envelopeStatus(ctx, stub, "1"); err != nil || status != "completed" {
+		t.Errorf("expected completed; got %q, %v", status, err)
+	}
+	if _, err := envelopeStatus(ctx, stub, "missing"); !esign.IsNotFound(err) {
+		t.Errorf("expected not found error; got %v", err)
+	}
+	if err := stub.DeleteDocumentPage(ctx, "1", "2", "3"); err != nil {
+		t.Errorf("expected nil error from unset DeleteDocumentPageFunc; got %v", err)
+	}
+	if res, err := stub.Create(ctx, &model.EnvelopeDefinition{}); res != nil || err != nil {
+		t.Errorf("expected zero values from unset CreateFunc; got %v, %v", res, err)
+	}
+	calls := stub.Calls("Get")
+	if len(calls) != 2 || calls[0].Args[0] != "1" || calls[1].Args[0] != "missing" {
+		t.Errorf("expected Get calls for 1 and missing; got %#v", calls)
+	}
+	if len(stub.Calls("")) != 4 {
+		t.Errorf("expected 4 calls; got %d", len(stub.Calls("")))
+	}
+	stub.Reset()
+	if len(stub.Calls("")) != 0 {
+		t.Errorf("expected no calls after Reset")
+	}
+	var re *esign.ResponseError
+	if _, err := stub.Get(ctx, "missing"); !errors.As(err, &re) {
+		t.Errorf("expected *esign.ResponseError; got %v", err)
+	}
+}
+
+func TestListStatusChangesOp_DateOptions(t *testing.T) {
+	tm := time.Date(2022, 3, 1, 10, 15, 30, 123456789, time.UTC)
+	op := envelopes.New(nil).ListStatusChanges().FromDate(tm).LastQueriedDate(tm)
+	want := "2022-03-01T10:15:30.123456789Z"
+	for _, nm := range []string{"from_date", "last_queried_date"} {
+		got := op.QueryOpts.Get(nm)
+		if got != want {
+			t.Errorf("expected %s %s; got %s", nm, want, got)
+		}
+		// the value round trips as returned in LastQueriedDateTime
+		if parsed, err := time.Parse(time.RFC3339Nano, got); err != nil || !parsed.Equal(tm) {
+			t.Errorf("expected %s to parse as %v; got %v %v", nm, tm, parsed, err)
+		}
+	}
+}
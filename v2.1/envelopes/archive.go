@@ -0,0 +1,416 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package envelopes
+
+// archive.go contains the Archiver which saves an envelope's documents,
+// certificate, form data and audit events to a Sink.
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+// DefaultArchiveParallel is the default number of envelopes archived
+// concurrently.
+const DefaultArchiveParallel = 4
+
+// ManifestName is the name of an envelope's manifest file within
+// its archive directory.
+const ManifestName = "manifest.json"
+
+// ErrNotCompleted is wrapped by the ArchiveError of an envelope whose
+// status is not completed.
+var ErrNotCompleted = errors.New("envelope is not completed")
+
+// Sink stores the files of envelope archives.  File names are slash
+// separated paths beginning with the envelope id.  Implementations
+// must be safe for concurrent use.
+type Sink interface {
+	// Put stores the content read from r as name.  If r returns an
+	// error, Put must return the error and must not store a partial
+	// file.
+	Put(ctx context.Context, name string, r io.Reader) error
+	// Exists reports whether name has been stored.
+	Exists(ctx context.Context, name string) (bool, error)
+}
+
+// ArchiveFile describes a downloaded file in an ArchiveManifest.
+type ArchiveFile struct {
+	// Name is the file's name in the Sink
+	Name         string `json:"name"`
+	DocumentID   string `json:"documentId"`
+	DocumentName string `json:"documentName,omitempty"`
+	ContentType  string `json:"contentType,omitempty"`
+	Size         int64  `json:"size"`
+	// SHA256 is the hex encoded hash of the file's content
+	SHA256 string `json:"sha256"`
+}
+
+// ArchiveManifest describes an archived envelope and is saved as
+// the envelope's manifest.json.
+type ArchiveManifest struct {
+	EnvelopeID        string                     `json:"envelopeId"`
+	Status            string                     `json:"status"`
+	EmailSubject      string                     `json:"emailSubject,omitempty"`
+	CompletedDateTime *time.Time                 `json:"completedDateTime,omitempty"`
+	ArchivedDateTime  time.Time                  `json:"archivedDateTime"`
+	Files             []ArchiveFile              `json:"files"`
+	Recipients        *model.Recipients          `json:"recipients,omitempty"`
+	FormData          *model.EnvelopeFormData    `json:"formData,omitempty"`
+	AuditEvents       []model.EnvelopeAuditEvent `json:"auditEvents,omitempty"`
+}
+
+// ArchiveError reports the failure to archive an envelope.
+type ArchiveError struct {
+	EnvelopeID string
+	Err        error
+}
+
+// Error returns the envelope id and error.
+func (a *ArchiveError) Error() string {
+	return "archive " + a.EnvelopeID + ": " + a.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (a *ArchiveError) Unwrap() error {
+	return a.Err
+}
+
+// ArchiveErrors lists the envelopes that Archive failed to archive.
+type ArchiveErrors []*ArchiveError
+
+// Error lists all errors.
+func (a ArchiveErrors) Error() string {
+	msgs := make([]string, 0, len(a))
+	for _, e := range a {
+		msgs = append(msgs, e.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Archiver saves envelopes to a Sink.  For each envelope, the Archiver
+// saves each document, the certificate of completion and a manifest
+// containing file hashes, recipients, form data and audit events.  The
+// manifest is saved last, and envelopes with a saved manifest are
+// skipped so an interrupted Archive may be restarted.  Only completed
+// envelopes are archived; the ArchiveError of any other envelope wraps
+// ErrNotCompleted and nothing is saved for it.
+type Archiver struct {
+	// IncludeCombined adds the combined pdf of all documents.
+	IncludeCombined bool
+	// IncludeArchive adds the zip archive of all documents.
+	IncludeArchive bool
+	// MaxParallel is the maximum number of envelopes archived
+	// concurrently.  Zero means DefaultArchiveParallel.
+	MaxParallel int
+
+	sv   *Service
+	sink Sink
+}
+
+// NewArchiver returns an Archiver saving envelopes to sink.
+func NewArchiver(sv *Service, sink Sink) *Archiver {
+	return &Archiver{sv: sv, sink: sink}
+}
+
+// Archive saves each envelope and returns the manifests in the order of
+// envelopeIDs.  Manifests of skipped envelopes are nil.  If any envelope
+// fails, Archive returns the manifests along with an ArchiveErrors.
+func (a *Archiver) Archive(ctx context.Context, envelopeIDs ...string) ([]*ArchiveManifest, error) {
+	maxParallel := a.MaxParallel
+	if maxParallel <= 0 {
+		maxParallel = DefaultArchiveParallel
+	}
+	manifests := make([]*ArchiveManifest, len(envelopeIDs))
+	var errs ArchiveErrors
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxParallel)
+	for i, id := range envelopeIDs {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			mu.Lock()
+			errs = append(errs, &ArchiveError{EnvelopeID: id, Err: ctx.Err()})
+			mu.Unlock()
+			continue
+		}
+		wg.Add(1)
+		go func(i int, id string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			m, err := a.archiveEnvelope(ctx, id)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, &ArchiveError{EnvelopeID: id, Err: err})
+				return
+			}
+			manifests[i] = m
+		}(i, id)
+	}
+	wg.Wait()
+	if len(errs) > 0 {
+		return manifests, errs
+	}
+	return manifests, nil
+}
+
+func (a *Archiver) archiveEnvelope(ctx context.Context, envelopeID string) (*ArchiveManifest, error) {
+	if envelopeID == "" {
+		return nil, errors.New("blank envelope id")
+	}
+	manifestName := path.Join(envelopeID, ManifestName)
+	if ok, err := a.sink.Exists(ctx, manifestName); err != nil || ok {
+		return nil, err
+	}
+	env, err := a.sv.Get(envelopeID).Include("recipients").Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if env.Status != "completed" {
+		return nil, fmt.Errorf("%w: status is %s", ErrNotCompleted, env.Status)
+	}
+	m := &ArchiveManifest{
+		EnvelopeID:        envelopeID,
+		Status:            env.Status,
+		EmailSubject:      env.EmailSubject,
+		CompletedDateTime: env.CompletedDateTime,
+		Recipients:        env.Recipients,
+	}
+	docs, err := a.sv.DocumentsList(envelopeID).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range docs.EnvelopeDocuments {
+		if d.Type == "summary" || d.DocumentID == "certificate" {
+			continue
+		}
+		name := path.Join(envelopeID, "documents", safeFileName(d.DocumentID+"-"+d.Name))
+		if err = a.saveDocument(ctx, m, name, d.DocumentID, d.Name); err != nil {
+			return nil, err
+		}
+	}
+	extras := []struct {
+		include bool
+		id      string
+		name    string
+	}{
+		{true, "certificate", "certificate.pdf"},
+		{a.IncludeCombined, "combined", "combined.pdf"},
+		{a.IncludeArchive, "archive", "archive.zip"},
+	}
+	for _, x := range extras {
+		if !x.include {
+			continue
+		}
+		if err = a.saveDocument(ctx, m, path.Join(envelopeID, x.name), x.id, ""); err != nil {
+			return nil, err
+		}
+	}
+	if m.FormData, err = a.sv.FormDataGet(envelopeID).Do(ctx); err != nil {
+		return nil, err
+	}
+	audit, err := a.sv.ListAuditEvents(envelopeID).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m.AuditEvents = audit.AuditEvents
+	m.ArchivedDateTime = time.Now().UTC()
+
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err = a.sink.Put(ctx, manifestName, bytes.NewReader(b)); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// saveDocument downloads a document to the sink and adds it to the
+// manifest's files.
+func (a *Archiver) saveDocument(ctx context.Context, m *ArchiveManifest, name, documentID, documentName string) error {
+	dn, err := a.sv.DocumentsGet(documentID, m.EnvelopeID).Do(ctx)
+	if err != nil {
+		return err
+	}
+	defer dn.Close()
+	h := sha256.New()
+	cnt := &countWriter{}
+	if err = a.sink.Put(ctx, name, io.TeeReader(dn, io.MultiWriter(h, cnt))); err != nil {
+		return err
+	}
+	m.Files = append(m.Files, ArchiveFile{
+		Name:         name,
+		DocumentID:   documentID,
+		DocumentName: documentName,
+		ContentType:  dn.ContentType,
+		Size:         cnt.n,
+		SHA256:       hex.EncodeToString(h.Sum(nil)),
+	})
+	return nil
+}
+
+type countWriter struct {
+	n int64
+}
+
+func (c *countWriter) Write(p []byte) (int, error) {
+	c.n += int64(len(p))
+	return len(p), nil
+}
+
+// safeFileName replaces characters that are not allowed in
+// file names on common systems.
+func safeFileName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r < ' ', strings.ContainsRune(`/\:*?"<>|`, r):
+			return '_'
+		}
+		return r
+	}, name)
+}
+
+// DirSink is a Sink that saves files in a local directory.
+type DirSink struct {
+	dir string
+}
+
+// NewDirSink returns a DirSink saving files to dir.
+func NewDirSink(dir string) (*DirSink, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &DirSink{dir: dir}, nil
+}
+
+func (d *DirSink) filename(name string) (string, error) {
+	clean := path.Clean("/" + name)
+	if clean == "/" || clean[1:] != name {
+		return "", errors.New("invalid file name " + name)
+	}
+	return filepath.Join(d.dir, filepath.FromSlash(name)), nil
+}
+
+// Put writes r to a temporary file that is renamed to name when
+// complete.
+func (d *DirSink) Put(ctx context.Context, name string, r io.Reader) error {
+	fn, err := d.filename(name)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(fn), 0700); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(fn), ".archive-")
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err = os.Rename(tmp.Name(), fn); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
+
+// Exists reports whether the file name exists.
+func (d *DirSink) Exists(ctx context.Context, name string) (bool, error) {
+	fn, err := d.filename(name)
+	if err != nil {
+		return false, err
+	}
+	_, err = os.Stat(fn)
+	switch {
+	case err == nil:
+		return true, nil
+	case os.IsNotExist(err):
+		return false, nil
+	}
+	return false, err
+}
+
+// ZipSink is a Sink that writes files to a zip stream.  Each file is
+// read into memory before it is written so that failed downloads do
+// not corrupt the stream.  Putting a name already written discards the
+// new content, so an Archive that failed part way may be retried with
+// the same ZipSink.  Close must be called to complete the zip.
+type ZipSink struct {
+	mu    sync.Mutex
+	zw    *zip.Writer
+	names map[string]bool
+}
+
+// NewZipSink returns a ZipSink writing to w.
+func NewZipSink(w io.Writer) *ZipSink {
+	return &ZipSink{zw: zip.NewWriter(w), names: make(map[string]bool)}
+}
+
+// Put adds the contents of r to the zip as name.  If name was already
+// written, r is read and discarded.
+func (z *ZipSink) Put(ctx context.Context, name string, r io.Reader) error {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	z.mu.Lock()
+	defer z.mu.Unlock()
+	if z.names[name] {
+		return nil
+	}
+	fw, err := z.zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: time.Now()})
+	if err != nil {
+		return err
+	}
+	if _, err = fw.Write(b); err != nil {
+		return err
+	}
+	z.names[name] = true
+	return nil
+}
+
+// Exists reports whether name was written by the ZipSink.
+func (z *ZipSink) Exists(ctx context.Context, name string) (bool, error) {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+	return z.names[name], nil
+}
+
+// Close writes the zip central directory.  Close does not close the
+// underlying writer.
+func (z *ZipSink) Close() error {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+	return z.zw.Close()
+}
@@ -0,0 +1,241 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package envelopes_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/jfcote87/esign/esigntest"
+	"github.com/jfcote87/esign/v2.1/envelopes"
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+func createCompletedEnvelope(t *testing.T, srv *esigntest.Server, sv *envelopes.Service) string {
+	id := createArchiveEnvelope(t, sv)
+	if err := srv.Sign(id, "1"); err != nil {
+		t.Fatalf("sign expected success; got %v", err)
+	}
+	return id
+}
+
+func createArchiveEnvelope(t *testing.T, sv *envelopes.Service) string {
+	tabs := &model.Tabs{}
+	if _, err := tabs.Add(model.Text{TabPosition: model.TabPosition{TabLabel: "company"}, TabValue: model.TabValue{Value: "ACME"}}); err != nil {
+		t.Fatalf("add tab: %v", err)
+	}
+	summary, err := sv.Create(&model.EnvelopeDefinition{
+		EmailSubject: "Archive",
+		Status:       "sent",
+		Documents: []model.Document{
+			{DocumentID: "1", Name: "terms.pdf", DocumentBase64: []byte("TERMS")},
+			{DocumentID: "2", Name: "a/b:c", DocumentBase64: []byte("OTHER")},
+		},
+		Recipients: &model.Recipients{
+			Signers: []model.Signer{{RecipientID: "1", Name: "Signer", Email: "signer@example.com", Tabs: tabs}},
+		},
+	}).Do(context.Background())
+	if err != nil {
+		t.Fatalf("create envelope expected success; got %v", err)
+	}
+	return summary.EnvelopeID
+}
+
+func TestArchiver_DirSink(t *testing.T) {
+	ctx := context.Background()
+	srv := esigntest.NewServer()
+	defer srv.Close()
+	sv := envelopes.New(srv.Credential())
+	id := createCompletedEnvelope(t, srv, sv)
+
+	dir, err := ioutil.TempDir("", "archive")
+	if err != nil {
+		t.Fatalf("tempdir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	sink, err := envelopes.NewDirSink(dir)
+	if err != nil {
+		t.Fatalf("expected new sink; got %v", err)
+	}
+	a := envelopes.NewArchiver(sv, sink)
+	a.IncludeCombined = true
+	manifests, err := a.Archive(ctx, id, "missing")
+	errs, ok := err.(envelopes.ArchiveErrors)
+	if !ok || len(errs) != 1 || errs[0].EnvelopeID != "missing" {
+		t.Errorf("expected error for missing envelope; got %v", err)
+	}
+	m := manifests[0]
+	if m == nil || manifests[1] != nil {
+		t.Fatalf("expected manifest for %s only; got %v", id, manifests)
+	}
+	var names []string
+	for _, f := range m.Files {
+		names = append(names, f.Name)
+		b, err := ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(f.Name)))
+		h := sha256.Sum256(b)
+		if err != nil || hex.EncodeToString(h[:]) != f.SHA256 || int64(len(b)) != f.Size {
+			t.Errorf("%s expected hash %s and size %d; got %x %d %v", f.Name, f.SHA256, f.Size, h, len(b), err)
+		}
+	}
+	want := []string{id + "/documents/1-terms.pdf", id + "/documents/2-a_b_c", id + "/certificate.pdf", id + "/combined.pdf"}
+	if strings.Join(names, ",") != strings.Join(want, ",") {
+		t.Errorf("expected files %v; got %v", want, names)
+	}
+	if m.Status != "completed" || m.Recipients == nil || len(m.Recipients.Signers) != 1 || len(m.AuditEvents) < 3 {
+		t.Errorf("expected completed envelope with recipients and audit events; got %#v", m)
+	}
+	if m.FormData == nil || len(m.FormData.FormData) != 1 || m.FormData.FormData[0].Value != "ACME" {
+		t.Errorf("expected form data; got %#v", m.FormData)
+	}
+	var saved *envelopes.ArchiveManifest
+	b, _ := ioutil.ReadFile(filepath.Join(dir, id, envelopes.ManifestName))
+	if err = json.Unmarshal(b, &saved); err != nil || saved.EnvelopeID != id || len(saved.Files) != 4 {
+		t.Errorf("expected saved manifest; got %v %v", saved, err)
+	}
+
+	// archived envelopes are skipped
+	manifests, err = a.Archive(ctx, id)
+	if err != nil || len(manifests) != 1 || manifests[0] != nil {
+		t.Errorf("expected skipped envelope; got %v %v", manifests, err)
+	}
+
+	// failed reads leave no file
+	errRead := errors.New("read error")
+	if err = sink.Put(ctx, "x/file", &errReader{err: errRead}); err != errRead {
+		t.Errorf("expected read error; got %v", err)
+	}
+	if ok, err := sink.Exists(ctx, "x/file"); ok || err != nil {
+		t.Errorf("expected no file; got %v %v", ok, err)
+	}
+	if err = sink.Put(ctx, "../file", strings.NewReader("")); err == nil {
+		t.Errorf("expected invalid name error")
+	}
+}
+
+type errReader struct {
+	err error
+}
+
+func (e *errReader) Read(p []byte) (int, error) {
+	return 0, e.err
+}
+
+func TestArchiver_ZipSink(t *testing.T) {
+	ctx := context.Background()
+	srv := esigntest.NewServer()
+	defer srv.Close()
+	sv := envelopes.New(srv.Credential())
+	ids := []string{createCompletedEnvelope(t, srv, sv), createCompletedEnvelope(t, srv, sv)}
+
+	buf := &bytes.Buffer{}
+	sink := envelopes.NewZipSink(buf)
+	a := envelopes.NewArchiver(sv, sink)
+	a.MaxParallel = 1
+	if _, err := a.Archive(ctx, ids...); err != nil {
+		t.Fatalf("expected success; got %v", err)
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatalf("expected close; got %v", err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("expected valid zip; got %v", err)
+	}
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	sort.Strings(names)
+	var want []string
+	for _, id := range ids {
+		want = append(want, id+"/certificate.pdf", id+"/documents/1-terms.pdf", id+"/documents/2-a_b_c", id+"/manifest.json")
+	}
+	sort.Strings(want)
+	if strings.Join(names, ",") != strings.Join(want, ",") {
+		t.Errorf("expected %v; got %v", want, names)
+	}
+}
+
+func TestArchiver_NotCompleted(t *testing.T) {
+	ctx := context.Background()
+	srv := esigntest.NewServer()
+	defer srv.Close()
+	sv := envelopes.New(srv.Credential())
+	id := createArchiveEnvelope(t, sv)
+
+	sink := envelopes.NewZipSink(ioutil.Discard)
+	manifests, err := envelopes.NewArchiver(sv, sink).Archive(ctx, id)
+	errs, ok := err.(envelopes.ArchiveErrors)
+	if !ok || len(errs) != 1 || !errors.Is(errs[0], envelopes.ErrNotCompleted) || manifests[0] != nil {
+		t.Fatalf("expected ErrNotCompleted; got %v", err)
+	}
+	if ok, _ := sink.Exists(ctx, id+"/certificate.pdf"); ok {
+		t.Errorf("expected no files saved for %s", id)
+	}
+
+	// completed envelope is archived after a failed attempt
+	if err = srv.Sign(id, "1"); err != nil {
+		t.Fatalf("sign expected success; got %v", err)
+	}
+	if _, err = envelopes.NewArchiver(sv, sink).Archive(ctx, id); err != nil {
+		t.Errorf("expected success; got %v", err)
+	}
+}
+
+// failingSink fails the first Put of a manifest.
+type failingSink struct {
+	*envelopes.ZipSink
+	failed bool
+}
+
+func (f *failingSink) Put(ctx context.Context, name string, r io.Reader) error {
+	if strings.HasSuffix(name, envelopes.ManifestName) && !f.failed {
+		f.failed = true
+		return errors.New("put failed")
+	}
+	return f.ZipSink.Put(ctx, name, r)
+}
+
+func TestArchiver_ZipSinkRetry(t *testing.T) {
+	ctx := context.Background()
+	srv := esigntest.NewServer()
+	defer srv.Close()
+	sv := envelopes.New(srv.Credential())
+	id := createCompletedEnvelope(t, srv, sv)
+
+	buf := &bytes.Buffer{}
+	zs := envelopes.NewZipSink(buf)
+	a := envelopes.NewArchiver(sv, &failingSink{ZipSink: zs})
+	if _, err := a.Archive(ctx, id); err == nil {
+		t.Fatalf("expected manifest put failure")
+	}
+	manifests, err := a.Archive(ctx, id)
+	if err != nil || manifests[0] == nil || len(manifests[0].Files) != 3 {
+		t.Fatalf("expected retry to succeed; got %v %v", manifests, err)
+	}
+	if err = zs.Close(); err != nil {
+		t.Fatalf("expected close; got %v", err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("expected valid zip; got %v", err)
+	}
+	if len(zr.File) != 4 {
+		t.Errorf("expected 4 unique files; got %d", len(zr.File))
+	}
+}
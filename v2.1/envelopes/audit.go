@@ -0,0 +1,159 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package envelopes
+
+// audit.go contains the AuditEvent type parsed from the name/value
+// fields of an envelope audit event along with CSV and JSON lines output.
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+// AuditEvent is an envelope audit event with typed fields.
+type AuditEvent struct {
+	// LogTime is the time of the event.  LogTime is zero when the
+	// event's logTime is missing or invalid, and an invalid value is
+	// kept in Other.
+	LogTime time.Time `json:"logTime"`
+	// Source is the client used for the action (e.g. web, API, mobile)
+	Source   string `json:"source,omitempty"`
+	UserName string `json:"userName,omitempty"`
+	UserID   string `json:"userId,omitempty"`
+	Action   string `json:"action,omitempty"`
+	Message  string `json:"message,omitempty"`
+	// EnvelopeStatus is the envelope's status after the event
+	EnvelopeStatus  string `json:"envelopeStatus,omitempty"`
+	ClientIPAddress string `json:"clientIPAddress,omitempty"`
+	// Information contains details of the event such as the
+	// recipient affected by the action
+	Information string `json:"information,omitempty"`
+	// Recipient identifies the recipient of recipient events
+	Recipient   string `json:"recipient,omitempty"`
+	GeoLocation string `json:"geoLocation,omitempty"`
+	Language    string `json:"language,omitempty"`
+	// Other contains fields not listed above
+	Other map[string]string `json:"other,omitempty"`
+}
+
+// auditColumns are the CSV columns and the event field names
+var auditColumns = []string{"logTime", "Source", "UserName", "UserId", "Action", "Message",
+	"EnvelopeStatus", "ClientIPAddress", "Information", "Recipient", "GeoLocation", "Language"}
+
+func (a *AuditEvent) fields() []*string {
+	return []*string{nil, &a.Source, &a.UserName, &a.UserID, &a.Action, &a.Message,
+		&a.EnvelopeStatus, &a.ClientIPAddress, &a.Information, &a.Recipient, &a.GeoLocation, &a.Language}
+}
+
+// ParseAuditEvent converts the EventFields of ev to an AuditEvent.
+func ParseAuditEvent(ev model.EnvelopeAuditEvent) *AuditEvent {
+	a := &AuditEvent{}
+	flds := a.fields()
+	for _, nv := range ev.EventFields {
+		if nv.Name == "logTime" {
+			if tm, err := time.Parse(time.RFC3339Nano, nv.Value); err == nil {
+				a.LogTime = tm
+				continue
+			}
+		}
+		found := false
+		for i, col := range auditColumns {
+			if col == nv.Name && flds[i] != nil {
+				*flds[i], found = nv.Value, true
+				break
+			}
+		}
+		if !found {
+			if a.Other == nil {
+				a.Other = make(map[string]string)
+			}
+			a.Other[nv.Name] = nv.Value
+		}
+	}
+	return a
+}
+
+// ParseAuditEvents converts all events of res to AuditEvents.
+func ParseAuditEvents(res *model.EnvelopeAuditEventResponse) []AuditEvent {
+	if res == nil {
+		return nil
+	}
+	events := make([]AuditEvent, 0, len(res.AuditEvents))
+	for _, ev := range res.AuditEvents {
+		events = append(events, *ParseAuditEvent(ev))
+	}
+	return events
+}
+
+// Events executes the op and returns the parsed audit events.
+func (op *ListAuditEventsOp) Events(ctx context.Context) ([]AuditEvent, error) {
+	res, err := op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuditEvents(res), nil
+}
+
+// WriteAuditCSV writes events as CSV with a header row.  The names
+// of all events' Other fields are added as trailing columns in sorted
+// order.  An invalid logTime kept in Other is written in the logTime
+// column.
+func WriteAuditCSV(w io.Writer, events []AuditEvent) error {
+	var others []string
+	seen := map[string]bool{"logTime": true}
+	for i := range events {
+		for k := range events[i].Other {
+			if !seen[k] {
+				seen[k] = true
+				others = append(others, k)
+			}
+		}
+	}
+	sort.Strings(others)
+	cw := csv.NewWriter(w)
+	if err := cw.Write(append(append([]string{}, auditColumns...), others...)); err != nil {
+		return err
+	}
+	for i := range events {
+		ev := &events[i]
+		rec := make([]string, len(auditColumns), len(auditColumns)+len(others))
+		for j, f := range ev.fields() {
+			if f != nil {
+				rec[j] = *f
+			}
+		}
+		rec[0] = ev.Other["logTime"]
+		if !ev.LogTime.IsZero() {
+			rec[0] = ev.LogTime.Format(time.RFC3339Nano)
+		}
+		for _, k := range others {
+			rec = append(rec, ev.Other[k])
+		}
+		if err := cw.Write(rec); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteAuditJSONLines writes each event as a json object followed
+// by a newline.
+func WriteAuditJSONLines(w io.Writer, events []AuditEvent) error {
+	enc := json.NewEncoder(w)
+	for i := range events {
+		if err := enc.Encode(&events[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
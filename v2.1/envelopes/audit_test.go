@@ -0,0 +1,99 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package envelopes_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jfcote87/esign/esigntest"
+	"github.com/jfcote87/esign/v2.1/envelopes"
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+func TestParseAuditEvents(t *testing.T) {
+	res := &model.EnvelopeAuditEventResponse{
+		AuditEvents: []model.EnvelopeAuditEvent{
+			{EventFields: []model.NameValue{
+				{Name: "logTime", Value: "2022-03-01T10:15:30.123Z"},
+				{Name: "Source", Value: "web"},
+				{Name: "UserName", Value: "Jane Doe"},
+				{Name: "UserId", Value: "U1"},
+				{Name: "Action", Value: "Signed"},
+				{Name: "Message", Value: "Jane Doe signed the envelope"},
+				{Name: "EnvelopeStatus", Value: "completed"},
+				{Name: "ClientIPAddress", Value: "10.0.0.1"},
+				{Name: "Information", Value: "Jane Doe, \"jane@example.com\""},
+				{Name: "Recipient", Value: "jane@example.com"},
+				{Name: "GeoLocation", Value: "40.7,-74.0"},
+				{Name: "Language", Value: "english (us)"},
+				{Name: "Custom", Value: "X"},
+			}},
+			{EventFields: []model.NameValue{
+				{Name: "logTime", Value: "yesterday"},
+				{Name: "Action", Value: "Viewed"},
+				{Name: "Extra", Value: "Y"},
+			}},
+		},
+	}
+	events := envelopes.ParseAuditEvents(res)
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events; got %v", events)
+	}
+	ev := events[0]
+	if !ev.LogTime.Equal(time.Date(2022, 3, 1, 10, 15, 30, 123000000, time.UTC)) || ev.Action != "Signed" ||
+		ev.UserID != "U1" || ev.ClientIPAddress != "10.0.0.1" || ev.Recipient != "jane@example.com" ||
+		ev.GeoLocation != "40.7,-74.0" || ev.Other["Custom"] != "X" {
+		t.Errorf("unexpected event %#v", ev)
+	}
+	if ev = events[1]; !ev.LogTime.IsZero() || ev.Other["logTime"] != "yesterday" || ev.Action != "Viewed" {
+		t.Errorf("expected invalid logTime kept in Other; got %#v", ev)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := envelopes.WriteAuditCSV(buf, events); err != nil {
+		t.Fatalf("expected csv; got %v", err)
+	}
+	want := "logTime,Source,UserName,UserId,Action,Message,EnvelopeStatus,ClientIPAddress,Information,Recipient,GeoLocation,Language,Custom,Extra\n" +
+		"2022-03-01T10:15:30.123Z,web,Jane Doe,U1,Signed,Jane Doe signed the envelope,completed,10.0.0.1,\"Jane Doe, \"\"jane@example.com\"\"\",jane@example.com,\"40.7,-74.0\",english (us),X,\n" +
+		"yesterday,,,,Viewed,,,,,,,,,Y\n"
+	if buf.String() != want {
+		t.Errorf("expected csv %q; got %q", want, buf.String())
+	}
+
+	buf.Reset()
+	if err := envelopes.WriteAuditJSONLines(buf, events); err != nil {
+		t.Fatalf("expected json lines; got %v", err)
+	}
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], `{"logTime":"2022-03-01T10:15:30.123Z","source":"web"`) {
+		t.Errorf("unexpected json lines %q", buf.String())
+	}
+}
+
+func TestListAuditEventsOp_Events(t *testing.T) {
+	srv := esigntest.NewServer()
+	defer srv.Close()
+	sv := envelopes.New(srv.Credential())
+	id := createCompletedEnvelope(t, srv, sv)
+	events, err := sv.ListAuditEvents(id).Events(context.Background())
+	if err != nil {
+		t.Fatalf("expected success; got %v", err)
+	}
+	var statuses []string
+	for _, ev := range events {
+		if ev.LogTime.IsZero() {
+			t.Errorf("expected log time; got %#v", ev)
+		}
+		statuses = append(statuses, ev.EnvelopeStatus)
+	}
+	if want := "created,sent,delivered,completed"; strings.Join(statuses, ",") != want {
+		t.Errorf("expected statuses %s; got %v", want, statuses)
+	}
+}
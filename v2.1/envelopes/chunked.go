@@ -0,0 +1,251 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package envelopes
+
+// chunked.go contains DoChunked which moves large uploads to
+// chunked uploads before creating an envelope.
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+// Chunked upload defaults
+const (
+	// DefaultChunkedUploadThreshold is the size above which an upload
+	// is sent as a chunked upload.
+	DefaultChunkedUploadThreshold = 10 << 20
+	// DefaultChunkSize is the size of each part of a chunked upload.
+	DefaultChunkSize = 5 << 20
+	// DefaultChunkedUploadParallel is the maximum number of parts
+	// uploaded at once.
+	DefaultChunkedUploadParallel = 4
+)
+
+// ChunkedUploadOptions determine which files DoChunked sends as chunked
+// uploads and how they are sent.  Zero values indicate defaults.
+type ChunkedUploadOptions struct {
+	// Threshold is the file size above which a file is sent
+	// as a chunked upload.
+	Threshold int64
+	// ChunkSize is the maximum size of each part.
+	ChunkSize int64
+	// MaxParallel is the maximum number of parts uploaded
+	// concurrently.
+	MaxParallel int
+}
+
+func (o *ChunkedUploadOptions) withDefaults() ChunkedUploadOptions {
+	var opts ChunkedUploadOptions
+	if o != nil {
+		opts = *o
+	}
+	if opts.Threshold <= 0 {
+		opts.Threshold = DefaultChunkedUploadThreshold
+	}
+	if opts.ChunkSize <= 0 {
+		opts.ChunkSize = DefaultChunkSize
+	}
+	if opts.MaxParallel <= 0 {
+		opts.MaxParallel = DefaultChunkedUploadParallel
+	}
+	return opts
+}
+
+// DoChunked executes the op after sending each upload larger than the
+// options' threshold as a chunked upload.  Parts of a chunked upload are
+// sent concurrently up to the options' MaxParallel, and the committed
+// upload's uri replaces the RemoteURL of the envelope document with the
+// matching DocumentID.  Smaller uploads are sent in the create request.
+// The op's envelope definition is not changed.  On failure, DoChunked
+// deletes any chunked uploads it created.  A nil opts indicates default
+// options.
+//
+// DocuSign limits chunked uploads to 50MB and documents to 25MB.
+func (op *CreateOp) DoChunked(ctx context.Context, opts *ChunkedUploadOptions) (*model.EnvelopeSummary, error) {
+	if op == nil {
+		return nil, errors.New("nil op")
+	}
+	if ctx == nil {
+		closeUploads(op.Files)
+		return nil, errors.New("nil context")
+	}
+	def, ok := op.Payload.(*model.EnvelopeDefinition)
+	if !ok || def == nil {
+		closeUploads(op.Files)
+		return nil, errors.New("op payload must be a non-nil *model.EnvelopeDefinition")
+	}
+	defCopy := *def
+	defCopy.Documents = append([]model.Document(nil), def.Documents...)
+	cu := &chunkedUploader{
+		sv:   New(op.Credential),
+		opts: opts.withDefaults(),
+	}
+
+	var inline []*esign.UploadFile
+	for i, f := range op.Files {
+		uf, uri, err := cu.upload(ctx, f, defCopy.Documents)
+		if err != nil {
+			closeUploads(op.Files[i+1:])
+			closeUploads(inline)
+			cu.cleanup(ctx)
+			return nil, err
+		}
+		if uf != nil {
+			inline = append(inline, uf)
+			continue
+		}
+		for j := range defCopy.Documents {
+			if defCopy.Documents[j].DocumentID == f.ID {
+				defCopy.Documents[j].RemoteURL = uri
+			}
+		}
+	}
+	createOp := *op
+	createOp.Payload = &defCopy
+	createOp.Files = inline
+	res, err := createOp.Do(ctx)
+	if err != nil {
+		cu.cleanup(ctx)
+	}
+	return res, err
+}
+
+func closeUploads(files []*esign.UploadFile) {
+	for _, f := range files {
+		f.Close()
+	}
+}
+
+// chunkedUploader tracks the chunked uploads created by a DoChunked call.
+type chunkedUploader struct {
+	sv   *Service
+	opts ChunkedUploadOptions
+	ids  []string
+}
+
+// upload reads f and returns an UploadFile if f is not larger than the
+// threshold.  Otherwise f is sent as a chunked upload, and the committed
+// upload's uri is returned.  f is always closed.
+func (cu *chunkedUploader) upload(ctx context.Context, f *esign.UploadFile, docs []model.Document) (*esign.UploadFile, string, error) {
+	if f == nil || f.Reader == nil {
+		return nil, "", errors.New("upload file has no reader")
+	}
+	defer f.Close()
+	head, err := readChunk(f, cu.opts.Threshold+1)
+	if err != nil {
+		return nil, "", err
+	}
+	if int64(len(head)) <= cu.opts.Threshold {
+		return &esign.UploadFile{
+			ContentType: f.ContentType,
+			FileName:    f.FileName,
+			ID:          f.ID,
+			Reader:      bytes.NewReader(head),
+		}, "", nil
+	}
+	found := false
+	for _, d := range docs {
+		found = found || d.DocumentID == f.ID
+	}
+	if !found {
+		return nil, "", fmt.Errorf("no envelope document found for upload %s (documentId %q)", f.FileName, f.ID)
+	}
+	src := io.MultiReader(bytes.NewReader(head), f)
+
+	chunk, err := readChunk(src, cu.opts.ChunkSize)
+	if err != nil {
+		return nil, "", err
+	}
+	res, err := cu.sv.ChunkedUploadsCreate(&model.ChunkedUploadRequest{Data: chunk}).Do(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	id := res.ChunkedUploadID
+	cu.ids = append(cu.ids, id)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var uploadErr error
+	setErr := func(err error) {
+		mu.Lock()
+		if uploadErr == nil {
+			uploadErr = err
+			cancel()
+		}
+		mu.Unlock()
+	}
+	sem := make(chan struct{}, cu.opts.MaxParallel)
+	for seq := 1; ctx.Err() == nil; seq++ {
+		if chunk, err = readChunk(src, cu.opts.ChunkSize); err != nil {
+			setErr(err)
+			break
+		}
+		if len(chunk) == 0 {
+			break
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			continue
+		}
+		wg.Add(1)
+		go func(seq int, data []byte) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if _, err := cu.sv.ChunkedUploadsUpdate(id, strconv.Itoa(seq), &model.ChunkedUploadRequest{Data: data}).Do(ctx); err != nil {
+				setErr(err)
+			}
+		}(seq, chunk)
+	}
+	wg.Wait()
+	if uploadErr == nil && ctx.Err() != nil {
+		uploadErr = ctx.Err()
+	}
+	if uploadErr != nil {
+		return nil, "", uploadErr
+	}
+	if res, err = cu.sv.ChunkedUploadsCommit(id, nil, "").Action("commit").Do(ctx); err != nil {
+		return nil, "", err
+	}
+	if res.ChunkedUploadURI == "" {
+		return nil, "", fmt.Errorf("no uri returned for chunked upload %s", id)
+	}
+	return nil, res.ChunkedUploadURI, nil
+}
+
+// readChunk reads up to size bytes from r.  A short or empty result
+// indicates the end of r.
+func readChunk(r io.Reader, size int64) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	if _, err := io.CopyN(buf, r, size); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// cleanup deletes all created chunked uploads.  If ctx is done,
+// a background context is used.
+func (cu *chunkedUploader) cleanup(ctx context.Context) {
+	if ctx.Err() != nil {
+		ctx = context.Background()
+	}
+	for _, id := range cu.ids {
+		cu.sv.ChunkedUploadsDelete(id).Do(ctx)
+	}
+}
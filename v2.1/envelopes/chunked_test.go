@@ -0,0 +1,92 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package envelopes_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/esigntest"
+	"github.com/jfcote87/esign/v2.1/envelopes"
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+func TestCreateOp_DoChunked(t *testing.T) {
+	ctx := context.Background()
+	srv := esigntest.NewServer()
+	defer srv.Close()
+	sv := envelopes.New(srv.Credential())
+
+	large := bytes.Repeat([]byte("0123456789"), 105)
+	def := &model.EnvelopeDefinition{
+		EmailSubject: "Chunked",
+		Status:       "sent",
+		Documents: []model.Document{
+			{DocumentID: "1", Name: "large.pdf"},
+			{DocumentID: "2", Name: "small.pdf"},
+		},
+		Recipients: &model.Recipients{
+			Signers: []model.Signer{{RecipientID: "1", Name: "Signer", Email: "signer@example.com"}},
+		},
+	}
+	opts := &envelopes.ChunkedUploadOptions{Threshold: 500, ChunkSize: 100, MaxParallel: 3}
+	summary, err := sv.Create(def,
+		&esign.UploadFile{ID: "1", FileName: "large.pdf", ContentType: "application/pdf", Reader: bytes.NewReader(large)},
+		&esign.UploadFile{ID: "2", FileName: "small.pdf", ContentType: "application/pdf", Reader: strings.NewReader("SMALL")},
+	).DoChunked(ctx, opts)
+	if err != nil {
+		t.Fatalf("expected successful create; got %v", err)
+	}
+	if def.Documents[0].RemoteURL != "" {
+		t.Errorf("expected definition unchanged; got RemoteURL %s", def.Documents[0].RemoteURL)
+	}
+	for _, tt := range []struct {
+		id   string
+		want []byte
+	}{
+		{"1", large},
+		{"2", []byte("SMALL")},
+	} {
+		dn, err := sv.DocumentsGet(tt.id, summary.EnvelopeID).Do(ctx)
+		if err != nil {
+			t.Fatalf("document %s expected success; got %v", tt.id, err)
+		}
+		b, _ := ioutil.ReadAll(dn)
+		dn.Close()
+		if !bytes.Equal(b, tt.want) {
+			t.Errorf("document %s expected %d bytes; got %d", tt.id, len(tt.want), len(b))
+		}
+	}
+	if ids := srv.ChunkedUploadIDs(); len(ids) != 1 {
+		t.Errorf("expected 1 chunked upload; got %v", ids)
+	}
+
+	// failed create removes chunked uploads
+	srv2 := esigntest.NewServer()
+	defer srv2.Close()
+	def.Status = "invalid"
+	_, err = envelopes.New(srv2.Credential()).Create(def,
+		&esign.UploadFile{ID: "1", FileName: "large.pdf", ContentType: "application/pdf", Reader: bytes.NewReader(large)},
+	).DoChunked(ctx, opts)
+	if err == nil {
+		t.Errorf("expected create error for invalid status")
+	}
+	if ids := srv2.ChunkedUploadIDs(); len(ids) != 0 {
+		t.Errorf("expected chunked uploads deleted; got %v", ids)
+	}
+
+	// upload without a matching document
+	_, err = sv.Create(def,
+		&esign.UploadFile{ID: "3", FileName: "large.pdf", ContentType: "application/pdf", Reader: bytes.NewReader(large)},
+	).DoChunked(ctx, opts)
+	if err == nil || !strings.Contains(err.Error(), "no envelope document") {
+		t.Errorf("expected missing document error; got %v", err)
+	}
+}
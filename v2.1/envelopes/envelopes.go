@@ -15,6 +15,10 @@
 // To learn more about envelopes, see [Envelopes](/docs/esign-rest-api/esign101/concepts/envelopes/).
 //
 //
+// The specification lists no field constraints for this API's models,
+// so they have no Validate methods and ops have no ValidateFirst option.
+// Constraints may be added with a gen-esign overrides file.
+//
 // Service Api documentation may be found at:
 // https://developers.docusign.com/docs/esign-rest-api/reference/Envelopes
 // Usage example:
@@ -745,9 +749,9 @@ func (op *DocumentTabsGetOp) IncludeMetadata(val string) *DocumentTabsGetOp {
 // Example: `page_numbers=2,6`
 //
 // Note: You can only enter individual page numbers, and not a page range.
-func (op *DocumentTabsGetOp) PageNumbers(val string) *DocumentTabsGetOp {
+func (op *DocumentTabsGetOp) PageNumbers(val ...string) *DocumentTabsGetOp {
 	if op != nil {
-		op.QueryOpts.Set("page_numbers", val)
+		op.QueryOpts.Set("page_numbers", strings.Join(val, ","))
 	}
 	return op
 }
@@ -1805,9 +1809,13 @@ func (op *TemplatesListByDocumentOp) Do(ctx context.Context) (*model.TemplateInf
 //
 // * `applied`
 // * `matched`
-func (op *TemplatesListByDocumentOp) Include(val ...string) *TemplatesListByDocumentOp {
+func (op *TemplatesListByDocumentOp) Include(val ...TemplatesListByDocumentInclude) *TemplatesListByDocumentOp {
 	if op != nil {
-		op.QueryOpts.Set("include", strings.Join(val, ","))
+		vals := make([]string, len(val))
+		for i, v := range val {
+			vals[i] = string(v)
+		}
+		op.QueryOpts.Set("include", strings.Join(vals, ","))
 	}
 	return op
 }
@@ -2066,9 +2074,13 @@ func (op *GetOp) AdvancedUpdate() *GetOp {
 // - `tabs`: The tabs associated with the envelope.
 // - `payment_tabs`: The payment tabs associated with the envelope.
 // - `workflow`: The workflow definition associated with the envelope.
-func (op *GetOp) Include(val string) *GetOp {
+func (op *GetOp) Include(val ...GetInclude) *GetOp {
 	if op != nil {
-		op.QueryOpts.Set("include", val)
+		vals := make([]string, len(val))
+		for i, v := range val {
+			vals[i] = string(v)
+		}
+		op.QueryOpts.Set("include", strings.Join(vals, ","))
 	}
 	return op
 }
@@ -2179,6 +2191,45 @@ func (op *GetPageImagesOp) Do(ctx context.Context) (*model.PageImages, error) {
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *GetPageImagesOp) Pages(ctx context.Context, f func(*model.PageImages) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   res.EndPosition,
+			NextURI:       &res.NextURI,
+			ResultSetSize: res.ResultSetSize,
+			StartPosition: res.StartPosition,
+			TotalSetSize:  res.TotalSetSize,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // Count is the maximum number of results to return.
 func (op *GetPageImagesOp) Count(val int) *GetPageImagesOp {
 	if op != nil {
@@ -2375,6 +2426,45 @@ func (op *ListStatusOp) Do(ctx context.Context) (*model.EnvelopesInformation, er
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *ListStatusOp) Pages(ctx context.Context, f func(*model.EnvelopesInformation) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			ContinuationToken: res.ContinuationToken,
+			EndPosition:       res.EndPosition,
+			ResultSetSize:     res.ResultSetSize,
+			StartPosition:     res.StartPosition,
+			TotalSetSize:      res.TotalSetSize,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // AcStatus specifies the Authoritative Copy Status for the envelopes. Valid values:
 //
 // - `Unknown`
@@ -2387,9 +2477,9 @@ func (op *ListStatusOp) Do(ctx context.Context) (*model.EnvelopesInformation, er
 // - `Deposited`
 // - `DepositedEO`
 // - `DepositFailed`
-func (op *ListStatusOp) AcStatus(val string) *ListStatusOp {
+func (op *ListStatusOp) AcStatus(val AcStatus) *ListStatusOp {
 	if op != nil {
-		op.QueryOpts.Set("ac_status", val)
+		op.QueryOpts.Set("ac_status", string(val))
 	}
 	return op
 }
@@ -2425,9 +2515,9 @@ func (op *ListStatusOp) Email(val string) *ListStatusOp {
 // The value of this property can be:
 // - A comma-separated list of envelope IDs
 // - The special value `request_body`. In this case, the method uses the envelope IDs in the request body.
-func (op *ListStatusOp) EnvelopeIds(val string) *ListStatusOp {
+func (op *ListStatusOp) EnvelopeIds(val ...string) *ListStatusOp {
 	if op != nil {
-		op.QueryOpts.Set("envelope_ids", val)
+		op.QueryOpts.Set("envelope_ids", strings.Join(val, ","))
 	}
 	return op
 }
@@ -2437,7 +2527,7 @@ func (op *ListStatusOp) EnvelopeIds(val string) *ListStatusOp {
 // **Note:** This parameter must be set to a valid  `DateTime`, or  `envelope_ids` and/or `transaction_ids` must be specified.
 func (op *ListStatusOp) FromDate(val time.Time) *ListStatusOp {
 	if op != nil {
-		op.QueryOpts.Set("from_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -2460,9 +2550,9 @@ func (op *ListStatusOp) FromDate(val time.Time) *ListStatusOp {
 // For example, if you specify `Changed`, this method
 // returns a list of envelopes that changed status
 // during the `from_date` to `to_date` time period.
-func (op *ListStatusOp) FromToStatus(val string) *ListStatusOp {
+func (op *ListStatusOp) FromToStatus(val ListStatusFromToStatus) *ListStatusOp {
 	if op != nil {
-		op.QueryOpts.Set("from_to_status", val)
+		op.QueryOpts.Set("from_to_status", string(val))
 	}
 	return op
 }
@@ -2493,9 +2583,13 @@ func (op *ListStatusOp) StartPosition(val int) *ListStatusOp {
 // - `signed`
 // - `template`
 // - `voided`
-func (op *ListStatusOp) Status(val string) *ListStatusOp {
+func (op *ListStatusOp) Status(val ...ListStatusStatus) *ListStatusOp {
 	if op != nil {
-		op.QueryOpts.Set("status", val)
+		vals := make([]string, len(val))
+		for i, v := range val {
+			vals[i] = string(v)
+		}
+		op.QueryOpts.Set("status", strings.Join(vals, ","))
 	}
 	return op
 }
@@ -2507,7 +2601,7 @@ func (op *ListStatusOp) Status(val string) *ListStatusOp {
 // The default value is the time that you call the method.
 func (op *ListStatusOp) ToDate(val time.Time) *ListStatusOp {
 	if op != nil {
-		op.QueryOpts.Set("to_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -2563,10 +2657,49 @@ func (op *ListStatusChangesOp) Do(ctx context.Context) (*model.EnvelopesInformat
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *ListStatusChangesOp) Pages(ctx context.Context, f func(*model.EnvelopesInformation) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			ContinuationToken: res.ContinuationToken,
+			EndPosition:       res.EndPosition,
+			ResultSetSize:     res.ResultSetSize,
+			StartPosition:     res.StartPosition,
+			TotalSetSize:      res.TotalSetSize,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "continuation_token", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // AcStatus specifies the Authoritative Copy Status for the envelopes. Valid values: Unknown, Original, Transferred, AuthoritativeCopy, AuthoritativeCopyExportPending, AuthoritativeCopyExported, DepositPending, Deposited, DepositedEO, or DepositFailed.
-func (op *ListStatusChangesOp) AcStatus(val string) *ListStatusChangesOp {
+func (op *ListStatusChangesOp) AcStatus(val AcStatus) *ListStatusChangesOp {
 	if op != nil {
-		op.QueryOpts.Set("ac_status", val)
+		op.QueryOpts.Set("ac_status", string(val))
 	}
 	return op
 }
@@ -2642,9 +2775,13 @@ func (op *ListStatusChangesOp) EnvelopeIds(val ...string) *ListStatusChangesOp {
 // - `recipients`
 // - `powerforms`
 // - `folders`
-func (op *ListStatusChangesOp) Exclude(val string) *ListStatusChangesOp {
+func (op *ListStatusChangesOp) Exclude(val ...Exclude) *ListStatusChangesOp {
 	if op != nil {
-		op.QueryOpts.Set("exclude", val)
+		vals := make([]string, len(val))
+		for i, v := range val {
+			vals[i] = string(v)
+		}
+		op.QueryOpts.Set("exclude", strings.Join(vals, ","))
 	}
 	return op
 }
@@ -2675,9 +2812,13 @@ func (op *ListStatusChangesOp) FolderIds(val ...string) *ListStatusChangesOp {
 // - `sentitems`
 // - `draft`
 // - `templates`
-func (op *ListStatusChangesOp) FolderTypes(val string) *ListStatusChangesOp {
+func (op *ListStatusChangesOp) FolderTypes(val ...FolderTypes) *ListStatusChangesOp {
 	if op != nil {
-		op.QueryOpts.Set("folder_types", val)
+		vals := make([]string, len(val))
+		for i, v := range val {
+			vals[i] = string(v)
+		}
+		op.QueryOpts.Set("folder_types", strings.Join(vals, ","))
 	}
 	return op
 }
@@ -2689,7 +2830,7 @@ func (op *ListStatusChangesOp) FolderTypes(val string) *ListStatusChangesOp {
 // are set.
 func (op *ListStatusChangesOp) FromDate(val time.Time) *ListStatusChangesOp {
 	if op != nil {
-		op.QueryOpts.Set("from_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -2697,9 +2838,9 @@ func (op *ListStatusChangesOp) FromDate(val time.Time) *ListStatusChangesOp {
 // FromToStatus this is the status type checked for in the `from_date`/`to_date` period. If `changed` is specified, then envelopes that changed status during the period are found. If for example, `created` is specified, then envelopes created during the period are found. Default is `changed`.
 //
 // Possible values are: Voided, Changed, Created, Deleted, Sent, Delivered, Signed, Completed, Declined, TimedOut and Processing.
-func (op *ListStatusChangesOp) FromToStatus(val string) *ListStatusChangesOp {
+func (op *ListStatusChangesOp) FromToStatus(val ListStatusChangesFromToStatus) *ListStatusChangesOp {
 	if op != nil {
-		op.QueryOpts.Set("from_to_status", val)
+		op.QueryOpts.Set("from_to_status", string(val))
 	}
 	return op
 }
@@ -2716,9 +2857,13 @@ func (op *ListStatusChangesOp) FromToStatus(val string) *ListStatusChangesOp {
 // - `recipients`: The recipients associated with the envelope.
 // - `powerform`: The PowerForms associated with the envelope.
 // - `payment_tabs`: The payment tabs associated with the envelope.
-func (op *ListStatusChangesOp) Include(val string) *ListStatusChangesOp {
+func (op *ListStatusChangesOp) Include(val ...ListStatusChangesInclude) *ListStatusChangesOp {
 	if op != nil {
-		op.QueryOpts.Set("include", val)
+		vals := make([]string, len(val))
+		for i, v := range val {
+			vals[i] = string(v)
+		}
+		op.QueryOpts.Set("include", strings.Join(vals, ","))
 	}
 	return op
 }
@@ -2738,9 +2883,13 @@ func (op *ListStatusChangesOp) IncludePurgeInformation(val string) *ListStatusCh
 // - `sentitems`
 // - `draft`
 // - `templates`
-func (op *ListStatusChangesOp) IntersectingFolderIds(val ...string) *ListStatusChangesOp {
+func (op *ListStatusChangesOp) IntersectingFolderIds(val ...IntersectingFolderIds) *ListStatusChangesOp {
 	if op != nil {
-		op.QueryOpts.Set("intersecting_folder_ids", strings.Join(val, ","))
+		vals := make([]string, len(val))
+		for i, v := range val {
+			vals[i] = string(v)
+		}
+		op.QueryOpts.Set("intersecting_folder_ids", strings.Join(vals, ","))
 	}
 	return op
 }
@@ -2748,9 +2897,9 @@ func (op *ListStatusChangesOp) IntersectingFolderIds(val ...string) *ListStatusC
 // LastQueriedDate returns envelopes that were modified prior to the specified date and time.
 //
 // Example: `2020-05-09T21:56:12.2500000Z`
-func (op *ListStatusChangesOp) LastQueriedDate(val string) *ListStatusChangesOp {
+func (op *ListStatusChangesOp) LastQueriedDate(val time.Time) *ListStatusChangesOp {
 	if op != nil {
-		op.QueryOpts.Set("last_queried_date", val)
+		op.QueryOpts.Set("last_queried_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -2778,9 +2927,9 @@ func (op *ListStatusChangesOp) Order(val string) *ListStatusChangesOp {
 // - `user_name`
 // - `status_changed`
 // - `last_modified`
-func (op *ListStatusChangesOp) OrderBy(val string) *ListStatusChangesOp {
+func (op *ListStatusChangesOp) OrderBy(val OrderBy) *ListStatusChangesOp {
 	if op != nil {
-		op.QueryOpts.Set("order_by", val)
+		op.QueryOpts.Set("order_by", string(val))
 	}
 	return op
 }
@@ -2845,9 +2994,13 @@ func (op *ListStatusChangesOp) StartPosition(val int) *ListStatusChangesOp {
 // * `voided`
 //
 // The `any` value is equivalent to any status.
-func (op *ListStatusChangesOp) Status(val ...string) *ListStatusChangesOp {
+func (op *ListStatusChangesOp) Status(val ...ListStatusChangesStatus) *ListStatusChangesOp {
 	if op != nil {
-		op.QueryOpts.Set("status", strings.Join(val, ","))
+		vals := make([]string, len(val))
+		for i, v := range val {
+			vals[i] = string(v)
+		}
+		op.QueryOpts.Set("status", strings.Join(vals, ","))
 	}
 	return op
 }
@@ -2857,7 +3010,7 @@ func (op *ListStatusChangesOp) Status(val ...string) *ListStatusChangesOp {
 // The default is the current date and time.
 func (op *ListStatusChangesOp) ToDate(val time.Time) *ListStatusChangesOp {
 	if op != nil {
-		op.QueryOpts.Set("to_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -2879,9 +3032,9 @@ func (op *ListStatusChangesOp) TransactionIds(val ...string) *ListStatusChangesO
 // - `sender`
 // - `recipient`
 // - `recipient_only`
-func (op *ListStatusChangesOp) UserFilter(val string) *ListStatusChangesOp {
+func (op *ListStatusChangesOp) UserFilter(val UserFilter) *ListStatusChangesOp {
 	if op != nil {
-		op.QueryOpts.Set("user_filter", val)
+		op.QueryOpts.Set("user_filter", string(val))
 	}
 	return op
 }
@@ -3078,6 +3231,45 @@ func (op *NotaryJournalsListOp) Do(ctx context.Context) (*model.NotaryJournalLis
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *NotaryJournalsListOp) Pages(ctx context.Context, f func(*model.NotaryJournalList) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   res.EndPosition,
+			NextURI:       &res.NextURI,
+			ResultSetSize: res.ResultSetSize,
+			StartPosition: res.StartPosition,
+			TotalSetSize:  res.TotalSetSize,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // Count is the maximum number of results to return.
 func (op *NotaryJournalsListOp) Count(val string) *NotaryJournalsListOp {
 	if op != nil {
@@ -4326,3 +4518,196 @@ type TabsBlobPutTabsBlobOp esign.Op
 func (op *TabsBlobPutTabsBlobOp) Do(ctx context.Context) error {
 	return ((*esign.Op)(op)).Do(ctx, nil)
 }
+
+
+// AcStatus is a value of the ac_status query option.
+type AcStatus string
+
+// AcStatus values
+const (
+	AcStatusUnknown                        AcStatus = "Unknown"
+	AcStatusOriginal                       AcStatus = "Original"
+	AcStatusTransferred                    AcStatus = "Transferred"
+	AcStatusAuthoritativeCopy              AcStatus = "AuthoritativeCopy"
+	AcStatusAuthoritativeCopyExportPending AcStatus = "AuthoritativeCopyExportPending"
+	AcStatusAuthoritativeCopyExported      AcStatus = "AuthoritativeCopyExported"
+	AcStatusDepositPending                 AcStatus = "DepositPending"
+	AcStatusDeposited                      AcStatus = "Deposited"
+	AcStatusDepositedEO                    AcStatus = "DepositedEO"
+	AcStatusDepositFailed                  AcStatus = "DepositFailed"
+)
+
+// Exclude is a value of the exclude query option.
+type Exclude string
+
+// Exclude values
+const (
+	ExcludeRecipients Exclude = "recipients"
+	ExcludePowerforms Exclude = "powerforms"
+	ExcludeFolders    Exclude = "folders"
+)
+
+// FolderTypes is a value of the folder_types query option.
+type FolderTypes string
+
+// FolderTypes values
+const (
+	FolderTypesNormal    FolderTypes = "normal"
+	FolderTypesInbox     FolderTypes = "inbox"
+	FolderTypesSentitems FolderTypes = "sentitems"
+	FolderTypesDraft     FolderTypes = "draft"
+	FolderTypesTemplates FolderTypes = "templates"
+)
+
+// GetInclude is a value of the include query option.
+type GetInclude string
+
+// GetInclude values
+const (
+	GetIncludeCustomFields GetInclude = "custom_fields"
+	GetIncludeDocuments    GetInclude = "documents"
+	GetIncludeAttachments  GetInclude = "attachments"
+	GetIncludeExtensions   GetInclude = "extensions"
+	GetIncludeFolders      GetInclude = "folders"
+	GetIncludeRecipients   GetInclude = "recipients"
+	GetIncludePowerform    GetInclude = "powerform"
+	GetIncludeTabs         GetInclude = "tabs"
+	GetIncludePaymentTabs  GetInclude = "payment_tabs"
+	GetIncludeWorkflow     GetInclude = "workflow"
+)
+
+// IntersectingFolderIds is a value of the intersecting_folder_ids query option.
+type IntersectingFolderIds string
+
+// IntersectingFolderIds values
+const (
+	IntersectingFolderIdsNormal    IntersectingFolderIds = "normal"
+	IntersectingFolderIdsInbox     IntersectingFolderIds = "inbox"
+	IntersectingFolderIdsSentitems IntersectingFolderIds = "sentitems"
+	IntersectingFolderIdsDraft     IntersectingFolderIds = "draft"
+	IntersectingFolderIdsTemplates IntersectingFolderIds = "templates"
+)
+
+// ListStatusChangesFromToStatus is a value of the from_to_status query option.
+type ListStatusChangesFromToStatus string
+
+// ListStatusChangesFromToStatus values
+const (
+	ListStatusChangesFromToStatusVoided     ListStatusChangesFromToStatus = "Voided"
+	ListStatusChangesFromToStatusChanged    ListStatusChangesFromToStatus = "Changed"
+	ListStatusChangesFromToStatusCreated    ListStatusChangesFromToStatus = "Created"
+	ListStatusChangesFromToStatusDeleted    ListStatusChangesFromToStatus = "Deleted"
+	ListStatusChangesFromToStatusSent       ListStatusChangesFromToStatus = "Sent"
+	ListStatusChangesFromToStatusDelivered  ListStatusChangesFromToStatus = "Delivered"
+	ListStatusChangesFromToStatusSigned     ListStatusChangesFromToStatus = "Signed"
+	ListStatusChangesFromToStatusCompleted  ListStatusChangesFromToStatus = "Completed"
+	ListStatusChangesFromToStatusDeclined   ListStatusChangesFromToStatus = "Declined"
+	ListStatusChangesFromToStatusTimedOut   ListStatusChangesFromToStatus = "TimedOut"
+	ListStatusChangesFromToStatusProcessing ListStatusChangesFromToStatus = "Processing"
+)
+
+// ListStatusChangesInclude is a value of the include query option.
+type ListStatusChangesInclude string
+
+// ListStatusChangesInclude values
+const (
+	ListStatusChangesIncludeCustomFields ListStatusChangesInclude = "custom_fields"
+	ListStatusChangesIncludeDocuments    ListStatusChangesInclude = "documents"
+	ListStatusChangesIncludeAttachments  ListStatusChangesInclude = "attachments"
+	ListStatusChangesIncludeExtensions   ListStatusChangesInclude = "extensions"
+	ListStatusChangesIncludeFolders      ListStatusChangesInclude = "folders"
+	ListStatusChangesIncludeRecipients   ListStatusChangesInclude = "recipients"
+	ListStatusChangesIncludePowerform    ListStatusChangesInclude = "powerform"
+	ListStatusChangesIncludePaymentTabs  ListStatusChangesInclude = "payment_tabs"
+)
+
+// ListStatusChangesStatus is a value of the status query option.
+type ListStatusChangesStatus string
+
+// ListStatusChangesStatus values
+const (
+	ListStatusChangesStatusCompleted  ListStatusChangesStatus = "completed"
+	ListStatusChangesStatusCreated    ListStatusChangesStatus = "created"
+	ListStatusChangesStatusDeclined   ListStatusChangesStatus = "declined"
+	ListStatusChangesStatusDeleted    ListStatusChangesStatus = "deleted"
+	ListStatusChangesStatusDelivered  ListStatusChangesStatus = "delivered"
+	ListStatusChangesStatusProcessing ListStatusChangesStatus = "processing"
+	ListStatusChangesStatusSent       ListStatusChangesStatus = "sent"
+	ListStatusChangesStatusSigned     ListStatusChangesStatus = "signed"
+	ListStatusChangesStatusTimedout   ListStatusChangesStatus = "timedout"
+	ListStatusChangesStatusVoided     ListStatusChangesStatus = "voided"
+	ListStatusChangesStatusAny        ListStatusChangesStatus = "any"
+)
+
+// ListStatusFromToStatus is a value of the from_to_status query option.
+type ListStatusFromToStatus string
+
+// ListStatusFromToStatus values
+const (
+	ListStatusFromToStatusChanged    ListStatusFromToStatus = "Changed"
+	ListStatusFromToStatusCompleted  ListStatusFromToStatus = "Completed"
+	ListStatusFromToStatusCreated    ListStatusFromToStatus = "Created"
+	ListStatusFromToStatusDeclined   ListStatusFromToStatus = "Declined"
+	ListStatusFromToStatusDeleted    ListStatusFromToStatus = "Deleted"
+	ListStatusFromToStatusDelivered  ListStatusFromToStatus = "Delivered"
+	ListStatusFromToStatusProcessing ListStatusFromToStatus = "Processing"
+	ListStatusFromToStatusSent       ListStatusFromToStatus = "Sent"
+	ListStatusFromToStatusSigned     ListStatusFromToStatus = "Signed"
+	ListStatusFromToStatusTimedOut   ListStatusFromToStatus = "TimedOut"
+	ListStatusFromToStatusVoided     ListStatusFromToStatus = "Voided"
+)
+
+// ListStatusStatus is a value of the status query option.
+type ListStatusStatus string
+
+// ListStatusStatus values
+const (
+	ListStatusStatusCompleted  ListStatusStatus = "completed"
+	ListStatusStatusCreated    ListStatusStatus = "created"
+	ListStatusStatusDeclined   ListStatusStatus = "declined"
+	ListStatusStatusDeleted    ListStatusStatus = "deleted"
+	ListStatusStatusDelivered  ListStatusStatus = "delivered"
+	ListStatusStatusProcessing ListStatusStatus = "processing"
+	ListStatusStatusSent       ListStatusStatus = "sent"
+	ListStatusStatusSigned     ListStatusStatus = "signed"
+	ListStatusStatusTemplate   ListStatusStatus = "template"
+	ListStatusStatusVoided     ListStatusStatus = "voided"
+)
+
+// OrderBy is a value of the order_by query option.
+type OrderBy string
+
+// OrderBy values
+const (
+	OrderByLastModified   OrderBy = "last_modified"
+	OrderByActionRequired OrderBy = "action_required"
+	OrderByCreated        OrderBy = "created"
+	OrderByCompleted      OrderBy = "completed"
+	OrderByEnvelopeName   OrderBy = "envelope_name"
+	OrderByExpire         OrderBy = "expire"
+	OrderBySent           OrderBy = "sent"
+	OrderBySignerList     OrderBy = "signer_list"
+	OrderByStatus         OrderBy = "status"
+	OrderBySubject        OrderBy = "subject"
+	OrderByUserName       OrderBy = "user_name"
+	OrderByStatusChanged  OrderBy = "status_changed"
+)
+
+// TemplatesListByDocumentInclude is a value of the include query option.
+type TemplatesListByDocumentInclude string
+
+// TemplatesListByDocumentInclude values
+const (
+	TemplatesListByDocumentIncludeApplied TemplatesListByDocumentInclude = "applied"
+	TemplatesListByDocumentIncludeMatched TemplatesListByDocumentInclude = "matched"
+)
+
+// UserFilter is a value of the user_filter query option.
+type UserFilter string
+
+// UserFilter values
+const (
+	UserFilterSender        UserFilter = "sender"
+	UserFilterRecipient     UserFilter = "recipient"
+	UserFilterRecipientOnly UserFilter = "recipient_only"
+)
@@ -0,0 +1,13 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package envelopes
+
+import "time"
+
+// SetWatcherClock replaces the time source of w for testing.
+func SetWatcherClock(w *Watcher, now func() time.Time) {
+	w.now = now
+}
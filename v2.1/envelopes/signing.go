@@ -0,0 +1,281 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package envelopes
+
+// signing.go contains EmbeddedSigning which creates recipient views
+// with a signed state token and verifies the redirect to the return url.
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+// SigningEvent is the event parameter DocuSign adds to the return url
+// of a recipient view.
+type SigningEvent string
+
+// Signing events
+const (
+	SigningAccessCodeFailed SigningEvent = "access_code_failed"
+	SigningCancel           SigningEvent = "cancel"
+	SigningComplete         SigningEvent = "signing_complete"
+	SigningDecline          SigningEvent = "decline"
+	SigningException        SigningEvent = "exception"
+	SigningFaxPending       SigningEvent = "fax_pending"
+	SigningIDCheckFailed    SigningEvent = "id_check_failed"
+	SigningSessionTimeout   SigningEvent = "session_timeout"
+	SigningTTLExpired       SigningEvent = "ttl_expired"
+	SigningViewingComplete  SigningEvent = "viewing_complete"
+)
+
+// Valid reports whether e is a known event.
+func (e SigningEvent) Valid() bool {
+	switch e {
+	case SigningAccessCodeFailed, SigningCancel, SigningComplete, SigningDecline, SigningException,
+		SigningFaxPending, SigningIDCheckFailed, SigningSessionTimeout, SigningTTLExpired, SigningViewingComplete:
+		return true
+	}
+	return false
+}
+
+// DefaultSigningStateTTL is the default time a state token is valid.
+const DefaultSigningStateTTL = time.Hour
+
+// SigningStateParam is the return url query parameter containing the
+// state token.
+const SigningStateParam = "esign_state"
+
+// Errors returned when verifying a signing redirect
+var (
+	ErrSigningStateInvalid = errors.New("invalid signing state")
+	ErrSigningStateExpired = errors.New("signing state expired")
+	ErrSigningEventInvalid = errors.New("invalid signing event")
+	ErrSigningNotCompleted = errors.New("recipient has not completed signing")
+)
+
+// MinSigningKeySize is the minimum length of an EmbeddedSigning key.
+const MinSigningKeySize = 32
+
+// SigningResult is the verified result of a signing redirect.
+type SigningResult struct {
+	EnvelopeID   string
+	RecipientID  string
+	ClientUserID string
+	Event        SigningEvent
+	// Expires is the expiration time of the state token
+	Expires time.Time
+}
+
+// signingState is the payload of a state token
+type signingState struct {
+	EnvelopeID   string `json:"e"`
+	RecipientID  string `json:"r,omitempty"`
+	ClientUserID string `json:"c"`
+	Expires      int64  `json:"x"`
+}
+
+// EmbeddedSigning creates recipient views for embedded signers and
+// verifies the redirects to the views' return urls.  The return url
+// of each view contains a state token, signed with an HMAC key, that
+// identifies the envelope and signer and expires after StateTTL.
+//
+// The event parameter of a redirect is set by the signer's browser, so
+// a signer may change it.  Verify therefore confirms signing_complete
+// events with DocuSign using RecipientsList.  A state token may be used
+// until it expires, so handlers should be idempotent.
+type EmbeddedSigning struct {
+	// StateTTL is the time that a state token is valid.  Zero
+	// means DefaultSigningStateTTL.
+	StateTTL time.Duration
+	// AuthenticationMethod is the method used to authenticate the
+	// signer.  Blank means "none".
+	AuthenticationMethod string
+	// ErrorFunc, if not nil, is called by Handler with each error
+	// before the error response is written.
+	ErrorFunc func(r *http.Request, status int, err error)
+
+	sv  *Service
+	key []byte
+}
+
+// NewEmbeddedSigning returns an EmbeddedSigning that signs state tokens
+// with key.  key must be at least MinSigningKeySize random bytes.
+func NewEmbeddedSigning(sv *Service, key []byte) (*EmbeddedSigning, error) {
+	if len(key) < MinSigningKeySize {
+		return nil, fmt.Errorf("signing key must be at least %d bytes", MinSigningKeySize)
+	}
+	return &EmbeddedSigning{sv: sv, key: key}, nil
+}
+
+// ViewRequest returns the RecipientViewRequest for signer with a state
+// token added to returnURL.  The signer must have a ClientUserID.
+func (es *EmbeddedSigning) ViewRequest(envelopeID string, signer *model.Signer, returnURL string) (*model.RecipientViewRequest, error) {
+	if signer == nil || signer.ClientUserID == "" {
+		return nil, errors.New("signer must have a ClientUserID for embedded signing")
+	}
+	u, err := url.Parse(returnURL)
+	if err != nil {
+		return nil, err
+	}
+	ttl := es.StateTTL
+	if ttl <= 0 {
+		ttl = DefaultSigningStateTTL
+	}
+	state, err := es.sign(&signingState{
+		EnvelopeID:   envelopeID,
+		RecipientID:  signer.RecipientID,
+		ClientUserID: signer.ClientUserID,
+		Expires:      time.Now().Add(ttl).UnixNano(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	q := u.Query()
+	q.Set(SigningStateParam, state)
+	u.RawQuery = q.Encode()
+
+	authMethod := es.AuthenticationMethod
+	if authMethod == "" {
+		authMethod = "none"
+	}
+	return &model.RecipientViewRequest{
+		AuthenticationMethod: authMethod,
+		ClientUserID:         signer.ClientUserID,
+		Email:                signer.Email,
+		RecipientID:          signer.RecipientID,
+		ReturnURL:            u.String(),
+		UserName:             signer.Name,
+	}, nil
+}
+
+// RecipientView creates a recipient view for signer.
+func (es *EmbeddedSigning) RecipientView(ctx context.Context, envelopeID string, signer *model.Signer, returnURL string) (*model.ViewURL, error) {
+	req, err := es.ViewRequest(envelopeID, signer, returnURL)
+	if err != nil {
+		return nil, err
+	}
+	return es.sv.ViewsCreateRecipient(envelopeID, req).Do(ctx)
+}
+
+func (es *EmbeddedSigning) mac(payload string) string {
+	h := hmac.New(sha256.New, es.key)
+	h.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(h.Sum(nil))
+}
+
+func (es *EmbeddedSigning) sign(st *signingState) (string, error) {
+	b, err := json.Marshal(st)
+	if err != nil {
+		return "", err
+	}
+	payload := base64.RawURLEncoding.EncodeToString(b)
+	return payload + "." + es.mac(payload), nil
+}
+
+// Verify checks the state token and event of a redirect to a return url.
+// For signing_complete events, Verify also confirms that DocuSign reports
+// the recipient as completed, returning ErrSigningNotCompleted if not.
+func (es *EmbeddedSigning) Verify(r *http.Request) (*SigningResult, error) {
+	q := r.URL.Query()
+	parts := strings.Split(q.Get(SigningStateParam), ".")
+	if len(parts) != 2 || !hmac.Equal([]byte(parts[1]), []byte(es.mac(parts[0]))) {
+		return nil, ErrSigningStateInvalid
+	}
+	b, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, ErrSigningStateInvalid
+	}
+	var st signingState
+	if err = json.Unmarshal(b, &st); err != nil {
+		return nil, ErrSigningStateInvalid
+	}
+	if time.Now().UnixNano() > st.Expires {
+		return nil, ErrSigningStateExpired
+	}
+	event := SigningEvent(q.Get("event"))
+	if !event.Valid() {
+		return nil, ErrSigningEventInvalid
+	}
+	res := &SigningResult{
+		EnvelopeID:   st.EnvelopeID,
+		RecipientID:  st.RecipientID,
+		ClientUserID: st.ClientUserID,
+		Event:        event,
+		Expires:      time.Unix(0, st.Expires),
+	}
+	if event == SigningComplete {
+		if err = es.VerifyCompleted(r.Context(), res); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// VerifyCompleted returns nil if DocuSign reports that the signer of res
+// has signed, otherwise ErrSigningNotCompleted or the api error.
+func (es *EmbeddedSigning) VerifyCompleted(ctx context.Context, res *SigningResult) error {
+	rcps, err := es.sv.RecipientsList(res.EnvelopeID).Do(ctx)
+	if err != nil {
+		return err
+	}
+	for _, s := range rcps.Signers {
+		if s.ClientUserID != res.ClientUserID || (res.RecipientID > "" && s.RecipientID != res.RecipientID) {
+			continue
+		}
+		switch strings.ToLower(s.Status) {
+		case "completed", "signed":
+			return nil
+		}
+	}
+	return ErrSigningNotCompleted
+}
+
+// Handler returns an http.Handler for the return url that calls f with
+// verified results.  Requests that fail verification receive a 400
+// response or, when the recipient has not signed, a 403 response.  A 502
+// response is sent when DocuSign cannot confirm a signing_complete event.
+// Response bodies contain only the status text; use ErrorFunc to log the
+// error.
+func (es *EmbeddedSigning) Handler(f func(w http.ResponseWriter, r *http.Request, res *SigningResult)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		res, err := es.Verify(r)
+		if err != nil {
+			status := http.StatusBadRequest
+			switch {
+			case err == ErrSigningNotCompleted:
+				status = http.StatusForbidden
+			case !isSigningError(err):
+				// DocuSign could not be reached to confirm completion
+				status = http.StatusBadGateway
+			}
+			if es.ErrorFunc != nil {
+				es.ErrorFunc(r, status, err)
+			}
+			http.Error(w, http.StatusText(status), status)
+			return
+		}
+		f(w, r, res)
+	})
+}
+
+func isSigningError(err error) bool {
+	switch err {
+	case ErrSigningStateInvalid, ErrSigningStateExpired, ErrSigningEventInvalid, ErrSigningNotCompleted:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package envelopes_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jfcote87/esign/esigntest"
+	"github.com/jfcote87/esign/v2.1/envelopes"
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+// followView gets the view url and returns the redirect location.
+func followView(t *testing.T, viewURL string) string {
+	cl := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	res, err := cl.Get(viewURL)
+	if err != nil {
+		t.Fatalf("view %s expected success; got %v", viewURL, err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusFound {
+		t.Fatalf("view %s expected redirect; got %d", viewURL, res.StatusCode)
+	}
+	return res.Header.Get("Location")
+}
+
+func TestEmbeddedSigning(t *testing.T) {
+	ctx := context.Background()
+	srv := esigntest.NewServer()
+	defer srv.Close()
+	sv := envelopes.New(srv.Credential())
+	signer := model.Signer{RecipientID: "1", Name: "Signer", Email: "signer@example.com", ClientUserID: "C1"}
+	summary, err := sv.Create(&model.EnvelopeDefinition{
+		EmailSubject: "Embedded",
+		Status:       "sent",
+		Documents:    []model.Document{{DocumentID: "1", Name: "doc.pdf", DocumentBase64: []byte("PDF")}},
+		Recipients:   &model.Recipients{Signers: []model.Signer{signer}},
+	}).Do(ctx)
+	if err != nil {
+		t.Fatalf("create envelope expected success; got %v", err)
+	}
+
+	if _, err = envelopes.NewEmbeddedSigning(sv, []byte("short key")); err == nil {
+		t.Errorf("expected error for short key")
+	}
+	es, err := envelopes.NewEmbeddedSigning(sv, []byte("0123456789abcdef0123456789abcdef"))
+	if err != nil {
+		t.Fatalf("expected EmbeddedSigning; got %v", err)
+	}
+	if _, err = es.ViewRequest(summary.EnvelopeID, &model.Signer{Name: "Remote"}, "https://app.example.com"); err == nil {
+		t.Errorf("expected error for signer without ClientUserID")
+	}
+	view, err := es.RecipientView(ctx, summary.EnvelopeID, &signer, "https://app.example.com/done?page=1")
+	if err != nil {
+		t.Fatalf("expected recipient view; got %v", err)
+	}
+	location := followView(t, view.URL)
+
+	var results []*envelopes.SigningResult
+	h := es.Handler(func(w http.ResponseWriter, r *http.Request, res *envelopes.SigningResult) {
+		results = append(results, res)
+	})
+	var handlerErr error
+	es.ErrorFunc = func(r *http.Request, status int, err error) {
+		handlerErr = err
+	}
+	serve := func(target string) int {
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, httptest.NewRequest("GET", target, nil))
+		if rr.Code != http.StatusOK && strings.TrimSpace(rr.Body.String()) != http.StatusText(rr.Code) {
+			t.Errorf("expected generic %d response body; got %q", rr.Code, rr.Body.String())
+		}
+		return rr.Code
+	}
+	if code := serve(location); code != http.StatusOK || len(results) != 1 {
+		t.Fatalf("expected verified redirect; got %d %v", code, results)
+	}
+	if res := results[0]; res.EnvelopeID != summary.EnvelopeID || res.RecipientID != "1" ||
+		res.ClientUserID != "C1" || res.Event != envelopes.SigningComplete {
+		t.Errorf("unexpected result %#v", res)
+	}
+
+	u, _ := url.Parse(location)
+	if u.Query().Get("page") != "1" {
+		t.Errorf("expected return url parameters kept; got %s", location)
+	}
+	state := u.Query().Get(envelopes.SigningStateParam)
+	forged := func(state, event string) string {
+		return "https://app.example.com/done?" + url.Values{envelopes.SigningStateParam: {state}, "event": {event}}.Encode()
+	}
+	other, _ := envelopes.NewEmbeddedSigning(sv, []byte("another key of at least 32 bytes"))
+	otherReq, _ := other.ViewRequest(summary.EnvelopeID, &signer, "https://app.example.com/done")
+	ou, _ := url.Parse(otherReq.ReturnURL)
+
+	// a signer that has not signed may not forge a signing_complete event
+	unsigned := model.Signer{RecipientID: "1", Name: "Unsigned", Email: "unsigned@example.com", ClientUserID: "C2"}
+	summary2, err := sv.Create(&model.EnvelopeDefinition{
+		EmailSubject: "Embedded",
+		Status:       "sent",
+		Documents:    []model.Document{{DocumentID: "1", Name: "doc.pdf", DocumentBase64: []byte("PDF")}},
+		Recipients:   &model.Recipients{Signers: []model.Signer{unsigned}},
+	}).Do(ctx)
+	if err != nil {
+		t.Fatalf("create envelope expected success; got %v", err)
+	}
+	unsignedReq, _ := es.ViewRequest(summary2.EnvelopeID, &unsigned, "https://app.example.com/done")
+	uu, _ := url.Parse(unsignedReq.ReturnURL)
+	tests := []struct {
+		name   string
+		target string
+		err    error
+		status int
+	}{
+		{"no state", "https://app.example.com/done?event=signing_complete", envelopes.ErrSigningStateInvalid, http.StatusBadRequest},
+		{"tampered", forged(strings.Replace(state, ".", "x.", 1), "signing_complete"), envelopes.ErrSigningStateInvalid, http.StatusBadRequest},
+		{"other key", forged(ou.Query().Get(envelopes.SigningStateParam), "signing_complete"), envelopes.ErrSigningStateInvalid, http.StatusBadRequest},
+		{"unknown event", forged(state, "signed"), envelopes.ErrSigningEventInvalid, http.StatusBadRequest},
+		{"not signed", forged(uu.Query().Get(envelopes.SigningStateParam), "signing_complete"), envelopes.ErrSigningNotCompleted, http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		if _, err := es.Verify(httptest.NewRequest("GET", tt.target, nil)); err != tt.err {
+			t.Errorf("%s: expected %v; got %v", tt.name, tt.err, err)
+		}
+		handlerErr = nil
+		if code := serve(tt.target); code != tt.status || handlerErr != tt.err {
+			t.Errorf("%s: expected %d and ErrorFunc %v; got %d %v", tt.name, tt.status, tt.err, code, handlerErr)
+		}
+	}
+	if res, err := es.Verify(httptest.NewRequest("GET", forged(state, "cancel"), nil)); err != nil || res.Event != envelopes.SigningCancel {
+		t.Errorf("expected cancel event; got %v %v", res, err)
+	}
+
+	es.StateTTL = time.Nanosecond
+	req, _ := es.ViewRequest(summary.EnvelopeID, &signer, "https://app.example.com/done?event=cancel")
+	time.Sleep(time.Millisecond)
+	if _, err = es.Verify(httptest.NewRequest("GET", req.ReturnURL, nil)); err != envelopes.ErrSigningStateExpired {
+		t.Errorf("expected expired state; got %v", err)
+	}
+}
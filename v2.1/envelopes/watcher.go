@@ -0,0 +1,377 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package envelopes
+
+// watcher.go contains the Watcher which polls for envelope status
+// changes when a Connect listener is not available.
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+// MinPollInterval is the shortest interval between polls allowed by
+// DocuSign's API rules.
+const MinPollInterval = 15 * time.Minute
+
+// EnvelopeStatus is the status of an envelope.
+type EnvelopeStatus string
+
+// Envelope statuses reported in a StatusEvent
+const (
+	EnvelopeCreated   EnvelopeStatus = "created"
+	EnvelopeSent      EnvelopeStatus = "sent"
+	EnvelopeDelivered EnvelopeStatus = "delivered"
+	EnvelopeCompleted EnvelopeStatus = "completed"
+	EnvelopeDeclined  EnvelopeStatus = "declined"
+	EnvelopeVoided    EnvelopeStatus = "voided"
+)
+
+// IsFinal reports whether the status is completed, declined or voided.
+func (s EnvelopeStatus) IsFinal() bool {
+	return s == EnvelopeCompleted || s == EnvelopeDeclined || s == EnvelopeVoided
+}
+
+// StatusEvent reports an envelope's change of status.  When status
+// changes more than once between polls, only the latest status
+// is reported (e.g. sent to completed).
+type StatusEvent struct {
+	EnvelopeID string
+	// From is the previous status.  From is blank when the envelope
+	// was not in the checkpoint.
+	From EnvelopeStatus
+	To   EnvelopeStatus
+	// Changed is the envelope's StatusChangedDateTime
+	Changed time.Time
+	// Envelope is the envelope returned by the poll
+	Envelope model.Envelope
+}
+
+// Checkpoint is the saved state of a Watcher.
+type Checkpoint struct {
+	// FromDate is the from_date of the next poll.
+	FromDate time.Time `json:"from_date"`
+	// LastPolled is the time of the last poll.
+	LastPolled time.Time `json:"last_polled"`
+	// Statuses contains the last reported status of each envelope.
+	// Envelopes in a final status are removed once a poll no longer
+	// returns them.  A final status changed before FromDate is not
+	// reported again when a later modification returns the envelope.
+	Statuses map[string]EnvelopeStatus `json:"statuses"`
+}
+
+func (cp *Checkpoint) copy() *Checkpoint {
+	cx := *cp
+	cx.Statuses = make(map[string]EnvelopeStatus, len(cp.Statuses))
+	for k, v := range cp.Statuses {
+		cx.Statuses[k] = v
+	}
+	return &cx
+}
+
+// ErrPollTooSoon is returned by Poll when the watcher's Interval has
+// not passed since the checkpoint's last poll.
+var ErrPollTooSoon = errors.New("poll interval has not passed since last poll")
+
+// ErrCheckpointNotFound is returned by a CheckpointStore's Load func
+// when no checkpoint is saved for the key.
+var ErrCheckpointNotFound = errors.New("checkpoint not found")
+
+// CheckpointStore saves a Watcher's checkpoint so that a restarted
+// Watcher does not replay events or poll too soon.  Implementations
+// must be safe for concurrent use.
+type CheckpointStore interface {
+	// Load returns the checkpoint saved for key.  If no checkpoint
+	// exists, Load must return ErrCheckpointNotFound.
+	Load(ctx context.Context, key string) (*Checkpoint, error)
+	// Save stores the checkpoint for key.
+	Save(ctx context.Context, key string, cp *Checkpoint) error
+}
+
+// Watcher polls for envelope status changes using ListStatusChanges,
+// or ListStatus when EnvelopeIDs is set, and reports each change as a
+// StatusEvent.  Watcher methods are not safe for concurrent use.
+type Watcher struct {
+	// Interval is the time between polls.  Values less than
+	// MinPollInterval are treated as MinPollInterval.
+	Interval time.Duration
+	// Since is the from_date of the first poll when no checkpoint is
+	// saved.  A zero value reports only changes after the first poll.
+	// Final statuses changed before Since are not reported.
+	Since time.Time
+	// EnvelopeIDs limits the watcher to the listed envelopes.
+	EnvelopeIDs []string
+
+	sv    *Service
+	store CheckpointStore
+	key   string
+	cp    *Checkpoint
+	now   func() time.Time
+}
+
+// NewWatcher returns a Watcher that saves its checkpoint in store
+// under key.  A nil store keeps the checkpoint in memory.
+func NewWatcher(sv *Service, store CheckpointStore, key string) *Watcher {
+	if store == nil {
+		store = &MemoryCheckpointStore{}
+	}
+	return &Watcher{sv: sv, store: store, key: key, now: time.Now}
+}
+
+func (w *Watcher) interval() time.Duration {
+	if w.Interval < MinPollInterval {
+		return MinPollInterval
+	}
+	return w.Interval
+}
+
+func (w *Watcher) checkpoint(ctx context.Context) (*Checkpoint, error) {
+	if w.cp != nil {
+		return w.cp, nil
+	}
+	cp, err := w.store.Load(ctx, w.key)
+	switch {
+	case err == ErrCheckpointNotFound:
+		cp = &Checkpoint{FromDate: w.Since}
+	case err != nil:
+		return nil, err
+	}
+	if cp.Statuses == nil {
+		cp.Statuses = make(map[string]EnvelopeStatus)
+	}
+	w.cp = cp
+	return cp, nil
+}
+
+// Run polls every Interval, calling f with each event, until ctx is
+// done or an error occurs.  When restarted with a saved checkpoint,
+// Run waits until Interval has passed since the last poll.
+func (w *Watcher) Run(ctx context.Context, f func(context.Context, StatusEvent) error) error {
+	for {
+		cp, err := w.checkpoint(ctx)
+		if err != nil {
+			return err
+		}
+		if wait := cp.LastPolled.Add(w.interval()).Sub(w.now()); wait > 0 {
+			tm := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				tm.Stop()
+				return ctx.Err()
+			case <-tm.C:
+			}
+		}
+		if err = w.Poll(ctx, f); err != nil {
+			return err
+		}
+	}
+}
+
+// Poll lists envelopes changed since the last poll and calls f with an
+// event for each envelope whose status differs from the checkpoint.
+// Events are ordered by status change time.  The checkpoint is saved
+// after the events are handled.  If f returns an error, Poll stops and
+// returns the error and unhandled events are reported by the next poll,
+// which may be made without waiting for Interval.
+// Poll returns an error wrapping ErrPollTooSoon if called before Interval
+// has passed since the checkpoint's last poll; Run waits as needed.
+func (w *Watcher) Poll(ctx context.Context, f func(context.Context, StatusEvent) error) error {
+	cp, err := w.checkpoint(ctx)
+	if err != nil {
+		return err
+	}
+	started := w.now()
+	if next := cp.LastPolled.Add(w.interval()); started.Before(next) {
+		return fmt.Errorf("%w; next poll allowed at %s", ErrPollTooSoon, next.Format(time.RFC3339))
+	}
+	if cp.FromDate.IsZero() {
+		cp.FromDate = started
+	}
+	var envelopes []model.Envelope
+	var lastQueried string
+	collect := func(res *model.EnvelopesInformation) error {
+		if res != nil {
+			if lastQueried == "" {
+				lastQueried = res.LastQueriedDateTime
+			}
+			envelopes = append(envelopes, res.Envelopes...)
+		}
+		return nil
+	}
+	if len(w.EnvelopeIDs) > 0 {
+		err = w.sv.ListStatus(&model.EnvelopeIdsRequest{EnvelopeIds: w.EnvelopeIDs}).
+			EnvelopeIds("request_body").Pages(ctx, collect)
+	} else {
+		err = w.sv.ListStatusChanges().FromDate(cp.FromDate).Pages(ctx, collect)
+	}
+	if err != nil {
+		return err
+	}
+
+	events := make([]StatusEvent, 0, len(envelopes))
+	polled := make(map[string]bool, len(envelopes))
+	for _, env := range envelopes {
+		polled[env.EnvelopeID] = true
+		status := EnvelopeStatus(strings.ToLower(env.Status))
+		from, ok := cp.Statuses[env.EnvelopeID]
+		if status == from {
+			continue
+		}
+		ev := StatusEvent{EnvelopeID: env.EnvelopeID, From: from, To: status, Envelope: env}
+		if env.StatusChangedDateTime != nil {
+			ev.Changed = *env.StatusChangedDateTime
+		}
+		// a pruned final status is returned again when the envelope
+		// is modified; it was reported by the poll covering its change
+		if !ok && status.IsFinal() && len(w.EnvelopeIDs) == 0 &&
+			!ev.Changed.IsZero() && ev.Changed.Before(cp.FromDate) {
+			continue
+		}
+		events = append(events, ev)
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Changed.Before(events[j].Changed)
+	})
+
+	for _, ev := range events {
+		if err = f(ctx, ev); err != nil {
+			// save handled events so they are not replayed.  LastPolled
+			// is unchanged so the remaining events may be retried.
+			if saveErr := w.store.Save(ctx, w.key, cp.copy()); saveErr != nil {
+				return saveErr
+			}
+			return err
+		}
+		cp.Statuses[ev.EnvelopeID] = ev.To
+	}
+	// final statuses cannot change, so they are only needed while
+	// polls return the envelope
+	for id, status := range cp.Statuses {
+		if status.IsFinal() && !polled[id] {
+			delete(cp.Statuses, id)
+		}
+	}
+	cp.LastPolled = started
+	cp.FromDate = started
+	if tm, err := time.Parse(time.RFC3339Nano, lastQueried); err == nil {
+		cp.FromDate = tm
+	}
+	return w.store.Save(ctx, w.key, cp.copy())
+}
+
+// MemoryCheckpointStore is a CheckpointStore that keeps checkpoints
+// in memory.  The zero value is ready to use.
+type MemoryCheckpointStore struct {
+	mu          sync.Mutex
+	checkpoints map[string]*Checkpoint
+}
+
+// Load returns a copy of the saved checkpoint.
+func (m *MemoryCheckpointStore) Load(ctx context.Context, key string) (*Checkpoint, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cp, ok := m.checkpoints[key]
+	if !ok {
+		return nil, ErrCheckpointNotFound
+	}
+	return cp.copy(), nil
+}
+
+// Save stores a copy of cp.
+func (m *MemoryCheckpointStore) Save(ctx context.Context, key string, cp *Checkpoint) error {
+	if cp == nil {
+		return errors.New("checkpoint may not be nil")
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.checkpoints == nil {
+		m.checkpoints = make(map[string]*Checkpoint)
+	}
+	m.checkpoints[key] = cp.copy()
+	return nil
+}
+
+// FileCheckpointStore is a CheckpointStore that saves each checkpoint
+// as a json file in a directory.
+type FileCheckpointStore struct {
+	dir string
+}
+
+// NewFileCheckpointStore returns a FileCheckpointStore saving files
+// to dir.
+func NewFileCheckpointStore(dir string) (*FileCheckpointStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &FileCheckpointStore{dir: dir}, nil
+}
+
+func (f *FileCheckpointStore) filename(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return filepath.Join(f.dir, hex.EncodeToString(h[:])+".checkpoint")
+}
+
+// Load reads the file for key.
+func (f *FileCheckpointStore) Load(ctx context.Context, key string) (*Checkpoint, error) {
+	b, err := ioutil.ReadFile(f.filename(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrCheckpointNotFound
+		}
+		return nil, err
+	}
+	var cp *Checkpoint
+	if err = json.Unmarshal(b, &cp); err != nil {
+		return nil, err
+	}
+	if cp == nil {
+		return nil, ErrCheckpointNotFound
+	}
+	return cp, nil
+}
+
+// Save writes cp to the key's file.  The file is replaced atomically
+// so concurrent Loads never see a partial file.
+func (f *FileCheckpointStore) Save(ctx context.Context, key string, cp *Checkpoint) error {
+	if cp == nil {
+		return errors.New("checkpoint may not be nil")
+	}
+	b, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(f.dir, ".checkpoint-")
+	if err != nil {
+		return err
+	}
+	if _, err = tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err = os.Rename(tmp.Name(), f.filename(key)); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
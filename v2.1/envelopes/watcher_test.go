@@ -0,0 +1,227 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package envelopes_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jfcote87/esign/esigntest"
+	"github.com/jfcote87/esign/v2.1/envelopes"
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+func createSentEnvelope(t *testing.T, sv *envelopes.Service) string {
+	summary, err := sv.Create(&model.EnvelopeDefinition{
+		EmailSubject: "Watch",
+		Status:       "sent",
+		Documents:    []model.Document{{DocumentID: "1", Name: "doc.pdf", DocumentBase64: []byte("PDF")}},
+		Recipients: &model.Recipients{
+			Signers: []model.Signer{{RecipientID: "1", Name: "Signer", Email: "signer@example.com"}},
+		},
+	}).Do(context.Background())
+	if err != nil {
+		t.Fatalf("create envelope expected success; got %v", err)
+	}
+	return summary.EnvelopeID
+}
+
+type eventList []string
+
+func (l *eventList) handler(ctx context.Context, ev envelopes.StatusEvent) error {
+	*l = append(*l, ev.EnvelopeID+":"+string(ev.From)+">"+string(ev.To))
+	return nil
+}
+
+func TestWatcher_Poll(t *testing.T) {
+	ctx := context.Background()
+	srv := esigntest.NewServer()
+	defer srv.Close()
+	sv := envelopes.New(srv.Credential())
+	store := &envelopes.MemoryCheckpointStore{}
+	clock := time.Now()
+	newWatcher := func() *envelopes.Watcher {
+		w := envelopes.NewWatcher(sv, store, "test")
+		w.Since = time.Now().Add(-time.Minute)
+		envelopes.SetWatcherClock(w, func() time.Time { return clock })
+		return w
+	}
+	tick := func() {
+		clock = clock.Add(envelopes.MinPollInterval)
+	}
+
+	id1 := createSentEnvelope(t, sv)
+	w := newWatcher()
+	var events eventList
+	if err := w.Poll(ctx, events.handler); err != nil {
+		t.Fatalf("poll expected success; got %v", err)
+	}
+	if err := w.Poll(ctx, events.handler); !errors.Is(err, envelopes.ErrPollTooSoon) {
+		t.Fatalf("expected ErrPollTooSoon; got %v", err)
+	}
+	tick()
+	if err := w.Poll(ctx, events.handler); err != nil {
+		t.Fatalf("second poll expected success; got %v", err)
+	}
+	if err := srv.Sign(id1, "1"); err != nil {
+		t.Fatalf("sign expected success; got %v", err)
+	}
+	// server times have a precision of milliseconds, so ensure the
+	// next poll's last queried time follows the signing
+	time.Sleep(2 * time.Millisecond)
+	tick()
+	if err := w.Poll(ctx, events.handler); err != nil {
+		t.Fatalf("third poll expected success; got %v", err)
+	}
+	want := eventList{id1 + ":>sent", id1 + ":sent>completed"}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("expected %v; got %v", want, events)
+	}
+
+	// completed envelope is removed once no longer returned
+	tick()
+	if err := w.Poll(ctx, events.handler); err != nil {
+		t.Fatalf("fourth poll expected success; got %v", err)
+	}
+	if cp, err := store.Load(ctx, "test"); err != nil || len(cp.Statuses) != 0 {
+		t.Errorf("expected completed envelope removed from checkpoint; got %#v %v", cp, err)
+	}
+
+	// handler error saves handled events and a restarted watcher
+	// reports only the unhandled events without waiting for the interval
+	id2 := createSentEnvelope(t, sv)
+	id3 := createSentEnvelope(t, sv)
+	errHandler := errors.New("handler error")
+	events = nil
+	tick()
+	err := w.Poll(ctx, func(ctx context.Context, ev envelopes.StatusEvent) error {
+		if ev.EnvelopeID == id3 {
+			return errHandler
+		}
+		return events.handler(ctx, ev)
+	})
+	if err != errHandler {
+		t.Fatalf("expected handler error; got %v", err)
+	}
+	if err := newWatcher().Poll(ctx, events.handler); err != nil {
+		t.Fatalf("restarted poll expected success; got %v", err)
+	}
+	want = eventList{id2 + ":>sent", id3 + ":>sent"}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("expected %v; got %v", want, events)
+	}
+
+	// watch listed envelopes
+	events = nil
+	wx := envelopes.NewWatcher(sv, nil, "ids")
+	wx.EnvelopeIDs = []string{id1, id3}
+	if err := wx.Poll(ctx, events.handler); err != nil {
+		t.Fatalf("envelope id poll expected success; got %v", err)
+	}
+	want = eventList{id1 + ":>completed", id3 + ":>sent"}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("expected %v; got %v", want, events)
+	}
+}
+
+func TestWatcher_RepeatedFinalStatus(t *testing.T) {
+	ctx := context.Background()
+	srv := esigntest.NewServer()
+	defer srv.Close()
+	sv := envelopes.New(srv.Credential())
+	store := &envelopes.MemoryCheckpointStore{}
+	clock := time.Now()
+	w := envelopes.NewWatcher(sv, store, "test")
+	w.Since = time.Now().Add(-time.Minute)
+	envelopes.SetWatcherClock(w, func() time.Time { return clock })
+
+	id := createSentEnvelope(t, sv)
+	if err := srv.Sign(id, "1"); err != nil {
+		t.Fatalf("sign expected success; got %v", err)
+	}
+	time.Sleep(2 * time.Millisecond)
+	var events eventList
+	for i, modify := range []bool{false, false, true, false} {
+		// modifying the completed envelope returns it in the next poll
+		// after its status has been removed from the checkpoint
+		if modify {
+			if cp, err := store.Load(ctx, "test"); err != nil || len(cp.Statuses) != 0 {
+				t.Fatalf("expected completed envelope removed from checkpoint; got %#v %v", cp, err)
+			}
+			if _, err := sv.Update(id, &model.Envelope{EmailSubject: "Modified"}).Do(ctx); err != nil {
+				t.Fatalf("update expected success; got %v", err)
+			}
+		}
+		if err := w.Poll(ctx, events.handler); err != nil {
+			t.Fatalf("poll %d expected success; got %v", i, err)
+		}
+		clock = clock.Add(envelopes.MinPollInterval)
+	}
+	if want := (eventList{id + ":>completed"}); !reflect.DeepEqual(events, want) {
+		t.Errorf("expected %v; got %v", want, events)
+	}
+}
+
+func TestWatcher_Run(t *testing.T) {
+	srv := esigntest.NewServer()
+	defer srv.Close()
+	sv := envelopes.New(srv.Credential())
+	id := createSentEnvelope(t, sv)
+
+	store := &envelopes.MemoryCheckpointStore{}
+	for i, want := range []eventList{{id + ":>sent"}, nil} {
+		var events eventList
+		w := envelopes.NewWatcher(sv, store, "run")
+		w.Since = time.Now().Add(-time.Minute)
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		err := w.Run(ctx, events.handler)
+		cancel()
+		if err != context.DeadlineExceeded {
+			t.Errorf("run %d expected deadline exceeded; got %v", i, err)
+		}
+		// the second run must wait for the poll interval
+		if !reflect.DeepEqual(events, want) {
+			t.Errorf("run %d expected %v; got %v", i, want, events)
+		}
+	}
+	cp, err := store.Load(context.Background(), "run")
+	if err != nil || time.Since(cp.LastPolled) > time.Minute || cp.Statuses[id] != envelopes.EnvelopeSent {
+		t.Errorf("expected saved checkpoint; got %#v %v", cp, err)
+	}
+}
+
+func TestFileCheckpointStore(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "checkpoints")
+	if err != nil {
+		t.Fatalf("tempdir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	store, err := envelopes.NewFileCheckpointStore(dir)
+	if err != nil {
+		t.Fatalf("expected new store; got %v", err)
+	}
+	if _, err = store.Load(ctx, "key"); err != envelopes.ErrCheckpointNotFound {
+		t.Errorf("expected ErrCheckpointNotFound; got %v", err)
+	}
+	cp := &envelopes.Checkpoint{
+		FromDate:   time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC),
+		LastPolled: time.Date(2022, 1, 2, 3, 5, 0, 0, time.UTC),
+		Statuses:   map[string]envelopes.EnvelopeStatus{"E1": envelopes.EnvelopeCompleted},
+	}
+	if err = store.Save(ctx, "key", cp); err != nil {
+		t.Fatalf("expected save; got %v", err)
+	}
+	loaded, err := store.Load(ctx, "key")
+	if err != nil || !reflect.DeepEqual(loaded, cp) {
+		t.Errorf("expected %#v; got %#v %v", cp, loaded, err)
+	}
+}
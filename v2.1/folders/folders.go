@@ -12,6 +12,10 @@
 // You can list the folder contents and move envelopes and templates between folders.
 //
 //
+// The specification lists no field constraints for this API's models,
+// so they have no Validate methods and ops have no ValidateFirst option.
+// Constraints may be added with a gen-esign overrides file.
+//
 // Service Api documentation may be found at:
 // https://developers.docusign.com/docs/esign-rest-api/reference/Folders
 // Usage example:
@@ -76,9 +80,13 @@ func (op *ListOp) Do(ctx context.Context) (*model.FoldersResponse, error) {
 // - `envelope_folders`: Returns a list of envelope folders. (Default)
 // - `template_folders`: Returns a list of template folders.
 // - `shared_template_folders`: Returns a list of shared template folders.
-func (op *ListOp) Include(val string) *ListOp {
+func (op *ListOp) Include(val ...Include) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("include", val)
+		vals := make([]string, len(val))
+		for i, v := range val {
+			vals[i] = string(v)
+		}
+		op.QueryOpts.Set("include", strings.Join(vals, ","))
 	}
 	return op
 }
@@ -147,10 +155,49 @@ func (op *ListItemsOp) Do(ctx context.Context) (*model.FolderItemsResponse, erro
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *ListItemsOp) Pages(ctx context.Context, f func(*model.FolderItemsResponse) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   res.EndPosition,
+			NextURI:       &res.NextURI,
+			ResultSetSize: res.ResultSetSize,
+			StartPosition: res.StartPosition,
+			TotalSetSize:  res.TotalSetSize,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // FromDate reserved for DocuSign.
 func (op *ListItemsOp) FromDate(val time.Time) *ListItemsOp {
 	if op != nil {
-		op.QueryOpts.Set("from_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -206,7 +253,7 @@ func (op *ListItemsOp) Status(val string) *ListItemsOp {
 // ToDate reserved for DocuSign.
 func (op *ListItemsOp) ToDate(val time.Time) *ListItemsOp {
 	if op != nil {
-		op.QueryOpts.Set("to_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -262,6 +309,44 @@ func (op *SearchOp) Do(ctx context.Context) (*model.FolderItemResponse, error) {
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *SearchOp) Pages(ctx context.Context, f func(*model.FolderItemResponse) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   res.EndPosition,
+			NextURI:       &res.NextURI,
+			ResultSetSize: res.ResultSetSize,
+			StartPosition: res.StartPosition,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // All specifies that all envelopes that match the criteria are returned.
 func (op *SearchOp) All() *SearchOp {
 	if op != nil {
@@ -285,7 +370,7 @@ func (op *SearchOp) Count(val int) *SearchOp {
 // FromDate specifies the start of the date range to return. If no value is provided, the default search is the previous 30 days.
 func (op *SearchOp) FromDate(val time.Time) *SearchOp {
 	if op != nil {
-		op.QueryOpts.Set("from_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -307,9 +392,9 @@ func (op *SearchOp) Order(val string) *SearchOp {
 }
 
 // OrderBy specifies the property used to sort the list. Valid values are: `action_required`, `created`, `completed`, `sent`, `signer_list`, `status`, or `subject`.
-func (op *SearchOp) OrderBy(val string) *SearchOp {
+func (op *SearchOp) OrderBy(val OrderBy) *SearchOp {
 	if op != nil {
-		op.QueryOpts.Set("order_by", val)
+		op.QueryOpts.Set("order_by", string(val))
 	}
 	return op
 }
@@ -331,7 +416,32 @@ func (op *SearchOp) StartPosition(val int) *SearchOp {
 // ToDate specifies the end of the date range to return.
 func (op *SearchOp) ToDate(val time.Time) *SearchOp {
 	if op != nil {
-		op.QueryOpts.Set("to_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
+
+
+// Include is a value of the include query option.
+type Include string
+
+// Include values
+const (
+	IncludeEnvelopeFolders       Include = "envelope_folders"
+	IncludeTemplateFolders       Include = "template_folders"
+	IncludeSharedTemplateFolders Include = "shared_template_folders"
+)
+
+// OrderBy is a value of the order_by query option.
+type OrderBy string
+
+// OrderBy values
+const (
+	OrderByActionRequired OrderBy = "action_required"
+	OrderByCreated        OrderBy = "created"
+	OrderByCompleted      OrderBy = "completed"
+	OrderBySent           OrderBy = "sent"
+	OrderBySignerList     OrderBy = "signer_list"
+	OrderByStatus         OrderBy = "status"
+	OrderBySubject        OrderBy = "subject"
+)
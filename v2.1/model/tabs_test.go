@@ -0,0 +1,116 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package model_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+func testTabs() *model.Tabs {
+	tabs := &model.Tabs{
+		SignHereTabs: []model.SignHere{{TabPosition: model.TabPosition{TabLabel: "sig"}}},
+		CheckboxTabs: []model.Checkbox{{TabPosition: model.TabPosition{TabLabel: "agree"}, Selected: model.TRUE}},
+		ListTabs: []model.List{{TabPosition: model.TabPosition{TabLabel: "color"},
+			ListItems: []model.ListItem{{Value: "red"}, {Value: "blue", Selected: model.TRUE}}}},
+		RadioGroupTabs: []model.RadioGroup{{GroupName: "size",
+			Radios: []model.Radio{{Value: "S"}, {Value: "L"}}}},
+	}
+	tabs.CurrencyTabs = append(tabs.CurrencyTabs, model.Currency{})
+	tabs.CurrencyTabs[0].TabLabel, tabs.CurrencyTabs[0].Value = "amount", "10.50"
+	tabs.TextTabs = append(tabs.TextTabs, model.Text{}, model.Text{})
+	tabs.TextTabs[0].TabLabel, tabs.TextTabs[0].Value = "name", "Jane"
+	tabs.TextTabs[1].TabLabel = "name"
+	return tabs
+}
+
+func TestTabs_All(t *testing.T) {
+	tabs := testTabs()
+	var kinds []model.TabKind
+	for _, tab := range tabs.All() {
+		kinds = append(kinds, tab.Kind)
+	}
+	want := []model.TabKind{model.TabKindCheckbox, model.TabKindCurrency, model.TabKindList,
+		model.TabKindRadioGroup, model.TabKindSignHere, model.TabKindText, model.TabKindText}
+	if !reflect.DeepEqual(kinds, want) {
+		t.Errorf("expected %v; got %v", want, kinds)
+	}
+	if _, ok := tabs.GetValue("sig"); ok {
+		t.Errorf("expected no value for signHere tab")
+	}
+	if (*model.Tabs)(nil).All() != nil {
+		t.Errorf("expected nil tabs for nil Tabs")
+	}
+}
+
+func TestTabs_SetValue(t *testing.T) {
+	tabs := testTabs()
+	tests := []struct {
+		label, value string
+		cnt          int
+		want         string
+	}{
+		{"name", "John", 2, "John"},
+		{"amount", "5.00", 1, "5.00"},
+		{"agree", "false", 1, "false"},
+		{"agree", "maybe", 0, "false"},
+		{"color", "red", 1, "red"},
+		{"color", "green", 0, "red"},
+		{"size", "L", 1, "L"},
+		{"sig", "x", 0, ""},
+		{"missing", "x", 0, ""},
+	}
+	for _, tt := range tests {
+		if cnt := tabs.SetValue(tt.label, tt.value); cnt != tt.cnt {
+			t.Errorf("%s=%s expected %d updates; got %d", tt.label, tt.value, tt.cnt, cnt)
+		}
+		if v, _ := tabs.GetValue(tt.label); v != tt.want {
+			t.Errorf("%s expected value %q; got %q", tt.label, tt.want, v)
+		}
+	}
+	if tabs.TextTabs[1].Value != "John" || tabs.ListTabs[0].Value != "red" || tabs.ListTabs[0].ListItems[1].Selected != model.FALSE {
+		t.Errorf("expected Tabs updated; got %#v %#v", tabs.TextTabs, tabs.ListTabs)
+	}
+}
+
+func TestTabsFromMap(t *testing.T) {
+	cur := model.Currency{}
+	cur.Value = "3.25"
+	tabs, err := model.TabsFromMap(map[string]interface{}{
+		"name":   "Jane",
+		"agree":  true,
+		"count":  3,
+		"rate":   1.5,
+		"amount": &cur,
+	})
+	if err != nil {
+		t.Fatalf("expected success; got %v", err)
+	}
+	if len(tabs.TextTabs) != 1 || len(tabs.CheckboxTabs) != 1 || len(tabs.NumberTabs) != 2 || len(tabs.CurrencyTabs) != 1 {
+		t.Fatalf("expected text, checkbox, number and currency tabs; got %#v", tabs)
+	}
+	want := map[string]interface{}{"name": "Jane", "agree": true, "count": "3", "rate": "1.5", "amount": "3.25"}
+	if got := tabs.Values(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v; got %v", want, got)
+	}
+
+	// round trip
+	rt, err := model.TabsFromMap(tabs.Values())
+	if err != nil {
+		t.Fatalf("expected round trip success; got %v", err)
+	}
+	if got := rt.Values(); !reflect.DeepEqual(got, want) {
+		t.Errorf("round trip expected %v; got %v", want, got)
+	}
+
+	for _, v := range []interface{}{struct{}{}, model.Draw{}} {
+		if _, err := model.TabsFromMap(map[string]interface{}{"bad": v}); err == nil {
+			t.Errorf("expected error for %T", v)
+		}
+	}
+}
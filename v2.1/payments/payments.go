@@ -11,6 +11,10 @@
 // This category includes resources for managing payment gateways. Payment information is added to envelopes via methods in the Envelopes category.
 //
 //
+// The specification lists no field constraints for this API's models,
+// so they have no Validate methods and ops have no ValidateFirst option.
+// Constraints may be added with a gen-esign overrides file.
+//
 // Service Api documentation may be found at:
 // https://developers.docusign.com/docs/esign-rest-api/reference/Payments
 // Usage example:
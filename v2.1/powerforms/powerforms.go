@@ -11,6 +11,10 @@
 // The PowerForms category enables you to create and manage PowerForms that you can use for self service and email forms.
 //
 //
+// The specification lists no field constraints for this API's models,
+// so they have no Validate methods and ops have no ValidateFirst option.
+// Constraints may be added with a gen-esign overrides file.
+//
 // Service Api documentation may be found at:
 // https://developers.docusign.com/docs/esign-rest-api/reference/PowerForms
 // Usage example:
@@ -75,9 +79,9 @@ func (op *DataListOp) Do(ctx context.Context) (*model.PowerFormsFormDataResponse
 // - `Csv_Classic`
 // - `Csv_One_Envelope_Per_Line`
 // - `Xml_Classic`
-func (op *DataListOp) DataLayout(val string) *DataListOp {
+func (op *DataListOp) DataLayout(val DataLayout) *DataListOp {
 	if op != nil {
-		op.QueryOpts.Set("data_layout", val)
+		op.QueryOpts.Set("data_layout", string(val))
 	}
 	return op
 }
@@ -87,7 +91,7 @@ func (op *DataListOp) DataLayout(val string) *DataListOp {
 // **Note:** If this property is null, no date filtering is applied.
 func (op *DataListOp) FromDate(val time.Time) *DataListOp {
 	if op != nil {
-		op.QueryOpts.Set("from_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -95,7 +99,7 @@ func (op *DataListOp) FromDate(val time.Time) *DataListOp {
 // ToDate is the end date of a date range in UTC DateTime format. The default value is `UtcNow`.
 func (op *DataListOp) ToDate(val time.Time) *DataListOp {
 	if op != nil {
-		op.QueryOpts.Set("to_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -229,7 +233,7 @@ func (op *ListOp) Do(ctx context.Context) (*model.PowerFormsResponse, error) {
 // **Note:** If no value is provided, no date filtering is applied.
 func (op *ListOp) FromDate(val time.Time) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("from_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -241,9 +245,9 @@ func (op *ListOp) FromDate(val time.Time) *ListOp {
 //
 // * `asc`: Ascending order.
 // * `desc`: Descending order.
-func (op *ListOp) Order(val string) *ListOp {
+func (op *ListOp) Order(val Order) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("order", val)
+		op.QueryOpts.Set("order", string(val))
 	}
 	return op
 }
@@ -261,9 +265,9 @@ func (op *ListOp) Order(val string) *ListOp {
 // - `type`
 // - `templatename`
 // - `created`
-func (op *ListOp) OrderBy(val string) *ListOp {
+func (op *ListOp) OrderBy(val OrderBy) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("order_by", val)
+		op.QueryOpts.Set("order_by", string(val))
 	}
 	return op
 }
@@ -273,9 +277,9 @@ func (op *ListOp) OrderBy(val string) *ListOp {
 // - `sender`: Include sender name and email in the search.
 // - `recipients`: Include recipient names and emails in the search.
 // - `envelope`: Include envelope information in the search.
-func (op *ListOp) SearchFields(val string) *ListOp {
+func (op *ListOp) SearchFields(val ...string) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("search_fields", val)
+		op.QueryOpts.Set("search_fields", strings.Join(val, ","))
 	}
 	return op
 }
@@ -293,7 +297,7 @@ func (op *ListOp) SearchText(val string) *ListOp {
 // **Note:** If no value is provided, this property defaults to the current date.
 func (op *ListOp) ToDate(val time.Time) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("to_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -323,6 +327,45 @@ func (op *ListSendersOp) Do(ctx context.Context) (*model.PowerFormSendersRespons
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *ListSendersOp) Pages(ctx context.Context, f func(*model.PowerFormSendersResponse) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   fmt.Sprint(res.EndPosition),
+			NextURI:       &res.NextURI,
+			ResultSetSize: fmt.Sprint(res.ResultSetSize),
+			StartPosition: fmt.Sprint(res.StartPosition),
+			TotalSetSize:  fmt.Sprint(res.TotalSetSize),
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // StartPosition is the position within the total result set from which to start returning values. The value **thumbnail** may be used to return the page image.
 func (op *ListSendersOp) StartPosition(val int) *ListSendersOp {
 	if op != nil {
@@ -356,3 +399,40 @@ func (op *UpdateOp) Do(ctx context.Context) (*model.PowerForm, error) {
 	var res *model.PowerForm
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
+
+
+// DataLayout is a value of the data_layout query option.
+type DataLayout string
+
+// DataLayout values
+const (
+	DataLayoutNative                DataLayout = "Native"
+	DataLayoutCsvClassic            DataLayout = "Csv_Classic"
+	DataLayoutCsvOneEnvelopePerLine DataLayout = "Csv_One_Envelope_Per_Line"
+	DataLayoutXMLClassic            DataLayout = "Xml_Classic"
+)
+
+// Order is a value of the order query option.
+type Order string
+
+// Order values
+const (
+	OrderAsc  Order = "asc"
+	OrderDesc Order = "desc"
+)
+
+// OrderBy is a value of the order_by query option.
+type OrderBy string
+
+// OrderBy values
+const (
+	OrderBySender       OrderBy = "sender"
+	OrderByAuth         OrderBy = "auth"
+	OrderByUsed         OrderBy = "used"
+	OrderByRemaining    OrderBy = "remaining"
+	OrderByLastused     OrderBy = "lastused"
+	OrderByStatus       OrderBy = "status"
+	OrderByType         OrderBy = "type"
+	OrderByTemplatename OrderBy = "templatename"
+	OrderByCreated      OrderBy = "created"
+)
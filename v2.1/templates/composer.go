@@ -0,0 +1,438 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package templates
+
+// composer.go contains the Composer which creates envelope definitions
+// from server templates using composite templates.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+// Person is the recipient bound to a template role.
+type Person struct {
+	Name  string
+	Email string
+	// ClientUserID makes the recipient an embedded recipient
+	ClientUserID string
+}
+
+// Composer creates an EnvelopeDefinition from one or more server
+// templates.  Each template becomes a composite template whose inline
+// template binds people to the template's roles and sets tab values.
+//
+//   c := templates.NewComposer(templates.New(cred))
+//   if err := c.Add(ctx, ndaTemplateID, termsTemplateID); err != nil {
+//       return err
+//   }
+//   c.EmailSubject = "Please sign"
+//   c.Status = "sent"
+//   c.Bind("Client", templates.Person{Name: "Jane Doe", Email: "jane@example.com"})
+//   c.SetTab("company", "ACME")
+//   def, err := c.Definition()
+//   ...
+//   summary, err := envelopes.New(cred).Create(def).Do(ctx)
+//
+// Roles of signers, carbon copies, certified deliveries, agents, editors
+// and intermediaries may be bound.  Only signer and carbon copy roles
+// have tabs.
+type Composer struct {
+	EmailSubject string
+	EmailBlurb   string
+	// Status is the status of the new envelope. Use "sent" to send the
+	// envelope immediately.  Blank creates a draft.
+	Status string
+
+	sv        *Service
+	templates []*composerTemplate
+	documents []model.Document
+	people    map[string]Person
+	tabs      map[string]string            // values for all roles by label
+	roleTabs  map[string]map[string]string // values by role and label
+}
+
+// composerTemplate is a server template and its roles
+type composerTemplate struct {
+	id    string
+	roles []*templateRole
+}
+
+// templateRole is a recipient of a template with a role name
+type templateRole struct {
+	name          string
+	recipientType string
+	person        Person // template's name and email
+	routingOrder  string
+	tabs          *model.Tabs
+}
+
+// key identifies the recipient of the role
+func (r *templateRole) key() string {
+	return r.recipientType + ":" + r.name
+}
+
+// NewComposer returns a Composer that loads templates using sv.
+func NewComposer(sv *Service) *Composer {
+	return &Composer{
+		sv:       sv,
+		people:   make(map[string]Person),
+		tabs:     make(map[string]string),
+		roleTabs: make(map[string]map[string]string),
+	}
+}
+
+// Add loads the recipients and tabs of each template.  Templates are
+// layered in the order added.
+func (c *Composer) Add(ctx context.Context, templateIDs ...string) error {
+	for _, id := range templateIDs {
+		rcps, err := c.sv.RecipientsList(id).IncludeTabs().Do(ctx)
+		if err != nil {
+			return fmt.Errorf("template %s: %w", id, err)
+		}
+		c.AddRecipients(id, rcps)
+	}
+	return nil
+}
+
+// AddRecipients adds a template using previously loaded recipients.
+// Recipients without a role name are left as defined in the template.
+func (c *Composer) AddRecipients(templateID string, rcps *model.Recipients) {
+	ct := &composerTemplate{id: templateID}
+	if rcps != nil {
+		for _, sx := range rcps.Signers {
+			ct.add(sx.RoleName, "signer", sx.Name, sx.Email, sx.RoutingOrder, sx.Tabs)
+		}
+		for _, cx := range rcps.CarbonCopies {
+			ct.add(cx.RoleName, "carbonCopy", cx.Name, cx.Email, cx.RoutingOrder, cx.Tabs)
+		}
+		for _, cx := range rcps.CertifiedDeliveries {
+			ct.add(cx.RoleName, "certifiedDelivery", cx.Name, cx.Email, cx.RoutingOrder, nil)
+		}
+		for _, ax := range rcps.Agents {
+			ct.add(ax.RoleName, "agent", ax.Name, ax.Email, ax.RoutingOrder, nil)
+		}
+		for _, ex := range rcps.Editors {
+			ct.add(ex.RoleName, "editor", ex.Name, ex.Email, ex.RoutingOrder, nil)
+		}
+		for _, ix := range rcps.Intermediaries {
+			ct.add(ix.RoleName, "intermediary", ix.Name, ix.Email, ix.RoutingOrder, nil)
+		}
+	}
+	c.templates = append(c.templates, ct)
+}
+
+func (ct *composerTemplate) add(name, recipientType, recipientName, email, routingOrder string, tabs *model.Tabs) {
+	if name == "" {
+		return
+	}
+	ct.roles = append(ct.roles, &templateRole{
+		name:          name,
+		recipientType: recipientType,
+		person:        Person{Name: recipientName, Email: email},
+		routingOrder:  routingOrder,
+		tabs:          tabs,
+	})
+}
+
+// AddDocument adds documents to the envelope following the templates'
+// documents.  Bound recipients receive the documents.
+func (c *Composer) AddDocument(docs ...model.Document) {
+	c.documents = append(c.documents, docs...)
+}
+
+// Bind assigns p to every template role named role.
+func (c *Composer) Bind(role string, p Person) {
+	c.people[role] = p
+}
+
+// SetTab sets the value of tabs labeled label for all roles.
+func (c *Composer) SetTab(label, value string) {
+	c.tabs[label] = value
+}
+
+// SetRoleTab sets the value of tabs labeled label for role only.  The
+// value replaces a value set by SetTab.
+func (c *Composer) SetRoleTab(role, label, value string) {
+	if c.roleTabs[role] == nil {
+		c.roleTabs[role] = make(map[string]string)
+	}
+	c.roleTabs[role][label] = value
+}
+
+// BindingError lists the roles and tabs that prevent creating a
+// definition.
+type BindingError struct {
+	// UnboundRoles are roles without an email in their templates
+	// that have not been bound
+	UnboundRoles []string
+	// UnboundTabs are the required tabs that the recipient cannot
+	// complete and have no value, formatted as "role: label"
+	UnboundTabs []string
+	// UnknownRoles are bound roles not found in any template
+	UnknownRoles []string
+	// UnknownTabs are labels set by SetTab or SetRoleTab ("role: label")
+	// that match no tab, or whose value could not be set (e.g. a list
+	// value not matching an item)
+	UnknownTabs []string
+}
+
+// Error lists the problems found.
+func (e *BindingError) Error() string {
+	var msgs []string
+	for _, p := range []struct {
+		desc string
+		list []string
+	}{
+		{"unbound roles", e.UnboundRoles},
+		{"unbound tabs", e.UnboundTabs},
+		{"unknown roles", e.UnknownRoles},
+		{"unknown tabs", e.UnknownTabs},
+	} {
+		if len(p.list) > 0 {
+			msgs = append(msgs, p.desc+" "+strings.Join(p.list, ", "))
+		}
+	}
+	return "templates: " + strings.Join(msgs, "; ")
+}
+
+// tabFlags are the tab properties used to find required tabs
+type tabFlags struct {
+	Locked         model.Bool `json:"locked"`
+	Required       model.Bool `json:"required"`
+	SenderRequired model.Bool `json:"senderRequired"`
+}
+
+// senderRequired reports whether the sender must supply a value for tab,
+// which is true for sender required tabs and locked required tabs.
+func senderRequired(tab model.Tab) (bool, error) {
+	var f tabFlags
+	b, err := json.Marshal(tab.Ptr)
+	if err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(b, &f); err != nil {
+		return false, err
+	}
+	return f.SenderRequired.True() || (f.Required.True() && f.Locked.True()), nil
+}
+
+// values returns the tab values for role
+func (c *Composer) values(role string) map[string]string {
+	vals := make(map[string]string)
+	for k, v := range c.tabs {
+		vals[k] = v
+	}
+	for k, v := range c.roleTabs[role] {
+		vals[k] = v
+	}
+	return vals
+}
+
+// inlineTabs returns a copy of the role's tabs that have values set.
+// Labels are recorded in set as both "label" and "role: label", and
+// "role: label" is recorded in failed or unbound for values that could
+// not be set and required tabs without values.
+func (c *Composer) inlineTabs(r *templateRole, set, failed, unbound map[string]bool) (*model.Tabs, error) {
+	if r.tabs == nil {
+		return nil, nil
+	}
+	var tabs *model.Tabs
+	b, err := json.Marshal(r.tabs)
+	if err != nil {
+		return nil, fmt.Errorf("templates: role %s tabs: %w", r.name, err)
+	}
+	if err = json.Unmarshal(b, &tabs); err != nil {
+		return nil, fmt.Errorf("templates: role %s tabs: %w", r.name, err)
+	}
+	vals := c.values(r.name)
+	res := &model.Tabs{}
+	for _, tab := range tabs.All() {
+		label := tab.Label()
+		v, ok := vals[label]
+		if !ok {
+			if cur, _ := tab.Value(); cur == "" {
+				required, err := senderRequired(tab)
+				if err != nil {
+					return nil, fmt.Errorf("templates: role %s tab %s: %w", r.name, label, err)
+				}
+				if required {
+					unbound[r.name+": "+label] = true
+				}
+			}
+			continue
+		}
+		if !tab.SetValue(v) {
+			failed[r.name+": "+label] = true
+			continue
+		}
+		set[label], set[r.name+": "+label] = true, true
+		res.Add(tab.Ptr)
+	}
+	if len(res.All()) == 0 {
+		return nil, nil
+	}
+	return res, nil
+}
+
+// Check returns a *BindingError if a role or tab is unbound or a
+// binding matches no role or tab.
+func (c *Composer) Check() error {
+	_, err := c.composite()
+	return err
+}
+
+// Definition returns the envelope definition.  A *BindingError is
+// returned if the bindings are incomplete.
+func (c *Composer) Definition() (*model.EnvelopeDefinition, error) {
+	cts, err := c.composite()
+	if err != nil {
+		return nil, err
+	}
+	return &model.EnvelopeDefinition{
+		EmailSubject:       c.EmailSubject,
+		EmailBlurb:         c.EmailBlurb,
+		Status:             c.Status,
+		CompositeTemplates: cts,
+	}, nil
+}
+
+// composite creates a composite template for each template with a server
+// template in sequence 1 and an inline template in sequence 2.  Each role
+// name and recipient type is assigned a recipient id so that a person
+// bound to a role in several templates is a single recipient.  A role
+// with differing recipient types in layered templates (e.g. a signer in
+// one and a carbon copy in another) is a separate recipient for each
+// type.
+func (c *Composer) composite() ([]model.CompositeTemplate, error) {
+	if len(c.templates) == 0 {
+		return nil, fmt.Errorf("templates: no templates added")
+	}
+	ids := make(map[string]string)
+	roles := make(map[string]*templateRole)
+	var roleNames []string
+	set, failed, unbound := make(map[string]bool), make(map[string]bool), make(map[string]bool)
+	var cts []model.CompositeTemplate
+	for i, ct := range c.templates {
+		rcps := &model.Recipients{}
+		for _, r := range ct.roles {
+			if _, ok := ids[r.key()]; !ok {
+				ids[r.key()] = strconv.Itoa(len(ids) + 1)
+			}
+			if _, ok := roles[r.name]; !ok {
+				roles[r.name] = r
+				roleNames = append(roleNames, r.name)
+			}
+			p, bound := c.people[r.name]
+			if !bound {
+				p = r.person
+			}
+			tabs, err := c.inlineTabs(r, set, failed, unbound)
+			if err != nil {
+				return nil, err
+			}
+			if bound || tabs != nil {
+				addRecipient(rcps, r, ids[r.key()], p, tabs)
+			}
+		}
+		comp := model.CompositeTemplate{
+			CompositeTemplateID: strconv.Itoa(i + 1),
+			ServerTemplates:     []model.ServerTemplate{{Sequence: "1", TemplateID: ct.id}},
+		}
+		if !isEmpty(rcps) {
+			comp.InlineTemplates = []model.InlineTemplate{{Sequence: "2", Recipients: rcps}}
+		}
+		cts = append(cts, comp)
+	}
+
+	be := &BindingError{}
+	for _, name := range roleNames {
+		if _, ok := c.people[name]; !ok && roles[name].person.Email == "" {
+			be.UnboundRoles = append(be.UnboundRoles, name)
+		}
+	}
+	for name := range c.people {
+		if _, ok := roles[name]; !ok {
+			be.UnknownRoles = append(be.UnknownRoles, name)
+		}
+	}
+	for label := range unbound {
+		be.UnboundTabs = append(be.UnboundTabs, label)
+	}
+	for label := range c.tabs {
+		if !set[label] {
+			be.UnknownTabs = append(be.UnknownTabs, label)
+		}
+	}
+	for role, vals := range c.roleTabs {
+		for label := range vals {
+			if key := role + ": " + label; !set[key] && !failed[key] {
+				be.UnknownTabs = append(be.UnknownTabs, key)
+			}
+		}
+	}
+	for key := range failed {
+		be.UnknownTabs = append(be.UnknownTabs, key)
+	}
+	if len(be.UnboundRoles)+len(be.UnboundTabs)+len(be.UnknownRoles)+len(be.UnknownTabs) > 0 {
+		sort.Strings(be.UnboundRoles)
+		sort.Strings(be.UnboundTabs)
+		sort.Strings(be.UnknownRoles)
+		sort.Strings(be.UnknownTabs)
+		return nil, be
+	}
+
+	if len(c.documents) > 0 {
+		rcps := &model.Recipients{}
+		for _, name := range roleNames {
+			if p, ok := c.people[name]; ok {
+				addRecipient(rcps, roles[name], ids[roles[name].key()], p, nil)
+			}
+		}
+		cts = append(cts, model.CompositeTemplate{
+			CompositeTemplateID: strconv.Itoa(len(cts) + 1),
+			InlineTemplates: []model.InlineTemplate{
+				{Sequence: "1", Documents: c.documents, Recipients: rcps},
+			},
+		})
+	}
+	return cts, nil
+}
+
+func isEmpty(rcps *model.Recipients) bool {
+	return len(rcps.Signers)+len(rcps.CarbonCopies)+len(rcps.CertifiedDeliveries)+
+		len(rcps.Agents)+len(rcps.Editors)+len(rcps.Intermediaries) == 0
+}
+
+// addRecipient appends the inline recipient for role r to rcps.
+func addRecipient(rcps *model.Recipients, r *templateRole, id string, p Person, tabs *model.Tabs) {
+	switch r.recipientType {
+	case "signer":
+		rcps.Signers = append(rcps.Signers, model.Signer{RecipientID: id, RoleName: r.name, RoutingOrder: r.routingOrder,
+			Name: p.Name, Email: p.Email, ClientUserID: p.ClientUserID, Tabs: tabs})
+	case "carbonCopy":
+		rcps.CarbonCopies = append(rcps.CarbonCopies, model.CarbonCopy{RecipientID: id, RoleName: r.name, RoutingOrder: r.routingOrder,
+			Name: p.Name, Email: p.Email, ClientUserID: p.ClientUserID, Tabs: tabs})
+	case "certifiedDelivery":
+		rcps.CertifiedDeliveries = append(rcps.CertifiedDeliveries, model.CertifiedDelivery{RecipientID: id, RoleName: r.name, RoutingOrder: r.routingOrder,
+			Name: p.Name, Email: p.Email, ClientUserID: p.ClientUserID})
+	case "agent":
+		rcps.Agents = append(rcps.Agents, model.Agent{RecipientID: id, RoleName: r.name, RoutingOrder: r.routingOrder,
+			Name: p.Name, Email: p.Email, ClientUserID: p.ClientUserID})
+	case "editor":
+		rcps.Editors = append(rcps.Editors, model.Editor{RecipientID: id, RoleName: r.name, RoutingOrder: r.routingOrder,
+			Name: p.Name, Email: p.Email, ClientUserID: p.ClientUserID})
+	case "intermediary":
+		rcps.Intermediaries = append(rcps.Intermediaries, model.Intermediary{RecipientID: id, RoleName: r.name, RoutingOrder: r.routingOrder,
+			Name: p.Name, Email: p.Email, ClientUserID: p.ClientUserID})
+	}
+}
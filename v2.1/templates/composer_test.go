@@ -0,0 +1,210 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package templates_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/jfcote87/esign/esigntest"
+	"github.com/jfcote87/esign/v2.1/envelopes"
+	"github.com/jfcote87/esign/v2.1/model"
+	"github.com/jfcote87/esign/v2.1/templates"
+)
+
+func createTemplates(t *testing.T, sv *templates.Service) (string, string) {
+	nda := &model.EnvelopeTemplate{
+		Name:      "NDA",
+		Documents: []model.Document{{DocumentID: "1", Name: "nda.pdf", DocumentBase64: []byte("NDA")}},
+		Recipients: &model.Recipients{
+			Signers: []model.Signer{{RecipientID: "1", RoleName: "Client", RoutingOrder: "1", Tabs: &model.Tabs{
+				TextTabs: []model.Text{
+					{TabPosition: model.TabPosition{TabLabel: "company"}, Required: model.TRUE, Locked: model.TRUE},
+					{TabPosition: model.TabPosition{TabLabel: "title"}},
+				},
+			}}},
+			CarbonCopies: []model.CarbonCopy{{RecipientID: "2", RoleName: "Legal", RoutingOrder: "2", Name: "Legal", Email: "legal@example.com"}},
+		},
+	}
+	terms := &model.EnvelopeTemplate{
+		Name:      "Terms",
+		Documents: []model.Document{{DocumentID: "1", Name: "terms.pdf", DocumentBase64: []byte("TERMS")}},
+		Recipients: &model.Recipients{
+			Signers: []model.Signer{{RecipientID: "5", RoleName: "Client", RoutingOrder: "1", Tabs: &model.Tabs{
+				ListTabs: []model.List{{TabPosition: model.TabPosition{TabLabel: "plan"}, ListItems: []model.ListItem{
+					{Text: "Basic", Value: "basic"}, {Text: "Pro", Value: "pro"},
+				}}},
+			}}},
+		},
+	}
+	var ids []string
+	for _, tmpl := range []*model.EnvelopeTemplate{nda, terms} {
+		summary, err := sv.Create(tmpl).Do(context.Background())
+		if err != nil {
+			t.Fatalf("create template %s expected success; got %v", tmpl.Name, err)
+		}
+		ids = append(ids, summary.TemplateID)
+	}
+	return ids[0], ids[1]
+}
+
+func TestComposer_BindingError(t *testing.T) {
+	ctx := context.Background()
+	srv := esigntest.NewServer()
+	defer srv.Close()
+	sv := templates.New(srv.Credential())
+	nda, terms := createTemplates(t, sv)
+
+	c := templates.NewComposer(sv)
+	if err := c.Add(ctx, nda, "missing"); err == nil {
+		t.Errorf("expected error for missing template")
+	}
+	c = templates.NewComposer(sv)
+	if err := c.Add(ctx, nda, terms); err != nil {
+		t.Fatalf("expected templates loaded; got %v", err)
+	}
+	c.Bind("Nobody", templates.Person{Name: "No", Email: "no@example.com"})
+	c.SetTab("missing", "X")
+	c.SetRoleTab("Client", "plan", "gold")
+	_, err := c.Definition()
+	be, ok := err.(*templates.BindingError)
+	if !ok {
+		t.Fatalf("expected *BindingError; got %v", err)
+	}
+	want := &templates.BindingError{
+		UnboundRoles: []string{"Client"},
+		UnboundTabs:  []string{"Client: company"},
+		UnknownRoles: []string{"Nobody"},
+		UnknownTabs:  []string{"Client: plan", "missing"},
+	}
+	if !reflect.DeepEqual(be, want) {
+		t.Errorf("expected %#v; got %#v", want, be)
+	}
+	if c.Check() == nil {
+		t.Errorf("expected Check to return error")
+	}
+
+	// unbound roles are sorted
+	summary, err := sv.Create(&model.EnvelopeTemplate{
+		Name:      "Witnessed",
+		Documents: []model.Document{{DocumentID: "1", Name: "w.pdf", DocumentBase64: []byte("W")}},
+		Recipients: &model.Recipients{
+			Signers: []model.Signer{{RecipientID: "1", RoleName: "Witness"}, {RecipientID: "2", RoleName: "Approver"}},
+		},
+	}).Do(ctx)
+	if err != nil {
+		t.Fatalf("create template expected success; got %v", err)
+	}
+	c = templates.NewComposer(sv)
+	if err := c.Add(ctx, summary.TemplateID); err != nil {
+		t.Fatalf("expected template loaded; got %v", err)
+	}
+	_, err = c.Definition()
+	if be, ok := err.(*templates.BindingError); !ok || !reflect.DeepEqual(be.UnboundRoles, []string{"Approver", "Witness"}) {
+		t.Errorf("expected sorted unbound roles; got %v", err)
+	}
+}
+
+func TestComposer_Definition(t *testing.T) {
+	ctx := context.Background()
+	srv := esigntest.NewServer()
+	defer srv.Close()
+	sv := templates.New(srv.Credential())
+	nda, terms := createTemplates(t, sv)
+
+	c := templates.NewComposer(sv)
+	if err := c.Add(ctx, nda, terms); err != nil {
+		t.Fatalf("expected templates loaded; got %v", err)
+	}
+	c.EmailSubject = "Please sign"
+	c.Status = "sent"
+	c.Bind("Client", templates.Person{Name: "Jane Doe", Email: "jane@example.com"})
+	c.SetTab("company", "ACME")
+	c.SetRoleTab("Client", "plan", "pro")
+	c.AddDocument(model.Document{DocumentID: "1", Name: "addendum.pdf", DocumentBase64: []byte("ADDENDUM")})
+	if err := c.Check(); err != nil {
+		t.Fatalf("expected complete bindings; got %v", err)
+	}
+	def, err := c.Definition()
+	if err != nil {
+		t.Fatalf("expected definition; got %v", err)
+	}
+	if len(def.CompositeTemplates) != 3 {
+		t.Fatalf("expected 3 composite templates; got %d", len(def.CompositeTemplates))
+	}
+	for i, ct := range def.CompositeTemplates[:2] {
+		if ct.ServerTemplates[0].Sequence != "1" || ct.InlineTemplates[0].Sequence != "2" ||
+			ct.InlineTemplates[0].Recipients.Signers[0].RecipientID != "1" {
+			t.Errorf("composite template %d: unexpected sequences or recipient ids %#v", i, ct)
+		}
+	}
+
+	esv := envelopes.New(srv.Credential())
+	summary, err := esv.Create(def).Do(ctx)
+	if err != nil {
+		t.Fatalf("expected envelope created; got %v", err)
+	}
+	env, _ := srv.Envelope(summary.EnvelopeID)
+	signers := env.Recipients.Signers
+	if len(signers) != 1 || signers[0].Email != "jane@example.com" || signers[0].RecipientID != "1" {
+		t.Fatalf("expected single bound signer; got %#v", signers)
+	}
+	for label, val := range map[string]string{"company": "ACME", "plan": "pro", "title": ""} {
+		if v, _ := signers[0].Tabs.GetValue(label); v != val {
+			t.Errorf("expected %s = %q; got %q", label, val, v)
+		}
+	}
+	if ccs := env.Recipients.CarbonCopies; len(ccs) != 1 || ccs[0].Email != "legal@example.com" {
+		t.Errorf("expected template carbon copy; got %#v", ccs)
+	}
+	docs, err := esv.DocumentsList(summary.EnvelopeID).Do(ctx)
+	if err != nil {
+		t.Fatalf("expected documents; got %v", err)
+	}
+	var names []string
+	for _, d := range docs.EnvelopeDocuments {
+		names = append(names, d.DocumentID+":"+d.Name)
+	}
+	if want := []string{"1:nda.pdf", "2:terms.pdf", "3:addendum.pdf", "certificate:Summary"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("expected documents %v; got %v", want, names)
+	}
+}
+
+func TestComposer_LayeredRecipientTypes(t *testing.T) {
+	c := templates.NewComposer(nil)
+	c.AddRecipients("T1", &model.Recipients{
+		Signers: []model.Signer{{RoleName: "Client", RoutingOrder: "1"}},
+	})
+	c.AddRecipients("T2", &model.Recipients{
+		CarbonCopies: []model.CarbonCopy{{RoleName: "Client", RoutingOrder: "2"}},
+		Signers:      []model.Signer{{RoleName: "Manager", RoutingOrder: "1", Email: "manager@example.com"}},
+	})
+	c.Bind("Client", templates.Person{Name: "Jane Doe", Email: "jane@example.com"})
+	c.AddDocument(model.Document{DocumentID: "1", Name: "addendum.pdf", DocumentBase64: []byte("ADDENDUM")})
+	def, err := c.Definition()
+	if err != nil {
+		t.Fatalf("expected definition; got %v", err)
+	}
+	if len(def.CompositeTemplates) != 3 {
+		t.Fatalf("expected 3 composite templates; got %d", len(def.CompositeTemplates))
+	}
+	r1 := def.CompositeTemplates[0].InlineTemplates[0].Recipients
+	if len(r1.Signers) != 1 || r1.Signers[0].RecipientID != "1" || len(r1.CarbonCopies) != 0 {
+		t.Errorf("expected signer 1 in first template; got %#v", r1)
+	}
+	// Manager, a signer added before carbon copies, is recipient 2 and,
+	// being unbound without tabs, is left as defined in T2
+	r2 := def.CompositeTemplates[1].InlineTemplates[0].Recipients
+	if len(r2.CarbonCopies) != 1 || r2.CarbonCopies[0].RecipientID != "3" || r2.CarbonCopies[0].Email != "jane@example.com" || len(r2.Signers) != 0 {
+		t.Errorf("expected carbon copy 3 in second template; got %#v", r2)
+	}
+	// added documents go to the role's first recipient type
+	r3 := def.CompositeTemplates[2].InlineTemplates[0].Recipients
+	if len(r3.Signers) != 1 || r3.Signers[0].RecipientID != "1" || len(r3.CarbonCopies) != 0 {
+		t.Errorf("expected signer 1 for added documents; got %#v", r3)
+	}
+}
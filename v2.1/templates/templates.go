@@ -19,6 +19,10 @@
 // You can create templates either programmatically or through the DocuSign web interface and then used by your application.
 //
 //
+// The specification lists no field constraints for this API's models,
+// so they have no Validate methods and ops have no ValidateFirst option.
+// Constraints may be added with a gen-esign overrides file.
+//
 // Service Api documentation may be found at:
 // https://developers.docusign.com/docs/esign-rest-api/reference/Templates
 // Usage example:
@@ -179,6 +183,45 @@ func (op *BulkRecipientsListOp) Do(ctx context.Context) (*model.BulkRecipientsRe
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *BulkRecipientsListOp) Pages(ctx context.Context, f func(*model.BulkRecipientsResponse) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   res.EndPosition,
+			NextURI:       &res.NextURI,
+			ResultSetSize: res.ResultSetSize,
+			StartPosition: res.StartPosition,
+			TotalSetSize:  res.TotalSetSize,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // IncludeTabs when **true,** the tab information associated with the recipient is included in the response. If you do not specify this parameter, the effect is the default behavior (**false**).
 func (op *BulkRecipientsListOp) IncludeTabs() *BulkRecipientsListOp {
 	if op != nil {
@@ -433,9 +476,9 @@ func (op *DocumentTabsGetOp) Do(ctx context.Context) (*model.Tabs, error) {
 // PageNumbers filters for tabs that occur on the pages that you specify. Enter as a comma-separated list of page Guids.
 //
 // Example: `page_numbers=2,6`
-func (op *DocumentTabsGetOp) PageNumbers(val string) *DocumentTabsGetOp {
+func (op *DocumentTabsGetOp) PageNumbers(val ...string) *DocumentTabsGetOp {
 	if op != nil {
-		op.QueryOpts.Set("page_numbers", val)
+		op.QueryOpts.Set("page_numbers", strings.Join(val, ","))
 	}
 	return op
 }
@@ -1166,9 +1209,13 @@ func (op *GetOp) Do(ctx context.Context) (*model.EnvelopeTemplate, error) {
 // - `tabs`: Includes information about tabs.
 // - `documents`: Includes information about documents.
 // - `favorite_template_status`: : Includes the template `favoritedByMe` property in the response. **Note:** You can mark a template as a favorite only in eSignature v2.1.
-func (op *GetOp) Include(val ...string) *GetOp {
+func (op *GetOp) Include(val ...GetInclude) *GetOp {
 	if op != nil {
-		op.QueryOpts.Set("include", strings.Join(val, ","))
+		vals := make([]string, len(val))
+		for i, v := range val {
+			vals[i] = string(v)
+		}
+		op.QueryOpts.Set("include", strings.Join(vals, ","))
 	}
 	return op
 }
@@ -1279,6 +1326,45 @@ func (op *GetPageImagesOp) Do(ctx context.Context) (*model.PageImages, error) {
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *GetPageImagesOp) Pages(ctx context.Context, f func(*model.PageImages) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   res.EndPosition,
+			NextURI:       &res.NextURI,
+			ResultSetSize: res.ResultSetSize,
+			StartPosition: res.StartPosition,
+			TotalSetSize:  res.TotalSetSize,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // Count is the maximum number of results to return.
 func (op *GetPageImagesOp) Count(val int) *GetPageImagesOp {
 	if op != nil {
@@ -1360,6 +1446,45 @@ func (op *ListOp) Do(ctx context.Context) (*model.EnvelopeTemplateResults, error
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *ListOp) Pages(ctx context.Context, f func(*model.EnvelopeTemplateResults) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   res.EndPosition,
+			NextURI:       &res.NextURI,
+			ResultSetSize: res.ResultSetSize,
+			StartPosition: res.StartPosition,
+			TotalSetSize:  res.TotalSetSize,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // Count is the maximum number of results to return.
 //
 // Use `start_position` to specify the number of results to skip.
@@ -1371,17 +1496,17 @@ func (op *ListOp) Count(val int) *ListOp {
 }
 
 // CreatedFromDate lists templates created on or after this date.
-func (op *ListOp) CreatedFromDate(val string) *ListOp {
+func (op *ListOp) CreatedFromDate(val time.Time) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("created_from_date", val)
+		op.QueryOpts.Set("created_from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
 
 // CreatedToDate lists templates modified before this date.
-func (op *ListOp) CreatedToDate(val string) *ListOp {
+func (op *ListOp) CreatedToDate(val time.Time) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("created_to_date", val)
+		op.QueryOpts.Set("created_to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -1400,9 +1525,9 @@ func (op *ListOp) FolderIds(val ...string) *ListOp {
 //   Templates in the **Shared Templates**  and **All Template** folders (if the request ID from and Admin) are excluded.
 // - `templates_root`: Templates in the root level of the **My Templates** folder, but not in an actual folder. Note that the **My Templates** folder is not a real folder.
 // - `recylebin`: Templates that have been deleted.
-func (op *ListOp) FolderTypes(val string) *ListOp {
+func (op *ListOp) FolderTypes(val FolderTypes) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("folder_types", val)
+		op.QueryOpts.Set("folder_types", string(val))
 	}
 	return op
 }
@@ -1410,7 +1535,7 @@ func (op *ListOp) FolderTypes(val string) *ListOp {
 // FromDate start of the search date range. Only returns templates created on or after this date/time. If no value is specified, there is no limit on the earliest date created.
 func (op *ListOp) FromDate(val time.Time) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("from_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -1428,9 +1553,13 @@ func (op *ListOp) FromDate(val time.Time) *ListOp {
 // - `recipients`: Includes information about template recipients.
 // - `custom_fields`: Includes information about template custom fields.
 // - `notifications`: Includes information about the notification settings for templates.
-func (op *ListOp) Include(val ...string) *ListOp {
+func (op *ListOp) Include(val ...ListInclude) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("include", strings.Join(val, ","))
+		vals := make([]string, len(val))
+		for i, v := range val {
+			vals[i] = string(v)
+		}
+		op.QueryOpts.Set("include", strings.Join(vals, ","))
 	}
 	return op
 }
@@ -1460,7 +1589,7 @@ func (op *ListOp) IsDownload(val string) *ListOp {
 // ModifiedFromDate lists templates modified on or after this date.
 func (op *ListOp) ModifiedFromDate(val time.Time) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("modified_from_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("modified_from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -1468,7 +1597,7 @@ func (op *ListOp) ModifiedFromDate(val time.Time) *ListOp {
 // ModifiedToDate lists templates modified before this date.
 func (op *ListOp) ModifiedToDate(val time.Time) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("modified_to_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("modified_to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -1478,9 +1607,9 @@ func (op *ListOp) ModifiedToDate(val time.Time) *ListOp {
 //
 // - `asc`: Ascending (A to Z)
 // - `desc`: Descending (Z to A)
-func (op *ListOp) Order(val string) *ListOp {
+func (op *ListOp) Order(val Order) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("order", val)
+		op.QueryOpts.Set("order", string(val))
 	}
 	return op
 }
@@ -1491,9 +1620,9 @@ func (op *ListOp) Order(val string) *ListOp {
 // - `name`: template name
 // - `modified`: date/time template was last modified
 // - `used`: date/time the template was last used.
-func (op *ListOp) OrderBy(val string) *ListOp {
+func (op *ListOp) OrderBy(val OrderBy) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("order_by", val)
+		op.QueryOpts.Set("order_by", string(val))
 	}
 	return op
 }
@@ -1504,9 +1633,9 @@ func (op *ListOp) OrderBy(val string) *ListOp {
 // - `sender`: Include sender name and email in the search.
 // - `recipients`: Include recipient names and emails in the search.
 // - `envelope`: Not used in template searches.
-func (op *ListOp) SearchFields(val string) *ListOp {
+func (op *ListOp) SearchFields(val ...string) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("search_fields", val)
+		op.QueryOpts.Set("search_fields", strings.Join(val, ","))
 	}
 	return op
 }
@@ -1544,9 +1673,9 @@ func (op *ListOp) StartPosition(val int) *ListOp {
 }
 
 // TemplateIds is a comma-separated list of template IDs to download. This value is valid only when `is_download` is **true.**
-func (op *ListOp) TemplateIds(val string) *ListOp {
+func (op *ListOp) TemplateIds(val ...string) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("template_ids", val)
+		op.QueryOpts.Set("template_ids", strings.Join(val, ","))
 	}
 	return op
 }
@@ -1556,7 +1685,7 @@ func (op *ListOp) TemplateIds(val string) *ListOp {
 // **Note:** If this property is null, the value defaults to the current date.
 func (op *ListOp) ToDate(val time.Time) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("to_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -1564,7 +1693,7 @@ func (op *ListOp) ToDate(val time.Time) *ListOp {
 // UsedFromDate start of the search date range. Only returns templates used or edited on or after this date/time. If no value is specified, there is no limit on the earliest date used.
 func (op *ListOp) UsedFromDate(val time.Time) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("used_from_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("used_from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -1572,7 +1701,7 @@ func (op *ListOp) UsedFromDate(val time.Time) *ListOp {
 // UsedToDate end of the search date range. Only returns templates used or edited up to this date/time. If no value is provided, this defaults to the current date.
 func (op *ListOp) UsedToDate(val time.Time) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("used_to_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("used_to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -1582,9 +1711,9 @@ func (op *ListOp) UsedToDate(val time.Time) *ListOp {
 // - `owned_by_me`: Results include only templates owned by the user.
 // - `shared_with_me`: Results include only templates owned by the user.
 // - `all`:  Results include all templates owned or shared with the user.
-func (op *ListOp) UserFilter(val string) *ListOp {
+func (op *ListOp) UserFilter(val UserFilter) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("user_filter", val)
+		op.QueryOpts.Set("user_filter", string(val))
 	}
 	return op
 }
@@ -1900,3 +2029,67 @@ func (op *ResponsiveHTMLPreviewCreateOp) Do(ctx context.Context) (*model.Documen
 	var res *model.DocumentHTMLDefinitions
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
+
+
+// FolderTypes is a value of the folder_types query option.
+type FolderTypes string
+
+// FolderTypes values
+const (
+	FolderTypesTemplates FolderTypes = "templates"
+)
+
+// GetInclude is a value of the include query option.
+type GetInclude string
+
+// GetInclude values
+const (
+	GetIncludePowerforms             GetInclude = "powerforms"
+	GetIncludeTabs                   GetInclude = "tabs"
+	GetIncludeDocuments              GetInclude = "documents"
+	GetIncludeFavoriteTemplateStatus GetInclude = "favorite_template_status"
+)
+
+// ListInclude is a value of the include query option.
+type ListInclude string
+
+// ListInclude values
+const (
+	ListIncludePowerforms             ListInclude = "powerforms"
+	ListIncludeDocuments              ListInclude = "documents"
+	ListIncludeFolders                ListInclude = "folders"
+	ListIncludeFavoriteTemplateStatus ListInclude = "favorite_template_status"
+	ListIncludeAdvancedTemplates      ListInclude = "advanced_templates"
+	ListIncludeRecipients             ListInclude = "recipients"
+	ListIncludeCustomFields           ListInclude = "custom_fields"
+	ListIncludeNotifications          ListInclude = "notifications"
+)
+
+// Order is a value of the order query option.
+type Order string
+
+// Order values
+const (
+	OrderAsc  Order = "asc"
+	OrderDesc Order = "desc"
+)
+
+// OrderBy is a value of the order_by query option.
+type OrderBy string
+
+// OrderBy values
+const (
+	OrderByName     OrderBy = "name"
+	OrderByModified OrderBy = "modified"
+	OrderByUsed     OrderBy = "used"
+)
+
+// UserFilter is a value of the user_filter query option.
+type UserFilter string
+
+// UserFilter values
+const (
+	UserFilterOwnedByMe    UserFilter = "owned_by_me"
+	UserFilterSharedWithMe UserFilter = "shared_with_me"
+	UserFilterAll          UserFilter = "all"
+)
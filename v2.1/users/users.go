@@ -18,6 +18,10 @@
 // * Add and delete the initials and signature images for a user.
 //
 //
+// The specification lists no field constraints for this API's models,
+// so they have no Validate methods and ops have no ValidateFirst option.
+// Constraints may be added with a gen-esign overrides file.
+//
 // Service Api documentation may be found at:
 // https://developers.docusign.com/docs/esign-rest-api/reference/Users
 // Usage example:
@@ -156,9 +160,9 @@ func (op *ContactsGetOp) Do(ctx context.Context) (*model.ContactGetResponse, err
 //
 // - `rooms`
 // - `docusignCore` (default)
-func (op *ContactsGetOp) CloudProvider(val string) *ContactsGetOp {
+func (op *ContactsGetOp) CloudProvider(val CloudProvider) *ContactsGetOp {
 	if op != nil {
-		op.QueryOpts.Set("cloud_provider", val)
+		op.QueryOpts.Set("cloud_provider", string(val))
 	}
 	return op
 }
@@ -477,9 +481,9 @@ func (op *SignaturesListOp) Do(ctx context.Context) (*model.UserSignaturesInform
 // - `signature`: Returns information about signature images only. This is the default value.
 // - `stamp`: Returns information about eHanko and custom stamps only.
 // - null
-func (op *SignaturesListOp) StampType(val string) *SignaturesListOp {
+func (op *SignaturesListOp) StampType(val StampType) *SignaturesListOp {
 	if op != nil {
-		op.QueryOpts.Set("stamp_type", val)
+		op.QueryOpts.Set("stamp_type", string(val))
 	}
 	return op
 }
@@ -631,9 +635,9 @@ func (op *DeleteOp) Do(ctx context.Context) (*model.UsersResponse, error) {
 }
 
 // Delete iD of the user to delete. This parameter takes a comma-separated list of values in the format: `Groups,PermissionSet,SigningGroupsEmail`.
-func (op *DeleteOp) Delete(val string) *DeleteOp {
+func (op *DeleteOp) Delete(val ...string) *DeleteOp {
 	if op != nil {
-		op.QueryOpts.Set("delete", val)
+		op.QueryOpts.Set("delete", strings.Join(val, ","))
 	}
 	return op
 }
@@ -784,6 +788,45 @@ func (op *ListOp) Do(ctx context.Context) (*model.UserInformationList, error) {
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *ListOp) Pages(ctx context.Context, f func(*model.UserInformationList) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   res.EndPosition,
+			NextURI:       &res.NextURI,
+			ResultSetSize: res.ResultSetSize,
+			StartPosition: res.StartPosition,
+			TotalSetSize:  res.TotalSetSize,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // AdditionalInfo when **true,** the custom settings information is returned for each user in the account. If this parameter is omitted, the default behavior is **false.**
 func (op *ListOp) AdditionalInfo() *ListOp {
 	if op != nil {
@@ -893,9 +936,13 @@ func (op *ListOp) StartPosition(val int) *ListOp {
 // * `Active`
 // * `Closed`
 // * `Disabled`
-func (op *ListOp) Status(val ...string) *ListOp {
+func (op *ListOp) Status(val ...Status) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("status", strings.Join(val, ","))
+		vals := make([]string, len(val))
+		for i, v := range val {
+			vals[i] = string(v)
+		}
+		op.QueryOpts.Set("status", strings.Join(vals, ","))
 	}
 	return op
 }
@@ -1035,3 +1082,35 @@ func (op *UpdateSettingsOp) AllowAllLanguages(val string) *UpdateSettingsOp {
 	}
 	return op
 }
+
+
+// CloudProvider is a value of the cloud_provider query option.
+type CloudProvider string
+
+// CloudProvider values
+const (
+	CloudProviderRooms        CloudProvider = "rooms"
+	CloudProviderDocusignCore CloudProvider = "docusignCore"
+)
+
+// StampType is a value of the stamp_type query option.
+type StampType string
+
+// StampType values
+const (
+	StampTypeSignature StampType = "signature"
+	StampTypeStamp     StampType = "stamp"
+	StampTypeNull      StampType = "null"
+)
+
+// Status is a value of the status query option.
+type Status string
+
+// Status values
+const (
+	StatusActivationRequired Status = "ActivationRequired"
+	StatusActivationSent     Status = "ActivationSent"
+	StatusActive             Status = "Active"
+	StatusClosed             Status = "Closed"
+	StatusDisabled           Status = "Disabled"
+)
@@ -19,6 +19,10 @@
 // * Listing the recipient names associated with an email address that was used by the account. For example, a single email address is often shared by mulitple members of a family.
 //
 //
+// The specification lists no field constraints for this API's models,
+// so they have no Validate methods and ops have no ValidateFirst option.
+// Constraints may be added with a gen-esign overrides file.
+//
 // Service Api documentation may be found at:
 // https://developers.docusign.com/docs/esign-rest-api/v2/reference/Accounts
 // Usage example:
@@ -746,9 +750,13 @@ func (op *PermissionProfilesCreateOp) Do(ctx context.Context) (*model.Permission
 // - `custom_fields`: Includes information about template custom fields.
 // - `notifications`: Includes information about the notification settings for templates.
 // - `advanced_templates`: Includes advanced templates in the response. For example, these include templates that use advanced recipient routing. We recommend that you use this option to ensure that the response includes all relevant templates.
-func (op *PermissionProfilesCreateOp) Include(val ...string) *PermissionProfilesCreateOp {
+func (op *PermissionProfilesCreateOp) Include(val ...Include) *PermissionProfilesCreateOp {
 	if op != nil {
-		op.QueryOpts.Set("include", strings.Join(val, ","))
+		vals := make([]string, len(val))
+		for i, v := range val {
+			vals[i] = string(v)
+		}
+		op.QueryOpts.Set("include", strings.Join(vals, ","))
 	}
 	return op
 }
@@ -817,9 +825,13 @@ func (op *PermissionProfilesGetOp) Do(ctx context.Context) (*model.PermissionPro
 // - `custom_fields`: Includes information about template custom fields.
 // - `notifications`: Includes information about the notification settings for templates.
 // - `advanced_templates`: Includes advanced templates in the response. For example, these include templates that use advanced recipient routing. We recommend that you use this option to ensure that the response includes all relevant templates.
-func (op *PermissionProfilesGetOp) Include(val ...string) *PermissionProfilesGetOp {
+func (op *PermissionProfilesGetOp) Include(val ...Include) *PermissionProfilesGetOp {
 	if op != nil {
-		op.QueryOpts.Set("include", strings.Join(val, ","))
+		vals := make([]string, len(val))
+		for i, v := range val {
+			vals[i] = string(v)
+		}
+		op.QueryOpts.Set("include", strings.Join(vals, ","))
 	}
 	return op
 }
@@ -891,9 +903,13 @@ func (op *PermissionProfilesUpdateOp) Do(ctx context.Context) (*model.Permission
 // - `custom_fields`: Includes information about template custom fields.
 // - `notifications`: Includes information about the notification settings for templates.
 // - `advanced_templates`: Includes advanced templates in the response. For example, these include templates that use advanced recipient routing. We recommend that you use this option to ensure that the response includes all relevant templates.
-func (op *PermissionProfilesUpdateOp) Include(val ...string) *PermissionProfilesUpdateOp {
+func (op *PermissionProfilesUpdateOp) Include(val ...Include) *PermissionProfilesUpdateOp {
 	if op != nil {
-		op.QueryOpts.Set("include", strings.Join(val, ","))
+		vals := make([]string, len(val))
+		for i, v := range val {
+			vals[i] = string(v)
+		}
+		op.QueryOpts.Set("include", strings.Join(vals, ","))
 	}
 	return op
 }
@@ -1196,9 +1212,9 @@ func (op *GetBillingChargesOp) Do(ctx context.Context) (*model.BillingChargeResp
 //
 // * envelopes
 // * seats
-func (op *GetBillingChargesOp) IncludeCharges(val string) *GetBillingChargesOp {
+func (op *GetBillingChargesOp) IncludeCharges(val IncludeCharges) *GetBillingChargesOp {
 	if op != nil {
-		op.QueryOpts.Set("include_charges", val)
+		op.QueryOpts.Set("include_charges", string(val))
 	}
 	return op
 }
@@ -1311,6 +1327,45 @@ func (op *ListSharedAccessOp) Do(ctx context.Context) (*model.AccountSharedAcces
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *ListSharedAccessOp) Pages(ctx context.Context, f func(*model.AccountSharedAccess) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   res.EndPosition,
+			NextURI:       &res.NextURI,
+			ResultSetSize: res.ResultSetSize,
+			StartPosition: res.StartPosition,
+			TotalSetSize:  res.TotalSetSize,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // Count specifies maximum number of results included in the response. If no value is specified, this defaults to 1000.
 func (op *ListSharedAccessOp) Count(val int) *ListSharedAccessOp {
 	if op != nil {
@@ -1343,9 +1398,9 @@ func (op *ListSharedAccessOp) FolderIds(val ...string) *ListSharedAccessOp {
 // - `envelopes`: Get information about envelope sharing between users.
 // - `templates`: Get information about template sharing among users and groups.
 // - `folders`: Get information about folder sharing among users and groups.
-func (op *ListSharedAccessOp) ItemType(val string) *ListSharedAccessOp {
+func (op *ListSharedAccessOp) ItemType(val ItemType) *ListSharedAccessOp {
 	if op != nil {
-		op.QueryOpts.Set("item_type", val)
+		op.QueryOpts.Set("item_type", string(val))
 	}
 	return op
 }
@@ -1369,9 +1424,9 @@ func (op *ListSharedAccessOp) SearchText(val string) *ListSharedAccessOp {
 // - `shared_to_and_from`: The response contains users in `user_list` who are sharing items to and sharing items from the current user.
 //
 // If the current user does not have administrative privileges, only the `shared_to` option is valid.
-func (op *ListSharedAccessOp) Shared(val string) *ListSharedAccessOp {
+func (op *ListSharedAccessOp) Shared(val ...string) *ListSharedAccessOp {
 	if op != nil {
-		op.QueryOpts.Set("shared", val)
+		op.QueryOpts.Set("shared", strings.Join(val, ","))
 	}
 	return op
 }
@@ -1497,9 +1552,9 @@ func (op *UpdateSharedAccessOp) Do(ctx context.Context) (*model.AccountSharedAcc
 // - `envelopes`: Get information about envelope sharing between users.
 // - `templates`: Get information about template sharing among users and groups.
 // - `folders`: Get information about folder sharing among users and groups.
-func (op *UpdateSharedAccessOp) ItemType(val string) *UpdateSharedAccessOp {
+func (op *UpdateSharedAccessOp) ItemType(val ItemType) *UpdateSharedAccessOp {
 	if op != nil {
-		op.QueryOpts.Set("item_type", val)
+		op.QueryOpts.Set("item_type", string(val))
 	}
 	return op
 }
@@ -1695,3 +1750,35 @@ func (op *IdentityVerificationsListOp) Do(ctx context.Context) (*model.AccountId
 	var res *model.AccountIdentityVerificationResponse
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
+
+// Include is a value of the include query option.
+type Include string
+
+// Include values
+const (
+	IncludeRecipients        Include = "recipients"
+	IncludeFolders           Include = "folders"
+	IncludeDocuments         Include = "documents"
+	IncludeCustomFields      Include = "custom_fields"
+	IncludeNotifications     Include = "notifications"
+	IncludeAdvancedTemplates Include = "advanced_templates"
+)
+
+// IncludeCharges is a value of the include_charges query option.
+type IncludeCharges string
+
+// IncludeCharges values
+const (
+	IncludeChargesEnvelopes IncludeCharges = "envelopes"
+	IncludeChargesSeats     IncludeCharges = "seats"
+)
+
+// ItemType is a value of the item_type query option.
+type ItemType string
+
+// ItemType values
+const (
+	ItemTypeEnvelopes ItemType = "envelopes"
+	ItemTypeTemplates ItemType = "templates"
+	ItemTypeFolders   ItemType = "folders"
+)
@@ -15,6 +15,10 @@
 // * Retrieve and update payment information.
 //
 //
+// The specification lists no field constraints for this API's models,
+// so they have no Validate methods and ops have no ValidateFirst option.
+// Constraints may be added with a gen-esign overrides file.
+//
 // Service Api documentation may be found at:
 // https://developers.docusign.com/docs/esign-rest-api/v2/reference/Billing
 // Usage example:
@@ -282,7 +286,7 @@ func (op *InvoicesListOp) Do(ctx context.Context) (*model.BillingInvoicesRespons
 // FromDate specifies the date/time of the earliest invoice in the account to retrieve.
 func (op *InvoicesListOp) FromDate(val time.Time) *InvoicesListOp {
 	if op != nil {
-		op.QueryOpts.Set("from_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -290,7 +294,7 @@ func (op *InvoicesListOp) FromDate(val time.Time) *InvoicesListOp {
 // ToDate specifies the date/time of the latest invoice in the account to retrieve.
 func (op *InvoicesListOp) ToDate(val time.Time) *InvoicesListOp {
 	if op != nil {
-		op.QueryOpts.Set("to_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -398,7 +402,7 @@ func (op *PaymentsListOp) Do(ctx context.Context) (*model.BillingPaymentsRespons
 // FromDate specifies the date/time of the earliest payment in the account to retrieve.
 func (op *PaymentsListOp) FromDate(val time.Time) *PaymentsListOp {
 	if op != nil {
-		op.QueryOpts.Set("from_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -406,7 +410,7 @@ func (op *PaymentsListOp) FromDate(val time.Time) *PaymentsListOp {
 // ToDate specifies the date/time of the latest payment in the account to retrieve.
 func (op *PaymentsListOp) ToDate(val time.Time) *PaymentsListOp {
 	if op != nil {
-		op.QueryOpts.Set("to_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -13,6 +13,10 @@
 // It is also used to manage the user's authentication/accounts with cloud storage service providers.
 //
 //
+// The specification lists no field constraints for this API's models,
+// so they have no Validate methods and ops have no ValidateFirst option.
+// Constraints may be added with a gen-esign overrides file.
+//
 // Service Api documentation may be found at:
 // https://developers.docusign.com/docs/esign-rest-api/v2/reference/CloudStorage
 // Usage example:
@@ -70,6 +74,45 @@ func (op *ListOp) Do(ctx context.Context) (*model.ExternalFolder, error) {
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *ListOp) Pages(ctx context.Context, f func(*model.ExternalFolder) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   res.EndPosition,
+			NextURI:       &res.NextURI,
+			ResultSetSize: res.ResultSetSize,
+			StartPosition: res.StartPosition,
+			TotalSetSize:  res.TotalSetSize,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // CloudStorageFolderPath set the call query parameter cloud_storage_folder_path
 func (op *ListOp) CloudStorageFolderPath(val string) *ListOp {
 	if op != nil {
@@ -107,9 +150,9 @@ func (op *ListOp) Order(val string) *ListOp {
 //
 // * modified
 // * name
-func (op *ListOp) OrderBy(val string) *ListOp {
+func (op *ListOp) OrderBy(val OrderBy) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("order_by", val)
+		op.QueryOpts.Set("order_by", string(val))
 	}
 	return op
 }
@@ -155,6 +198,45 @@ func (op *ListFoldersOp) Do(ctx context.Context) (*model.ExternalFolder, error)
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *ListFoldersOp) Pages(ctx context.Context, f func(*model.ExternalFolder) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   res.EndPosition,
+			NextURI:       &res.NextURI,
+			ResultSetSize: res.ResultSetSize,
+			StartPosition: res.StartPosition,
+			TotalSetSize:  res.TotalSetSize,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // CloudStorageFolderPath is a comma separated list of folder IDs included in the request.
 func (op *ListFoldersOp) CloudStorageFolderPath(val ...string) *ListFoldersOp {
 	if op != nil {
@@ -192,9 +274,9 @@ func (op *ListFoldersOp) Order(val string) *ListFoldersOp {
 //
 // * modified
 // * name
-func (op *ListFoldersOp) OrderBy(val string) *ListFoldersOp {
+func (op *ListFoldersOp) OrderBy(val OrderBy) *ListFoldersOp {
 	if op != nil {
-		op.QueryOpts.Set("order_by", val)
+		op.QueryOpts.Set("order_by", string(val))
 	}
 	return op
 }
@@ -360,3 +442,12 @@ func (op *ProvidersListOp) RedirectURL(val string) *ProvidersListOp {
 	}
 	return op
 }
+
+// OrderBy is a value of the order_by query option.
+type OrderBy string
+
+// OrderBy values
+const (
+	OrderByModified OrderBy = "modified"
+	OrderByName     OrderBy = "name"
+)
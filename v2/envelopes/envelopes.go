@@ -15,6 +15,10 @@
 // To create and send envelopes, see the [Envelopes resource](Envelopes).
 //
 //
+// The specification lists no field constraints for this API's models,
+// so they have no Validate methods and ops have no ValidateFirst option.
+// Constraints may be added with a gen-esign overrides file.
+//
 // Service Api documentation may be found at:
 // https://developers.docusign.com/docs/esign-rest-api/v2/reference/Envelopes
 // Usage example:
@@ -167,9 +171,13 @@ func (op *ChunkedUploadsGetOp) Do(ctx context.Context) (*model.ChunkedUploadResp
 // - `custom_fields`: Includes information about template custom fields.
 // - `notifications`: Includes information about the notification settings for templates.
 // - `advanced_templates`: Includes advanced templates in the response. For example, these include templates that use advanced recipient routing. We recommend that you use this option to ensure that the response includes all relevant templates.
-func (op *ChunkedUploadsGetOp) Include(val ...string) *ChunkedUploadsGetOp {
+func (op *ChunkedUploadsGetOp) Include(val ...ChunkedUploadsGetInclude) *ChunkedUploadsGetOp {
 	if op != nil {
-		op.QueryOpts.Set("include", strings.Join(val, ","))
+		vals := make([]string, len(val))
+		for i, v := range val {
+			vals[i] = string(v)
+		}
+		op.QueryOpts.Set("include", strings.Join(vals, ","))
 	}
 	return op
 }
@@ -1608,9 +1616,13 @@ func (op *TemplatesListByDocumentOp) Do(ctx context.Context) (*model.TemplateInf
 //
 // * applied
 // * matched
-func (op *TemplatesListByDocumentOp) Include(val ...string) *TemplatesListByDocumentOp {
+func (op *TemplatesListByDocumentOp) Include(val ...TemplatesListByDocumentInclude) *TemplatesListByDocumentOp {
 	if op != nil {
-		op.QueryOpts.Set("include", strings.Join(val, ","))
+		vals := make([]string, len(val))
+		for i, v := range val {
+			vals[i] = string(v)
+		}
+		op.QueryOpts.Set("include", strings.Join(vals, ","))
 	}
 	return op
 }
@@ -1987,6 +1999,45 @@ func (op *GetPageImagesOp) Do(ctx context.Context) (*model.PageImages, error) {
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *GetPageImagesOp) Pages(ctx context.Context, f func(*model.PageImages) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   res.EndPosition,
+			NextURI:       &res.NextURI,
+			ResultSetSize: res.ResultSetSize,
+			StartPosition: res.StartPosition,
+			TotalSetSize:  res.TotalSetSize,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // Count is the maximum number of results to be returned by this request.
 func (op *GetPageImagesOp) Count(val int) *GetPageImagesOp {
 	if op != nil {
@@ -2183,10 +2234,50 @@ func (op *ListStatusOp) Do(ctx context.Context) (*model.EnvelopesInformation, er
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *ListStatusOp) Pages(ctx context.Context, f func(*model.EnvelopesInformation) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			ContinuationToken: res.ContinuationToken,
+			EndPosition:       res.EndPosition,
+			NextURI:           &res.NextURI,
+			ResultSetSize:     res.ResultSetSize,
+			StartPosition:     res.StartPosition,
+			TotalSetSize:      res.TotalSetSize,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // AcStatus specifies the Authoritative Copy Status for the envelopes. The possible values are: Unknown, Original, Transferred, AuthoritativeCopy, AuthoritativeCopyExportPending, AuthoritativeCopyExported, DepositPending, Deposited, DepositedEO, or DepositFailed.
-func (op *ListStatusOp) AcStatus(val string) *ListStatusOp {
+func (op *ListStatusOp) AcStatus(val AcStatus) *ListStatusOp {
 	if op != nil {
-		op.QueryOpts.Set("ac_status", val)
+		op.QueryOpts.Set("ac_status", string(val))
 	}
 	return op
 }
@@ -2216,9 +2307,9 @@ func (op *ListStatusOp) Email(val string) *ListStatusOp {
 }
 
 // EnvelopeIds comma separated list of `envelopeId` values.
-func (op *ListStatusOp) EnvelopeIds(val string) *ListStatusOp {
+func (op *ListStatusOp) EnvelopeIds(val ...string) *ListStatusOp {
 	if op != nil {
-		op.QueryOpts.Set("envelope_ids", val)
+		op.QueryOpts.Set("envelope_ids", strings.Join(val, ","))
 	}
 	return op
 }
@@ -2228,7 +2319,7 @@ func (op *ListStatusOp) EnvelopeIds(val string) *ListStatusOp {
 // ****Note****: This parameter must be set to a valid  `DateTime`, or  `envelope_ids` and/or `transaction_ids` must be specified.
 func (op *ListStatusOp) FromDate(val time.Time) *ListStatusOp {
 	if op != nil {
-		op.QueryOpts.Set("from_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -2242,9 +2333,9 @@ func (op *ListStatusOp) FromDate(val time.Time) *ListStatusOp {
 // For example, if `Created` is specified, then envelopes created during the period are found.
 //
 // The default is `Changed`.
-func (op *ListStatusOp) FromToStatus(val string) *ListStatusOp {
+func (op *ListStatusOp) FromToStatus(val FromToStatus) *ListStatusOp {
 	if op != nil {
-		op.QueryOpts.Set("from_to_status", val)
+		op.QueryOpts.Set("from_to_status", string(val))
 	}
 	return op
 }
@@ -2272,16 +2363,16 @@ func (op *ListStatusOp) Status(val string) *ListStatusOp {
 // Default: "now", the time that you call the method.
 func (op *ListStatusOp) ToDate(val time.Time) *ListStatusOp {
 	if op != nil {
-		op.QueryOpts.Set("to_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
 
 // TransactionIds is a comma-separated list of envelope transaction IDs.
 // Transaction IDs are only valid for seven days.
-func (op *ListStatusOp) TransactionIds(val string) *ListStatusOp {
+func (op *ListStatusOp) TransactionIds(val ...string) *ListStatusOp {
 	if op != nil {
-		op.QueryOpts.Set("transaction_ids", val)
+		op.QueryOpts.Set("transaction_ids", strings.Join(val, ","))
 	}
 	return op
 }
@@ -2325,10 +2416,50 @@ func (op *ListStatusChangesOp) Do(ctx context.Context) (*model.EnvelopesInformat
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *ListStatusChangesOp) Pages(ctx context.Context, f func(*model.EnvelopesInformation) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			ContinuationToken: res.ContinuationToken,
+			EndPosition:       res.EndPosition,
+			NextURI:           &res.NextURI,
+			ResultSetSize:     res.ResultSetSize,
+			StartPosition:     res.StartPosition,
+			TotalSetSize:      res.TotalSetSize,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // AcStatus specifies the Authoritative Copy Status for the envelopes. The possible values are: Unknown, Original, Transferred, AuthoritativeCopy, AuthoritativeCopyExportPending, AuthoritativeCopyExported, DepositPending, Deposited, DepositedEO, or DepositFailed.
-func (op *ListStatusChangesOp) AcStatus(val string) *ListStatusChangesOp {
+func (op *ListStatusChangesOp) AcStatus(val AcStatus) *ListStatusChangesOp {
 	if op != nil {
-		op.QueryOpts.Set("ac_status", val)
+		op.QueryOpts.Set("ac_status", string(val))
 	}
 	return op
 }
@@ -2388,7 +2519,7 @@ func (op *ListStatusChangesOp) EnvelopeIds(val ...string) *ListStatusChangesOp {
 // are set.
 func (op *ListStatusChangesOp) FromDate(val time.Time) *ListStatusChangesOp {
 	if op != nil {
-		op.QueryOpts.Set("from_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -2402,9 +2533,9 @@ func (op *ListStatusChangesOp) FromDate(val time.Time) *ListStatusChangesOp {
 // For example, if `Created` is specified, then envelopes created during the period are found.
 //
 // The default is `Changed`.
-func (op *ListStatusChangesOp) FromToStatus(val string) *ListStatusChangesOp {
+func (op *ListStatusChangesOp) FromToStatus(val FromToStatus) *ListStatusChangesOp {
 	if op != nil {
-		op.QueryOpts.Set("from_to_status", val)
+		op.QueryOpts.Set("from_to_status", string(val))
 	}
 	return op
 }
@@ -2431,9 +2562,13 @@ func (op *ListStatusChangesOp) StartPosition(val int) *ListStatusChangesOp {
 // * voided
 //
 // The `any` value is equivalent to any status.
-func (op *ListStatusChangesOp) Status(val ...string) *ListStatusChangesOp {
+func (op *ListStatusChangesOp) Status(val ...Status) *ListStatusChangesOp {
 	if op != nil {
-		op.QueryOpts.Set("status", strings.Join(val, ","))
+		vals := make([]string, len(val))
+		for i, v := range val {
+			vals[i] = string(v)
+		}
+		op.QueryOpts.Set("status", strings.Join(vals, ","))
 	}
 	return op
 }
@@ -2443,7 +2578,7 @@ func (op *ListStatusChangesOp) Status(val ...string) *ListStatusChangesOp {
 // The default is the current date and time.
 func (op *ListStatusChangesOp) ToDate(val time.Time) *ListStatusChangesOp {
 	if op != nil {
-		op.QueryOpts.Set("to_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -2638,6 +2773,45 @@ func (op *NotaryJournalsListOp) Do(ctx context.Context) (*model.NotaryJournalLis
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *NotaryJournalsListOp) Pages(ctx context.Context, f func(*model.NotaryJournalList) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   res.EndPosition,
+			NextURI:       &res.NextURI,
+			ResultSetSize: res.ResultSetSize,
+			StartPosition: res.StartPosition,
+			TotalSetSize:  res.TotalSetSize,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // Count is the maximum number of results to be returned by this request.
 func (op *NotaryJournalsListOp) Count(val string) *NotaryJournalsListOp {
 	if op != nil {
@@ -2828,3 +3002,78 @@ func (op *ResponsiveHTMLPreviewCreateOp) Do(ctx context.Context) (*model.Documen
 	var res *model.DocumentHTMLDefinitions
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
+
+// AcStatus is a value of the ac_status query option.
+type AcStatus string
+
+// AcStatus values
+const (
+	AcStatusUnknown                        AcStatus = "Unknown"
+	AcStatusOriginal                       AcStatus = "Original"
+	AcStatusTransferred                    AcStatus = "Transferred"
+	AcStatusAuthoritativeCopy              AcStatus = "AuthoritativeCopy"
+	AcStatusAuthoritativeCopyExportPending AcStatus = "AuthoritativeCopyExportPending"
+	AcStatusAuthoritativeCopyExported      AcStatus = "AuthoritativeCopyExported"
+	AcStatusDepositPending                 AcStatus = "DepositPending"
+	AcStatusDeposited                      AcStatus = "Deposited"
+	AcStatusDepositedEO                    AcStatus = "DepositedEO"
+	AcStatusDepositFailed                  AcStatus = "DepositFailed"
+)
+
+// ChunkedUploadsGetInclude is a value of the include query option.
+type ChunkedUploadsGetInclude string
+
+// ChunkedUploadsGetInclude values
+const (
+	ChunkedUploadsGetIncludeRecipients        ChunkedUploadsGetInclude = "recipients"
+	ChunkedUploadsGetIncludeFolders           ChunkedUploadsGetInclude = "folders"
+	ChunkedUploadsGetIncludeDocuments         ChunkedUploadsGetInclude = "documents"
+	ChunkedUploadsGetIncludeCustomFields      ChunkedUploadsGetInclude = "custom_fields"
+	ChunkedUploadsGetIncludeNotifications     ChunkedUploadsGetInclude = "notifications"
+	ChunkedUploadsGetIncludeAdvancedTemplates ChunkedUploadsGetInclude = "advanced_templates"
+)
+
+// FromToStatus is a value of the from_to_status query option.
+type FromToStatus string
+
+// FromToStatus values
+const (
+	FromToStatusVoided     FromToStatus = "Voided"
+	FromToStatusChanged    FromToStatus = "Changed"
+	FromToStatusCreated    FromToStatus = "Created"
+	FromToStatusDeleted    FromToStatus = "Deleted"
+	FromToStatusSent       FromToStatus = "Sent"
+	FromToStatusDelivered  FromToStatus = "Delivered"
+	FromToStatusSigned     FromToStatus = "Signed"
+	FromToStatusCompleted  FromToStatus = "Completed"
+	FromToStatusDeclined   FromToStatus = "Declined"
+	FromToStatusTimedOut   FromToStatus = "TimedOut"
+	FromToStatusProcessing FromToStatus = "Processing"
+)
+
+// Status is a value of the status query option.
+type Status string
+
+// Status values
+const (
+	StatusCompleted  Status = "completed"
+	StatusCreated    Status = "created"
+	StatusDeclined   Status = "declined"
+	StatusDeleted    Status = "deleted"
+	StatusDelivered  Status = "delivered"
+	StatusProcessing Status = "processing"
+	StatusSent       Status = "sent"
+	StatusSigned     Status = "signed"
+	StatusTimedout   Status = "timedout"
+	StatusVoided     Status = "voided"
+	StatusAny        Status = "any"
+)
+
+// TemplatesListByDocumentInclude is a value of the include query option.
+type TemplatesListByDocumentInclude string
+
+// TemplatesListByDocumentInclude values
+const (
+	TemplatesListByDocumentIncludeApplied TemplatesListByDocumentInclude = "applied"
+	TemplatesListByDocumentIncludeMatched TemplatesListByDocumentInclude = "matched"
+)
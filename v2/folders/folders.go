@@ -13,6 +13,10 @@
 // You can list the folder contents and move envelopes between folders.
 //
 //
+// The specification lists no field constraints for this API's models,
+// so they have no Validate methods and ops have no ValidateFirst option.
+// Constraints may be added with a gen-esign overrides file.
+//
 // Service Api documentation may be found at:
 // https://developers.docusign.com/docs/esign-rest-api/v2/reference/Folders
 // Usage example:
@@ -131,10 +135,49 @@ func (op *ListItemsOp) Do(ctx context.Context) (*model.FolderItemsResponse, erro
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *ListItemsOp) Pages(ctx context.Context, f func(*model.FolderItemsResponse) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   res.EndPosition,
+			NextURI:       &res.NextURI,
+			ResultSetSize: res.ResultSetSize,
+			StartPosition: res.StartPosition,
+			TotalSetSize:  res.TotalSetSize,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // FromDate only return items on or after this date. If no value is provided, the default search is the previous 30 days.
 func (op *ListItemsOp) FromDate(val time.Time) *ListItemsOp {
 	if op != nil {
-		op.QueryOpts.Set("from_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -185,9 +228,13 @@ func (op *ListItemsOp) StartPosition(val int) *ListItemsOp {
 // * voided
 //
 // The `any` value is equivalent to any status.
-func (op *ListItemsOp) Status(val string) *ListItemsOp {
+func (op *ListItemsOp) Status(val ...Status) *ListItemsOp {
 	if op != nil {
-		op.QueryOpts.Set("status", val)
+		vals := make([]string, len(val))
+		for i, v := range val {
+			vals[i] = string(v)
+		}
+		op.QueryOpts.Set("status", strings.Join(vals, ","))
 	}
 	return op
 }
@@ -195,7 +242,7 @@ func (op *ListItemsOp) Status(val string) *ListItemsOp {
 // ToDate only return items up to this date. If no value is provided, the default search is to the current date.
 func (op *ListItemsOp) ToDate(val time.Time) *ListItemsOp {
 	if op != nil {
-		op.QueryOpts.Set("to_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -249,6 +296,44 @@ func (op *SearchOp) Do(ctx context.Context) (*model.FolderItemResponse, error) {
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *SearchOp) Pages(ctx context.Context, f func(*model.FolderItemResponse) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   res.EndPosition,
+			NextURI:       &res.NextURI,
+			ResultSetSize: res.ResultSetSize,
+			StartPosition: res.StartPosition,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // All specifies that all envelopes that match the criteria are returned.
 func (op *SearchOp) All() *SearchOp {
 	if op != nil {
@@ -268,7 +353,7 @@ func (op *SearchOp) Count(val int) *SearchOp {
 // FromDate specifies the start of the date range to return. If no value is provided, the default search is the previous 30 days.
 func (op *SearchOp) FromDate(val time.Time) *SearchOp {
 	if op != nil {
-		op.QueryOpts.Set("from_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -290,9 +375,9 @@ func (op *SearchOp) Order(val string) *SearchOp {
 }
 
 // OrderBy specifies the property used to sort the list. Valid values are: `action_required`, `created`, `completed`, `sent`, `signer_list`, `status`, or `subject`.
-func (op *SearchOp) OrderBy(val string) *SearchOp {
+func (op *SearchOp) OrderBy(val OrderBy) *SearchOp {
 	if op != nil {
-		op.QueryOpts.Set("order_by", val)
+		op.QueryOpts.Set("order_by", string(val))
 	}
 	return op
 }
@@ -308,7 +393,39 @@ func (op *SearchOp) StartPosition(val int) *SearchOp {
 // ToDate specifies the end of the date range to return.
 func (op *SearchOp) ToDate(val time.Time) *SearchOp {
 	if op != nil {
-		op.QueryOpts.Set("to_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
+
+// OrderBy is a value of the order_by query option.
+type OrderBy string
+
+// OrderBy values
+const (
+	OrderByActionRequired OrderBy = "action_required"
+	OrderByCreated        OrderBy = "created"
+	OrderByCompleted      OrderBy = "completed"
+	OrderBySent           OrderBy = "sent"
+	OrderBySignerList     OrderBy = "signer_list"
+	OrderByStatus         OrderBy = "status"
+	OrderBySubject        OrderBy = "subject"
+)
+
+// Status is a value of the status query option.
+type Status string
+
+// Status values
+const (
+	StatusCompleted  Status = "completed"
+	StatusCreated    Status = "created"
+	StatusDeclined   Status = "declined"
+	StatusDeleted    Status = "deleted"
+	StatusDelivered  Status = "delivered"
+	StatusProcessing Status = "processing"
+	StatusSent       Status = "sent"
+	StatusSigned     Status = "signed"
+	StatusTimedout   Status = "timedout"
+	StatusVoided     Status = "voided"
+	StatusAny        Status = "any"
+)
@@ -15,6 +15,8 @@ package model // import "github.com/jfcote87/esign/v2/model
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -10700,3 +10702,769 @@ func getRadioTabValues(tabs []RadioGroup) []NameValue {
 	}
 	return results
 }
+
+// TabKind identifies a tab list of Tabs
+type TabKind string
+
+// TabKind values for each list of Tabs
+const (
+	// TabKindApprove identifies ApproveTabs
+	TabKindApprove TabKind = "approve"
+	// TabKindCheckbox identifies CheckboxTabs
+	TabKindCheckbox TabKind = "checkbox"
+	// TabKindCompany identifies CompanyTabs
+	TabKindCompany TabKind = "company"
+	// TabKindDateSigned identifies DateSignedTabs
+	TabKindDateSigned TabKind = "dateSigned"
+	// TabKindDate identifies DateTabs
+	TabKindDate TabKind = "date"
+	// TabKindDecline identifies DeclineTabs
+	TabKindDecline TabKind = "decline"
+	// TabKindEmailAddress identifies EmailAddressTabs
+	TabKindEmailAddress TabKind = "emailAddress"
+	// TabKindEmail identifies EmailTabs
+	TabKindEmail TabKind = "email"
+	// TabKindEnvelopeID identifies EnvelopeIDTabs
+	TabKindEnvelopeID TabKind = "envelopeId"
+	// TabKindFirstName identifies FirstNameTabs
+	TabKindFirstName TabKind = "firstName"
+	// TabKindFormula identifies FormulaTabs
+	TabKindFormula TabKind = "formula"
+	// TabKindFullName identifies FullNameTabs
+	TabKindFullName TabKind = "fullName"
+	// TabKindInitialHere identifies InitialHereTabs
+	TabKindInitialHere TabKind = "initialHere"
+	// TabKindLastName identifies LastNameTabs
+	TabKindLastName TabKind = "lastName"
+	// TabKindList identifies ListTabs
+	TabKindList TabKind = "list"
+	// TabKindNotarize identifies NotarizeTabs
+	TabKindNotarize TabKind = "notarize"
+	// TabKindNote identifies NoteTabs
+	TabKindNote TabKind = "note"
+	// TabKindNumber identifies NumberTabs
+	TabKindNumber TabKind = "number"
+	// TabKindRadioGroup identifies RadioGroupTabs
+	TabKindRadioGroup TabKind = "radioGroup"
+	// TabKindSignHere identifies SignHereTabs
+	TabKindSignHere TabKind = "signHere"
+	// TabKindSignerAttachment identifies SignerAttachmentTabs
+	TabKindSignerAttachment TabKind = "signerAttachment"
+	// TabKindSmartSection identifies SmartSectionTabs
+	TabKindSmartSection TabKind = "smartSection"
+	// TabKindSSN identifies SSNTabs
+	TabKindSSN TabKind = "ssn"
+	// TabKindText identifies TextTabs
+	TabKindText TabKind = "text"
+	// TabKindTitle identifies TitleTabs
+	TabKindTitle TabKind = "title"
+	// TabKindView identifies ViewTabs
+	TabKindView TabKind = "view"
+	// TabKindZip identifies ZipTabs
+	TabKindZip TabKind = "zip"
+)
+
+// TabKinds lists the kind of every tab list in Tabs
+var TabKinds = []TabKind{
+	TabKindApprove,
+	TabKindCheckbox,
+	TabKindCompany,
+	TabKindDateSigned,
+	TabKindDate,
+	TabKindDecline,
+	TabKindEmailAddress,
+	TabKindEmail,
+	TabKindEnvelopeID,
+	TabKindFirstName,
+	TabKindFormula,
+	TabKindFullName,
+	TabKindInitialHere,
+	TabKindLastName,
+	TabKindList,
+	TabKindNotarize,
+	TabKindNote,
+	TabKindNumber,
+	TabKindRadioGroup,
+	TabKindSignHere,
+	TabKindSignerAttachment,
+	TabKindSmartSection,
+	TabKindSSN,
+	TabKindText,
+	TabKindTitle,
+	TabKindView,
+	TabKindZip,
+}
+
+// Tab is a tab found in a Tabs struct.  Ptr points to the tab's struct
+// in its Tabs list (e.g. a *Text for TabKindText), so changes made via
+// the Tab update the Tabs.  Appending to a list invalidates the list's
+// Tab values.
+type Tab struct {
+	Kind TabKind
+	Ptr  interface{}
+}
+
+// Label returns the tab's TabLabel.  The label of a radio group is its
+// GroupName.
+func (t Tab) Label() string {
+	switch p := t.Ptr.(type) {
+	case *Approve:
+		return p.TabLabel
+	case *Checkbox:
+		return p.TabLabel
+	case *Company:
+		return p.TabLabel
+	case *DateSigned:
+		return p.TabLabel
+	case *Date:
+		return p.TabLabel
+	case *Decline:
+		return p.TabLabel
+	case *EmailAddress:
+		return p.TabLabel
+	case *Email:
+		return p.TabLabel
+	case *EnvelopeID:
+		return p.TabLabel
+	case *FirstName:
+		return p.TabLabel
+	case *FormulaTab:
+		return p.TabLabel
+	case *FullName:
+		return p.TabLabel
+	case *InitialHere:
+		return p.TabLabel
+	case *LastName:
+		return p.TabLabel
+	case *List:
+		return p.TabLabel
+	case *Notarize:
+		return p.TabLabel
+	case *Note:
+		return p.TabLabel
+	case *Number:
+		return p.TabLabel
+	case *RadioGroup:
+		return p.GroupName
+	case *SignHere:
+		return p.TabLabel
+	case *SignerAttachment:
+		return p.TabLabel
+	case *SSN:
+		return p.TabLabel
+	case *Text:
+		return p.TabLabel
+	case *Title:
+		return p.TabLabel
+	case *View:
+		return p.TabLabel
+	case *Zip:
+		return p.TabLabel
+	}
+	return ""
+}
+
+func (t Tab) setLabel(label string) bool {
+	switch p := t.Ptr.(type) {
+	case *Approve:
+		p.TabLabel = label
+	case *Checkbox:
+		p.TabLabel = label
+	case *Company:
+		p.TabLabel = label
+	case *DateSigned:
+		p.TabLabel = label
+	case *Date:
+		p.TabLabel = label
+	case *Decline:
+		p.TabLabel = label
+	case *EmailAddress:
+		p.TabLabel = label
+	case *Email:
+		p.TabLabel = label
+	case *EnvelopeID:
+		p.TabLabel = label
+	case *FirstName:
+		p.TabLabel = label
+	case *FormulaTab:
+		p.TabLabel = label
+	case *FullName:
+		p.TabLabel = label
+	case *InitialHere:
+		p.TabLabel = label
+	case *LastName:
+		p.TabLabel = label
+	case *List:
+		p.TabLabel = label
+	case *Notarize:
+		p.TabLabel = label
+	case *Note:
+		p.TabLabel = label
+	case *Number:
+		p.TabLabel = label
+	case *RadioGroup:
+		p.GroupName = label
+	case *SignHere:
+		p.TabLabel = label
+	case *SignerAttachment:
+		p.TabLabel = label
+	case *SSN:
+		p.TabLabel = label
+	case *Text:
+		p.TabLabel = label
+	case *Title:
+		p.TabLabel = label
+	case *View:
+		p.TabLabel = label
+	case *Zip:
+		p.TabLabel = label
+	default:
+		return false
+	}
+	return true
+}
+
+// Value returns the tab's value and true.  ok is false for tabs
+// without a value (e.g. SignHere).  The value of a checkbox is "true"
+// or "false", and the value of a list or radio group is the value of
+// its selected item.
+func (t Tab) Value() (value string, ok bool) {
+	switch p := t.Ptr.(type) {
+	case *Checkbox:
+		return string(DSBool(p.Selected.True())), true
+	case *Company:
+		return p.Value, true
+	case *DateSigned:
+		return p.Value, true
+	case *Date:
+		return p.Value, true
+	case *EmailAddress:
+		return p.Value, true
+	case *Email:
+		return p.Value, true
+	case *FirstName:
+		return p.Value, true
+	case *FormulaTab:
+		return p.Value, true
+	case *FullName:
+		return p.Value, true
+	case *LastName:
+		return p.Value, true
+	case *List:
+		return listValue(p.ListItems), true
+	case *Note:
+		return p.Value, true
+	case *Number:
+		return p.Value, true
+	case *RadioGroup:
+		return radioGroupValue(p.Radios), true
+	case *SSN:
+		return p.Value, true
+	case *Text:
+		return p.Value, true
+	case *Title:
+		return p.Value, true
+	case *Zip:
+		return p.Value, true
+	}
+	return "", false
+}
+
+// SetValue sets the tab's value and reports whether the value was set.
+// A checkbox accepts values parsed by strconv.ParseBool, and a list or
+// radio group selects the item with a matching value.
+func (t Tab) SetValue(value string) bool {
+	switch p := t.Ptr.(type) {
+	case *Checkbox:
+		return setSelected(&p.Selected, value)
+	case *Company:
+		p.Value = value
+	case *DateSigned:
+		p.Value = value
+	case *Date:
+		p.Value = value
+	case *EmailAddress:
+		p.Value = value
+	case *Email:
+		p.Value = value
+	case *FirstName:
+		p.Value = value
+	case *FormulaTab:
+		p.Value = value
+	case *FullName:
+		p.Value = value
+	case *LastName:
+		p.Value = value
+	case *List:
+		if !setListValue(p.ListItems, value) {
+			return false
+		}
+		p.Value = value
+	case *Note:
+		p.Value = value
+	case *Number:
+		p.Value = value
+	case *RadioGroup:
+		return setRadioGroupValue(p.Radios, value)
+	case *SSN:
+		p.Value = value
+	case *Text:
+		p.Value = value
+	case *Title:
+		p.Value = value
+	case *Zip:
+		p.Value = value
+	default:
+		return false
+	}
+	return true
+}
+
+// All returns every tab of t in TabKinds order.
+func (t *Tabs) All() []Tab {
+	if t == nil {
+		return nil
+	}
+	var tabs []Tab
+	for i := range t.ApproveTabs {
+		tabs = append(tabs, Tab{Kind: TabKindApprove, Ptr: &t.ApproveTabs[i]})
+	}
+	for i := range t.CheckboxTabs {
+		tabs = append(tabs, Tab{Kind: TabKindCheckbox, Ptr: &t.CheckboxTabs[i]})
+	}
+	for i := range t.CompanyTabs {
+		tabs = append(tabs, Tab{Kind: TabKindCompany, Ptr: &t.CompanyTabs[i]})
+	}
+	for i := range t.DateSignedTabs {
+		tabs = append(tabs, Tab{Kind: TabKindDateSigned, Ptr: &t.DateSignedTabs[i]})
+	}
+	for i := range t.DateTabs {
+		tabs = append(tabs, Tab{Kind: TabKindDate, Ptr: &t.DateTabs[i]})
+	}
+	for i := range t.DeclineTabs {
+		tabs = append(tabs, Tab{Kind: TabKindDecline, Ptr: &t.DeclineTabs[i]})
+	}
+	for i := range t.EmailAddressTabs {
+		tabs = append(tabs, Tab{Kind: TabKindEmailAddress, Ptr: &t.EmailAddressTabs[i]})
+	}
+	for i := range t.EmailTabs {
+		tabs = append(tabs, Tab{Kind: TabKindEmail, Ptr: &t.EmailTabs[i]})
+	}
+	for i := range t.EnvelopeIDTabs {
+		tabs = append(tabs, Tab{Kind: TabKindEnvelopeID, Ptr: &t.EnvelopeIDTabs[i]})
+	}
+	for i := range t.FirstNameTabs {
+		tabs = append(tabs, Tab{Kind: TabKindFirstName, Ptr: &t.FirstNameTabs[i]})
+	}
+	for i := range t.FormulaTabs {
+		tabs = append(tabs, Tab{Kind: TabKindFormula, Ptr: &t.FormulaTabs[i]})
+	}
+	for i := range t.FullNameTabs {
+		tabs = append(tabs, Tab{Kind: TabKindFullName, Ptr: &t.FullNameTabs[i]})
+	}
+	for i := range t.InitialHereTabs {
+		tabs = append(tabs, Tab{Kind: TabKindInitialHere, Ptr: &t.InitialHereTabs[i]})
+	}
+	for i := range t.LastNameTabs {
+		tabs = append(tabs, Tab{Kind: TabKindLastName, Ptr: &t.LastNameTabs[i]})
+	}
+	for i := range t.ListTabs {
+		tabs = append(tabs, Tab{Kind: TabKindList, Ptr: &t.ListTabs[i]})
+	}
+	for i := range t.NotarizeTabs {
+		tabs = append(tabs, Tab{Kind: TabKindNotarize, Ptr: &t.NotarizeTabs[i]})
+	}
+	for i := range t.NoteTabs {
+		tabs = append(tabs, Tab{Kind: TabKindNote, Ptr: &t.NoteTabs[i]})
+	}
+	for i := range t.NumberTabs {
+		tabs = append(tabs, Tab{Kind: TabKindNumber, Ptr: &t.NumberTabs[i]})
+	}
+	for i := range t.RadioGroupTabs {
+		tabs = append(tabs, Tab{Kind: TabKindRadioGroup, Ptr: &t.RadioGroupTabs[i]})
+	}
+	for i := range t.SignHereTabs {
+		tabs = append(tabs, Tab{Kind: TabKindSignHere, Ptr: &t.SignHereTabs[i]})
+	}
+	for i := range t.SignerAttachmentTabs {
+		tabs = append(tabs, Tab{Kind: TabKindSignerAttachment, Ptr: &t.SignerAttachmentTabs[i]})
+	}
+	for i := range t.SmartSectionTabs {
+		tabs = append(tabs, Tab{Kind: TabKindSmartSection, Ptr: &t.SmartSectionTabs[i]})
+	}
+	for i := range t.SSNTabs {
+		tabs = append(tabs, Tab{Kind: TabKindSSN, Ptr: &t.SSNTabs[i]})
+	}
+	for i := range t.TextTabs {
+		tabs = append(tabs, Tab{Kind: TabKindText, Ptr: &t.TextTabs[i]})
+	}
+	for i := range t.TitleTabs {
+		tabs = append(tabs, Tab{Kind: TabKindTitle, Ptr: &t.TitleTabs[i]})
+	}
+	for i := range t.ViewTabs {
+		tabs = append(tabs, Tab{Kind: TabKindView, Ptr: &t.ViewTabs[i]})
+	}
+	for i := range t.ZipTabs {
+		tabs = append(tabs, Tab{Kind: TabKindZip, Ptr: &t.ZipTabs[i]})
+	}
+	return tabs
+}
+
+// Find returns all tabs with a Label of label.
+func (t *Tabs) Find(label string) []Tab {
+	var tabs []Tab
+	for _, tab := range t.All() {
+		if tab.Label() == label {
+			tabs = append(tabs, tab)
+		}
+	}
+	return tabs
+}
+
+// GetValue returns the value of the first tab with a Label of label that
+// has a value.
+func (t *Tabs) GetValue(label string) (string, bool) {
+	for _, tab := range t.Find(label) {
+		if v, ok := tab.Value(); ok {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// SetValue sets the value of all tabs with a Label of label and returns
+// the number of tabs updated.
+func (t *Tabs) SetValue(label, value string) int {
+	var cnt int
+	for _, tab := range t.Find(label) {
+		if tab.SetValue(value) {
+			cnt++
+		}
+	}
+	return cnt
+}
+
+// Add appends tab to the list of its type and returns the added Tab.
+// tab must be a tab struct or a pointer to one (e.g. Text or *Text).
+func (t *Tabs) Add(tab interface{}) (Tab, error) {
+	switch v := tab.(type) {
+	case Approve:
+		t.ApproveTabs = append(t.ApproveTabs, v)
+		return Tab{Kind: TabKindApprove, Ptr: &t.ApproveTabs[len(t.ApproveTabs)-1]}, nil
+	case *Approve:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case Checkbox:
+		t.CheckboxTabs = append(t.CheckboxTabs, v)
+		return Tab{Kind: TabKindCheckbox, Ptr: &t.CheckboxTabs[len(t.CheckboxTabs)-1]}, nil
+	case *Checkbox:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case Company:
+		t.CompanyTabs = append(t.CompanyTabs, v)
+		return Tab{Kind: TabKindCompany, Ptr: &t.CompanyTabs[len(t.CompanyTabs)-1]}, nil
+	case *Company:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case DateSigned:
+		t.DateSignedTabs = append(t.DateSignedTabs, v)
+		return Tab{Kind: TabKindDateSigned, Ptr: &t.DateSignedTabs[len(t.DateSignedTabs)-1]}, nil
+	case *DateSigned:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case Date:
+		t.DateTabs = append(t.DateTabs, v)
+		return Tab{Kind: TabKindDate, Ptr: &t.DateTabs[len(t.DateTabs)-1]}, nil
+	case *Date:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case Decline:
+		t.DeclineTabs = append(t.DeclineTabs, v)
+		return Tab{Kind: TabKindDecline, Ptr: &t.DeclineTabs[len(t.DeclineTabs)-1]}, nil
+	case *Decline:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case EmailAddress:
+		t.EmailAddressTabs = append(t.EmailAddressTabs, v)
+		return Tab{Kind: TabKindEmailAddress, Ptr: &t.EmailAddressTabs[len(t.EmailAddressTabs)-1]}, nil
+	case *EmailAddress:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case Email:
+		t.EmailTabs = append(t.EmailTabs, v)
+		return Tab{Kind: TabKindEmail, Ptr: &t.EmailTabs[len(t.EmailTabs)-1]}, nil
+	case *Email:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case EnvelopeID:
+		t.EnvelopeIDTabs = append(t.EnvelopeIDTabs, v)
+		return Tab{Kind: TabKindEnvelopeID, Ptr: &t.EnvelopeIDTabs[len(t.EnvelopeIDTabs)-1]}, nil
+	case *EnvelopeID:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case FirstName:
+		t.FirstNameTabs = append(t.FirstNameTabs, v)
+		return Tab{Kind: TabKindFirstName, Ptr: &t.FirstNameTabs[len(t.FirstNameTabs)-1]}, nil
+	case *FirstName:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case FormulaTab:
+		t.FormulaTabs = append(t.FormulaTabs, v)
+		return Tab{Kind: TabKindFormula, Ptr: &t.FormulaTabs[len(t.FormulaTabs)-1]}, nil
+	case *FormulaTab:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case FullName:
+		t.FullNameTabs = append(t.FullNameTabs, v)
+		return Tab{Kind: TabKindFullName, Ptr: &t.FullNameTabs[len(t.FullNameTabs)-1]}, nil
+	case *FullName:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case InitialHere:
+		t.InitialHereTabs = append(t.InitialHereTabs, v)
+		return Tab{Kind: TabKindInitialHere, Ptr: &t.InitialHereTabs[len(t.InitialHereTabs)-1]}, nil
+	case *InitialHere:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case LastName:
+		t.LastNameTabs = append(t.LastNameTabs, v)
+		return Tab{Kind: TabKindLastName, Ptr: &t.LastNameTabs[len(t.LastNameTabs)-1]}, nil
+	case *LastName:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case List:
+		t.ListTabs = append(t.ListTabs, v)
+		return Tab{Kind: TabKindList, Ptr: &t.ListTabs[len(t.ListTabs)-1]}, nil
+	case *List:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case Notarize:
+		t.NotarizeTabs = append(t.NotarizeTabs, v)
+		return Tab{Kind: TabKindNotarize, Ptr: &t.NotarizeTabs[len(t.NotarizeTabs)-1]}, nil
+	case *Notarize:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case Note:
+		t.NoteTabs = append(t.NoteTabs, v)
+		return Tab{Kind: TabKindNote, Ptr: &t.NoteTabs[len(t.NoteTabs)-1]}, nil
+	case *Note:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case Number:
+		t.NumberTabs = append(t.NumberTabs, v)
+		return Tab{Kind: TabKindNumber, Ptr: &t.NumberTabs[len(t.NumberTabs)-1]}, nil
+	case *Number:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case RadioGroup:
+		t.RadioGroupTabs = append(t.RadioGroupTabs, v)
+		return Tab{Kind: TabKindRadioGroup, Ptr: &t.RadioGroupTabs[len(t.RadioGroupTabs)-1]}, nil
+	case *RadioGroup:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case SignHere:
+		t.SignHereTabs = append(t.SignHereTabs, v)
+		return Tab{Kind: TabKindSignHere, Ptr: &t.SignHereTabs[len(t.SignHereTabs)-1]}, nil
+	case *SignHere:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case SignerAttachment:
+		t.SignerAttachmentTabs = append(t.SignerAttachmentTabs, v)
+		return Tab{Kind: TabKindSignerAttachment, Ptr: &t.SignerAttachmentTabs[len(t.SignerAttachmentTabs)-1]}, nil
+	case *SignerAttachment:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case SmartSection:
+		t.SmartSectionTabs = append(t.SmartSectionTabs, v)
+		return Tab{Kind: TabKindSmartSection, Ptr: &t.SmartSectionTabs[len(t.SmartSectionTabs)-1]}, nil
+	case *SmartSection:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case SSN:
+		t.SSNTabs = append(t.SSNTabs, v)
+		return Tab{Kind: TabKindSSN, Ptr: &t.SSNTabs[len(t.SSNTabs)-1]}, nil
+	case *SSN:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case Text:
+		t.TextTabs = append(t.TextTabs, v)
+		return Tab{Kind: TabKindText, Ptr: &t.TextTabs[len(t.TextTabs)-1]}, nil
+	case *Text:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case Title:
+		t.TitleTabs = append(t.TitleTabs, v)
+		return Tab{Kind: TabKindTitle, Ptr: &t.TitleTabs[len(t.TitleTabs)-1]}, nil
+	case *Title:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case View:
+		t.ViewTabs = append(t.ViewTabs, v)
+		return Tab{Kind: TabKindView, Ptr: &t.ViewTabs[len(t.ViewTabs)-1]}, nil
+	case *View:
+		if v != nil {
+			return t.Add(*v)
+		}
+	case Zip:
+		t.ZipTabs = append(t.ZipTabs, v)
+		return Tab{Kind: TabKindZip, Ptr: &t.ZipTabs[len(t.ZipTabs)-1]}, nil
+	case *Zip:
+		if v != nil {
+			return t.Add(*v)
+		}
+	}
+	return Tab{}, fmt.Errorf("unable to add %T to Tabs", tab)
+}
+
+// Values returns a map of labels to values for every labeled tab of t
+// that has a value.  Checkbox values are bools and all other values are
+// strings.  When labels are shared, the first tab's value is used.
+// TabsFromMap(t.Values()) creates text and checkbox tabs with the same
+// labels and values.
+func (t *Tabs) Values() map[string]interface{} {
+	m := make(map[string]interface{})
+	for _, tab := range t.All() {
+		label := tab.Label()
+		if _, ok := m[label]; ok || label == "" {
+			continue
+		}
+		if v, ok := tab.Value(); ok {
+			if tab.Kind == TabKindCheckbox {
+				m[label] = v == string(TRUE)
+				continue
+			}
+			m[label] = v
+		}
+	}
+	return m
+}
+
+// TabsFromMap creates Tabs for prefilling values.  Each key is used as a
+// tab label, and the value determines the tab type:
+//
+//	string        Text tab
+//	bool          Checkbox tab
+//	int, int64,
+//	float64       Number tab
+//	tab struct    the tab, labeled with the key if it has no label
+//	              (e.g. model.Currency or *model.Currency)
+func TabsFromMap(m map[string]interface{}) (*Tabs, error) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	tabs := &Tabs{}
+	for _, k := range keys {
+		var tab interface{}
+		var value string
+		switch v := m[k].(type) {
+		case string:
+			tab, value = Text{}, v
+		case bool:
+			tab, value = Checkbox{}, strconv.FormatBool(v)
+		case int:
+			tab, value = Number{}, strconv.Itoa(v)
+		case int64:
+			tab, value = Number{}, strconv.FormatInt(v, 10)
+		case float64:
+			tab, value = Number{}, strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			tab = v
+		}
+		tx, err := tabs.Add(tab)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", k, err)
+		}
+		if tx.Label() == "" && !tx.setLabel(k) {
+			return nil, fmt.Errorf("%s: %s tabs may not be labeled", k, tx.Kind)
+		}
+		if value > "" {
+			tx.SetValue(value)
+		}
+	}
+	return tabs, nil
+}
+
+func listValue(items []ListItem) string {
+	for _, item := range items {
+		if item.Selected.True() {
+			return item.Value
+		}
+	}
+	return ""
+}
+
+func setListValue(items []ListItem, value string) bool {
+	return selectItem(len(items), func(i int) (string, *Bool) {
+		return items[i].Value, &items[i].Selected
+	}, value)
+}
+
+func radioGroupValue(radios []Radio) string {
+	for _, rb := range radios {
+		if rb.Selected.True() {
+			return rb.Value
+		}
+	}
+	return ""
+}
+
+func setRadioGroupValue(radios []Radio, value string) bool {
+	return selectItem(len(radios), func(i int) (string, *Bool) {
+		return radios[i].Value, &radios[i].Selected
+	}, value)
+}
+
+// selectItem selects the item with a matching value and deselects all
+// others.  An empty value deselects all items.
+func selectItem(cnt int, item func(int) (string, *Bool), value string) bool {
+	found := value == ""
+	for i := 0; i < cnt; i++ {
+		if v, _ := item(i); v == value {
+			found = true
+		}
+	}
+	if !found {
+		return false
+	}
+	for i := 0; i < cnt; i++ {
+		v, sel := item(i)
+		*sel = DSBool(value != "" && v == value)
+	}
+	return true
+}
+
+func setSelected(sel *Bool, value string) bool {
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false
+	}
+	*sel = DSBool(b)
+	return true
+}
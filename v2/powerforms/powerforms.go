@@ -11,6 +11,10 @@
 // The PowerForms category enables PowerForms to be created and managed.
 //
 //
+// The specification lists no field constraints for this API's models,
+// so they have no Validate methods and ops have no ValidateFirst option.
+// Constraints may be added with a gen-esign overrides file.
+//
 // Service Api documentation may be found at:
 // https://developers.docusign.com/docs/esign-rest-api/v2/reference/PowerForms
 // Usage example:
@@ -80,7 +84,7 @@ func (op *DataListOp) DataLayout(val string) *DataListOp {
 // FromDate start of the search date range. Only returns templates created on or after this date/time. If no value is specified, there is no limit on the earliest date created.
 func (op *DataListOp) FromDate(val time.Time) *DataListOp {
 	if op != nil {
-		op.QueryOpts.Set("from_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -88,7 +92,7 @@ func (op *DataListOp) FromDate(val time.Time) *DataListOp {
 // ToDate end of the search date range. Only returns templates created up to this date/time. If no value is provided, this defaults to the current date.
 func (op *DataListOp) ToDate(val time.Time) *DataListOp {
 	if op != nil {
-		op.QueryOpts.Set("to_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -220,7 +224,7 @@ func (op *ListOp) Do(ctx context.Context) (*model.PowerFormsResponse, error) {
 // FromDate start of the search date range. Only returns templates created on or after this date/time. If no value is specified, there is no limit on the earliest date created.
 func (op *ListOp) FromDate(val time.Time) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("from_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -244,9 +248,9 @@ func (op *ListOp) Order(val string) *ListOp {
 //
 // * modified
 // * name
-func (op *ListOp) OrderBy(val string) *ListOp {
+func (op *ListOp) OrderBy(val OrderBy) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("order_by", val)
+		op.QueryOpts.Set("order_by", string(val))
 	}
 	return op
 }
@@ -254,7 +258,7 @@ func (op *ListOp) OrderBy(val string) *ListOp {
 // ToDate end of the search date range. Only returns templates created up to this date/time. If no value is provided, this defaults to the current date.
 func (op *ListOp) ToDate(val time.Time) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("to_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -284,6 +288,45 @@ func (op *ListSendersOp) Do(ctx context.Context) (*model.PowerFormSendersRespons
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *ListSendersOp) Pages(ctx context.Context, f func(*model.PowerFormSendersResponse) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   res.EndPosition,
+			NextURI:       &res.NextURI,
+			ResultSetSize: res.ResultSetSize,
+			StartPosition: res.StartPosition,
+			TotalSetSize:  res.TotalSetSize,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // StartPosition is the position within the total result set from which to start returning values. The value **thumbnail** may be used to return the page image.
 func (op *ListSendersOp) StartPosition(val int) *ListSendersOp {
 	if op != nil {
@@ -317,3 +360,12 @@ func (op *UpdateOp) Do(ctx context.Context) (*model.PowerForm, error) {
 	var res *model.PowerForm
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
+
+// OrderBy is a value of the order_by query option.
+type OrderBy string
+
+// OrderBy values
+const (
+	OrderByModified OrderBy = "modified"
+	OrderByName     OrderBy = "name"
+)
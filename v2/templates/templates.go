@@ -19,6 +19,10 @@
 // Templates can be created programmatically or can be created via the DocuSign web interface and then used by your application.
 //
 //
+// The specification lists no field constraints for this API's models,
+// so they have no Validate methods and ops have no ValidateFirst option.
+// Constraints may be added with a gen-esign overrides file.
+//
 // Service Api documentation may be found at:
 // https://developers.docusign.com/docs/esign-rest-api/v2/reference/Templates
 // Usage example:
@@ -179,6 +183,45 @@ func (op *BulkRecipientsListOp) Do(ctx context.Context) (*model.BulkRecipientsRe
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *BulkRecipientsListOp) Pages(ctx context.Context, f func(*model.BulkRecipientsResponse) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   res.EndPosition,
+			NextURI:       &res.NextURI,
+			ResultSetSize: res.ResultSetSize,
+			StartPosition: res.StartPosition,
+			TotalSetSize:  res.TotalSetSize,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // IncludeTabs when set to **true**, the tab information associated with the recipient is included in the response.
 func (op *BulkRecipientsListOp) IncludeTabs() *BulkRecipientsListOp {
 	if op != nil {
@@ -1185,9 +1228,13 @@ func (op *GetOp) Do(ctx context.Context) (*model.EnvelopeTemplate, error) {
 // - `custom_fields`: Includes information about template custom fields.
 // - `notifications`: Includes information about the notification settings for templates.
 // - `advanced_templates`: Includes advanced templates in the response. For example, these include templates that use advanced recipient routing. We recommend that you use this option to ensure that the response includes all relevant templates.
-func (op *GetOp) Include(val ...string) *GetOp {
+func (op *GetOp) Include(val ...Include) *GetOp {
 	if op != nil {
-		op.QueryOpts.Set("include", strings.Join(val, ","))
+		vals := make([]string, len(val))
+		for i, v := range val {
+			vals[i] = string(v)
+		}
+		op.QueryOpts.Set("include", strings.Join(vals, ","))
 	}
 	return op
 }
@@ -1298,6 +1345,45 @@ func (op *GetPageImagesOp) Do(ctx context.Context) (*model.PageImages, error) {
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *GetPageImagesOp) Pages(ctx context.Context, f func(*model.PageImages) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   res.EndPosition,
+			NextURI:       &res.NextURI,
+			ResultSetSize: res.ResultSetSize,
+			StartPosition: res.StartPosition,
+			TotalSetSize:  res.TotalSetSize,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // Count is the maximum number of results to be returned by this request.
 func (op *GetPageImagesOp) Count(val int) *GetPageImagesOp {
 	if op != nil {
@@ -1379,6 +1465,45 @@ func (op *ListOp) Do(ctx context.Context) (*model.EnvelopeTemplateResults, error
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
 
+// Pages executes the op for each page of results, calling f with each
+// page until all results are read or f returns an error.  Return
+// esign.ErrStopPaging from f to end paging without an error.
+func (op *ListOp) Pages(ctx context.Context, f func(*model.EnvelopeTemplateResults) error) error {
+	if op == nil {
+		return esign.ErrNilOp
+	}
+	pageOp := *op
+	pageOp.QueryOpts = make(url.Values)
+	for k, v := range op.QueryOpts {
+		pageOp.QueryOpts[k] = v
+	}
+	for {
+		res, err := pageOp.Do(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(res); err != nil {
+			if err == esign.ErrStopPaging {
+				return nil
+			}
+			return err
+		}
+		if res == nil {
+			return nil
+		}
+		pg := esign.PageInfo{
+			EndPosition:   res.EndPosition,
+			NextURI:       &res.NextURI,
+			ResultSetSize: res.ResultSetSize,
+			StartPosition: res.StartPosition,
+			TotalSetSize:  res.TotalSetSize,
+		}
+		if more, err := pg.SetNext(pageOp.QueryOpts, "start_position", "", ""); !more || err != nil {
+			return err
+		}
+	}
+}
+
 // Count is the number of records to return.
 func (op *ListOp) Count(val int) *ListOp {
 	if op != nil {
@@ -1406,7 +1531,7 @@ func (op *ListOp) FolderIds(val ...string) *ListOp {
 // FromDate start of the search date range. Only returns templates created on or after this date/time. If no value is specified, there is no limit on the earliest date created.
 func (op *ListOp) FromDate(val time.Time) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("from_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -1419,9 +1544,13 @@ func (op *ListOp) FromDate(val time.Time) *ListOp {
 // - `custom_fields`: Includes information about template custom fields.
 // - `notifications`: Includes information about the notification settings for templates.
 // - `advanced_templates`: Includes advanced templates in the response. For example, these include templates that use advanced recipient routing. We recommend that you use this option to ensure that the response includes all relevant templates.
-func (op *ListOp) Include(val ...string) *ListOp {
+func (op *ListOp) Include(val ...Include) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("include", strings.Join(val, ","))
+		vals := make([]string, len(val))
+		for i, v := range val {
+			vals[i] = string(v)
+		}
+		op.QueryOpts.Set("include", strings.Join(vals, ","))
 	}
 	return op
 }
@@ -1429,7 +1558,7 @@ func (op *ListOp) Include(val ...string) *ListOp {
 // ModifiedFromDate set the call query parameter modified_from_date
 func (op *ListOp) ModifiedFromDate(val time.Time) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("modified_from_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("modified_from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -1437,7 +1566,7 @@ func (op *ListOp) ModifiedFromDate(val time.Time) *ListOp {
 // ModifiedToDate set the call query parameter modified_to_date
 func (op *ListOp) ModifiedToDate(val time.Time) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("modified_to_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("modified_to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -1446,9 +1575,9 @@ func (op *ListOp) ModifiedToDate(val time.Time) *ListOp {
 //
 // - `asc` = ascending sort order (a to z)
 // - `desc` = descending sort order (z to a)
-func (op *ListOp) Order(val string) *ListOp {
+func (op *ListOp) Order(val Order) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("order", val)
+		op.QueryOpts.Set("order", string(val))
 	}
 	return op
 }
@@ -1458,9 +1587,9 @@ func (op *ListOp) Order(val string) *ListOp {
 // - `name`: template name
 // - `modified`: The date and time the template was last modified.
 // - `used`: The date and time the template was last used.
-func (op *ListOp) OrderBy(val string) *ListOp {
+func (op *ListOp) OrderBy(val OrderBy) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("order_by", val)
+		op.QueryOpts.Set("order_by", string(val))
 	}
 	return op
 }
@@ -1492,7 +1621,7 @@ func (op *ListOp) StartPosition(val int) *ListOp {
 // ToDate end of the search date range. Only returns templates created up to this date/time. If no value is provided, this defaults to the current date.
 func (op *ListOp) ToDate(val time.Time) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("to_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -1500,7 +1629,7 @@ func (op *ListOp) ToDate(val time.Time) *ListOp {
 // UsedFromDate start of the search date range. Only returns templates used or edited on or after this date/time. If no value is specified, there is no limit on the earliest date used.
 func (op *ListOp) UsedFromDate(val time.Time) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("used_from_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("used_from_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -1508,7 +1637,7 @@ func (op *ListOp) UsedFromDate(val time.Time) *ListOp {
 // UsedToDate end of the search date range. Only returns templates used or edited up to this date/time. If no value is provided, this defaults to the current date.
 func (op *ListOp) UsedToDate(val time.Time) *ListOp {
 	if op != nil {
-		op.QueryOpts.Set("used_to_date", val.Format(time.RFC3339))
+		op.QueryOpts.Set("used_to_date", val.Format(time.RFC3339Nano))
 	}
 	return op
 }
@@ -1722,3 +1851,35 @@ func (op *ResponsiveHTMLPreviewCreateOp) Do(ctx context.Context) (*model.Documen
 	var res *model.DocumentHTMLDefinitions
 	return res, ((*esign.Op)(op)).Do(ctx, &res)
 }
+
+// Include is a value of the include query option.
+type Include string
+
+// Include values
+const (
+	IncludeRecipients        Include = "recipients"
+	IncludeFolders           Include = "folders"
+	IncludeDocuments         Include = "documents"
+	IncludeCustomFields      Include = "custom_fields"
+	IncludeNotifications     Include = "notifications"
+	IncludeAdvancedTemplates Include = "advanced_templates"
+)
+
+// Order is a value of the order query option.
+type Order string
+
+// Order values
+const (
+	OrderAsc  Order = "asc"
+	OrderDesc Order = "desc"
+)
+
+// OrderBy is a value of the order_by query option.
+type OrderBy string
+
+// OrderBy values
+const (
+	OrderByName     OrderBy = "name"
+	OrderByModified OrderBy = "modified"
+	OrderByUsed     OrderBy = "used"
+)
@@ -0,0 +1,209 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+// validate.go contains the checks used by the generated Validate
+// methods of model types.
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// ValidationError lists the fields of a value that do not meet the
+// constraints of DocuSign's specification.  errors.Is reports true
+// for ErrValidation.
+type ValidationError struct {
+	// Type is the name of the validated type
+	Type   string
+	Fields []FieldError
+}
+
+// FieldError describes a field failing validation.  Field is the json
+// path of the field, e.g. "users[0].email".
+type FieldError struct {
+	Field  string
+	Reason string
+}
+
+// Error lists the invalid fields.
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		msgs = append(msgs, f.Field+" "+f.Reason)
+	}
+	return "invalid " + e.Type + ": " + strings.Join(msgs, "; ")
+}
+
+// Is allows errors.Is(err, ErrValidation).
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// Validator collects the field errors found by a generated Validate
+// method.  Checks other than Required ignore empty values.
+type Validator struct {
+	fields []FieldError
+}
+
+func (v *Validator) add(field, reason string) {
+	v.fields = append(v.fields, FieldError{Field: field, Reason: reason})
+}
+
+// Required reports a missing field when value is nil, a nil pointer or
+// an empty string, slice or map.  Zero numbers and false are values.
+func (v *Validator) Required(field string, value interface{}) {
+	rv := reflect.ValueOf(value)
+	missing := !rv.IsValid()
+	if !missing {
+		switch rv.Kind() {
+		case reflect.Ptr, reflect.Interface:
+			missing = rv.IsNil()
+		case reflect.String, reflect.Slice, reflect.Map:
+			missing = rv.Len() == 0
+		}
+	}
+	if missing {
+		v.add(field, "is required")
+	}
+}
+
+// Enum reports a value not found in values.
+func (v *Validator) Enum(field, value string, values ...string) {
+	if value == "" {
+		return
+	}
+	for _, s := range values {
+		if s == value {
+			return
+		}
+	}
+	v.add(field, fmt.Sprintf("must be one of %s", strings.Join(values, ", ")))
+}
+
+// MinLength reports a value shorter than min characters.
+func (v *Validator) MinLength(field, value string, min int) {
+	if value != "" && utf8.RuneCountInString(value) < min {
+		v.add(field, fmt.Sprintf("must be at least %d characters", min))
+	}
+}
+
+// MaxLength reports a value longer than max characters.
+func (v *Validator) MaxLength(field, value string, max int) {
+	if utf8.RuneCountInString(value) > max {
+		v.add(field, fmt.Sprintf("must be at most %d characters", max))
+	}
+}
+
+var patterns sync.Map // map[string]*regexp.Regexp
+
+// Pattern reports a value not matching the regular expression pattern.
+// An invalid pattern is reported rather than treating the value as
+// valid.
+func (v *Validator) Pattern(field, value, pattern string) {
+	if value == "" {
+		return
+	}
+	re, ok := patterns.Load(pattern)
+	if !ok {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			v.add(field, fmt.Sprintf("has invalid pattern %q: %v", pattern, err))
+			return
+		}
+		re, _ = patterns.LoadOrStore(pattern, compiled)
+	}
+	if !re.(*regexp.Regexp).MatchString(value) {
+		v.add(field, "must match "+pattern)
+	}
+}
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// ValidatedFormats lists the string formats checked by Format.
+var ValidatedFormats = []string{"date", "date-time", "email", "uri", "uuid"}
+
+// Format reports a value that is not a valid instance of format.  Date
+// times may omit the time zone as DocuSign often does.
+func (v *Validator) Format(field, value, format string) {
+	if value == "" {
+		return
+	}
+	var ok bool
+	switch format {
+	case "date":
+		_, err := time.Parse("2006-01-02", value)
+		ok = err == nil
+	case "date-time":
+		_, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			_, err = time.Parse("2006-01-02T15:04:05", value)
+		}
+		ok = err == nil
+	case "email":
+		_, err := mail.ParseAddress(value)
+		ok = err == nil
+	case "uri":
+		u, err := url.Parse(value)
+		ok = err == nil && u.Scheme > ""
+	case "uuid":
+		ok = uuidPattern.MatchString(value)
+	default:
+		ok = true
+	}
+	if !ok {
+		v.add(field, "is not a valid "+format)
+	}
+}
+
+// Nested adds the field errors of a nested value's Validate error
+// prefixing each with field.
+func (v *Validator) Nested(field string, err error) {
+	if err == nil {
+		return
+	}
+	ve, ok := err.(*ValidationError)
+	if !ok {
+		v.add(field, err.Error())
+		return
+	}
+	for _, f := range ve.Fields {
+		v.add(field+"."+f.Field, f.Reason)
+	}
+}
+
+// NestedIndex adds the field errors of element i of a slice field.
+func (v *Validator) NestedIndex(field string, i int, err error) {
+	v.Nested(fmt.Sprintf("%s[%d]", field, i), err)
+}
+
+// Err returns a *ValidationError for typeName listing the field
+// errors or nil if none were found.
+func (v *Validator) Err(typeName string) error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return &ValidationError{Type: typeName, Fields: v.fields}
+}
+
+// validatePayload calls the payload's Validate method if any.
+func validatePayload(payload interface{}) error {
+	vp, ok := payload.(interface{ Validate() error })
+	if !ok {
+		return nil
+	}
+	if rv := reflect.ValueOf(payload); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return nil
+	}
+	return vp.Validate()
+}
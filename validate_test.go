@@ -0,0 +1,106 @@
+// Copyright 2022 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/rooms"
+	"github.com/jfcote87/esign/rooms/users"
+)
+
+func TestValidator(t *testing.T) {
+	var v esign.Validator
+	v.Required("name", "")
+	v.Required("id", 0)
+	v.Required("flag", false)
+	v.Required("nilValue", nil)
+	v.Required("list", []string{})
+	v.Required("ptr", (*rooms.Field)(nil))
+	v.Required("ok", "value")
+	v.Enum("status", "closed", "active", "pending")
+	v.Enum("empty", "", "active")
+	v.MinLength("min", "ab", 3)
+	v.MaxLength("max", "abcd", 3)
+	v.Pattern("code", "A1", `^[A-Z]+$`)
+	v.Pattern("badPattern", "A", `^(?!x)`)
+	v.Format("uuid", "5d2bd2bb-0e3b-4c29-8f3a-dc8f8b6e4a12", "uuid")
+	v.Format("badUUID", "5d2bd2bb", "uuid")
+	v.Format("when", "2020-01-02T15:04:05.123", "date-time")
+	v.Format("email", "not an email", "email")
+	v.Format("uri", "/relative", "uri")
+	v.Nested("sub", &esign.ValidationError{Type: "Sub", Fields: []esign.FieldError{{Field: "x", Reason: "is required"}}})
+	v.NestedIndex("items", 2, errors.New("bad item"))
+	want := []string{"name", "nilValue", "list", "ptr", "status", "min", "max", "code", "badPattern", "badUUID", "email", "uri", "sub.x", "items[2]"}
+
+	err := v.Err("Test")
+	var ve *esign.ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("expected *ValidationError; got %v", err)
+	}
+	var got []string
+	for _, f := range ve.Fields {
+		got = append(got, f.Field)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected fields %v; got %v", want, got)
+	}
+	if !esign.IsValidation(err) {
+		t.Errorf("expected errors.Is(err, ErrValidation)")
+	}
+	if err := (&esign.Validator{}).Err("Test"); err != nil {
+		t.Errorf("expected nil; got %v", err)
+	}
+}
+
+func TestGeneratedValidate(t *testing.T) {
+	if err := (rooms.ClassicAdminToInvite{Email: "a@example.com", FirstName: "A", LastName: "B"}).Validate(); err != nil {
+		t.Errorf("expected valid; got %v", err)
+	}
+	fs := rooms.FieldSet{FieldSetID: "bad", Fields: []rooms.Field{{}, {FieldID: "bad"}}}
+	err := fs.Validate()
+	if err == nil || !strings.Contains(err.Error(), "fieldSetId is not a valid uuid; fields[1].fieldId is not a valid uuid") {
+		t.Errorf("expected fieldSetId and fields[1].fieldId errors; got %v", err)
+	}
+}
+
+type countCred struct {
+	calls int
+}
+
+func (c *countCred) AuthDo(ctx context.Context, op *esign.Op) (*http.Response, error) {
+	c.calls++
+	return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader("{}"))}, nil
+}
+
+func TestOp_ValidatePayload(t *testing.T) {
+	ctx := context.Background()
+	cred := &countCred{}
+	sv := users.New(cred)
+
+	admin := &rooms.ClassicAdminToInvite{Email: "a@example.com", FirstName: "A", LastName: "B"}
+
+	_, err := sv.InviteClassicAdmin(&rooms.ClassicAdminToInvite{}).ValidateFirst().Do(ctx)
+	if !esign.IsValidation(err) || cred.calls != 0 {
+		t.Errorf("expected validation error without request; got %v with %d calls", err, cred.calls)
+	}
+	if _, err = sv.InviteClassicAdmin(admin).ValidateFirst().Do(ctx); err != nil || cred.calls != 1 {
+		t.Errorf("expected valid request; got %v with %d calls", err, cred.calls)
+	}
+	if _, err = sv.InviteClassicAdmin(nil).ValidateFirst().Do(ctx); err != nil || cred.calls != 2 {
+		t.Errorf("expected nil payload to be sent; got %v with %d calls", err, cred.calls)
+	}
+	if _, err = sv.InviteClassicAdmin(&rooms.ClassicAdminToInvite{}).Do(ctx); err != nil || cred.calls != 3 {
+		t.Errorf("expected request without validation; got %v with %d calls", err, cred.calls)
+	}
+}